@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/github"
 	iolog "github.com/github/github-mcp-server/pkg/log"
@@ -38,7 +39,11 @@ var (
 		Run: func(_ *cobra.Command, _ []string) {
 			logFile := viper.GetString("log-file")
 			readOnly := viper.GetBool("read-only")
+			readOnlyExceptions := viper.GetStringSlice("read-only-exceptions")
 			exportTranslations := viper.GetBool("export-translations")
+			enableGraphQL := viper.GetBool("enable-graphql")
+			strictArguments := viper.GetBool("strict-arguments")
+			checkTokenScopes := viper.GetBool("check-token-scopes")
 			logger, err := initLogger(logFile)
 			if err != nil {
 				stdlog.Fatal("Failed to initialize logger:", err)
@@ -46,15 +51,40 @@ var (
 			logCommands := viper.GetBool("enable-command-logging")
 			cfg := runConfig{
 				readOnly:           readOnly,
+				readOnlyExceptions: readOnlyExceptions,
 				logger:             logger,
 				logCommands:        logCommands,
 				exportTranslations: exportTranslations,
+				enableGraphQL:      enableGraphQL,
+				strictArguments:    strictArguments,
+				checkTokenScopes:   checkTokenScopes,
 			}
 			if err := runStdioServer(cfg); err != nil {
 				stdlog.Fatal("failed to run stdio server:", err)
 			}
 		},
 	}
+
+	capabilityTokenCmd = &cobra.Command{
+		Use:   "capability-token",
+		Short: "Issue a capability token",
+		Long:  `Issue a capability token, signed with GITHUB_MCP_CAPABILITY_SECRET, that lets its holder run the given write tools on a read-only server.`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			tools, _ := cmd.Flags().GetStringSlice("tools")
+			ttl, _ := cmd.Flags().GetDuration("ttl")
+			secret := os.Getenv("GITHUB_MCP_CAPABILITY_SECRET")
+			if secret == "" {
+				stdlog.Fatal("GITHUB_MCP_CAPABILITY_SECRET not set")
+			}
+			if len(tools) == 0 {
+				stdlog.Fatal("at least one tool must be given with --tools")
+			}
+			if ttl <= 0 {
+				stdlog.Fatal("--ttl must be positive")
+			}
+			fmt.Println(github.NewCapabilityToken([]byte(secret), tools, time.Now().Add(ttl)))
+		},
+	}
 )
 
 func init() {
@@ -62,20 +92,32 @@ func init() {
 
 	// Add global flags that will be shared by all commands
 	rootCmd.PersistentFlags().Bool("read-only", false, "Restrict the server to read-only operations")
+	rootCmd.PersistentFlags().StringSlice("read-only-exceptions", nil, "Write tools that stay enabled in read-only mode, e.g. add_issue_comment")
 	rootCmd.PersistentFlags().String("log-file", "", "Path to log file")
 	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
+	rootCmd.PersistentFlags().Bool("enable-graphql", false, "Enable the graphql tool, which runs arbitrary GraphQL queries and mutations")
+	rootCmd.PersistentFlags().Bool("strict-arguments", false, "Reject tool calls with arguments the tool does not declare instead of ignoring them")
+	rootCmd.PersistentFlags().Bool("check-token-scopes", false, "Fail calls to tools needing an OAuth scope the token lacks with an error naming the scope")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
 
 	// Bind flag to viper
 	_ = viper.BindPFlag("read-only", rootCmd.PersistentFlags().Lookup("read-only"))
+	_ = viper.BindPFlag("read-only-exceptions", rootCmd.PersistentFlags().Lookup("read-only-exceptions"))
 	_ = viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
 	_ = viper.BindPFlag("enable-command-logging", rootCmd.PersistentFlags().Lookup("enable-command-logging"))
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
+	_ = viper.BindPFlag("enable-graphql", rootCmd.PersistentFlags().Lookup("enable-graphql"))
+	_ = viper.BindPFlag("strict-arguments", rootCmd.PersistentFlags().Lookup("strict-arguments"))
+	_ = viper.BindPFlag("check-token-scopes", rootCmd.PersistentFlags().Lookup("check-token-scopes"))
 	_ = viper.BindPFlag("gh-host", rootCmd.PersistentFlags().Lookup("gh-host"))
 
+	capabilityTokenCmd.Flags().StringSlice("tools", nil, "Write tools the token allows, e.g. add_issue_comment,create_issue")
+	capabilityTokenCmd.Flags().Duration("ttl", time.Hour, "How long the token stays valid")
+
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
+	rootCmd.AddCommand(capabilityTokenCmd)
 }
 
 func initConfig() {
@@ -103,9 +145,13 @@ func initLogger(outPath string) (*log.Logger, error) {
 
 type runConfig struct {
 	readOnly           bool
+	readOnlyExceptions []string
 	logger             *log.Logger
 	logCommands        bool
 	exportTranslations bool
+	enableGraphQL      bool
+	strictArguments    bool
+	checkTokenScopes   bool
 }
 
 func runStdioServer(cfg runConfig) error {
@@ -141,7 +187,17 @@ func runStdioServer(cfg runConfig) error {
 		return ghClient, nil // closing over client
 	}
 	// Create
-	ghServer := github.NewServer(getClient, version, cfg.readOnly, t)
+	serverCfg := github.ServerConfig{
+		ReadOnly:           cfg.readOnly,
+		ReadOnlyExceptions: cfg.readOnlyExceptions,
+		EnableGraphQL:      cfg.enableGraphQL,
+		StrictArguments:    cfg.strictArguments,
+		CheckTokenScopes:   cfg.checkTokenScopes,
+		// Lets callers holding a signed capability token run write tools on a read-only server
+		CapabilitySecret: []byte(os.Getenv("GITHUB_MCP_CAPABILITY_SECRET")),
+		Logger:           cfg.logger,
+	}
+	ghServer := github.NewServer(getClient, version, serverCfg, t)
 	stdioServer := server.NewStdioServer(ghServer)
 
 	stdLogger := stdlog.New(cfg.logger.Writer(), "stdioserver", 0)
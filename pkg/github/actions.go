@@ -0,0 +1,1090 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// actionsSecret describes a GitHub Actions secret. GitHub never returns the value of a secret.
+type actionsSecret struct {
+	Name      string           `json:"name"`
+	CreatedAt github.Timestamp `json:"created_at"`
+	UpdatedAt github.Timestamp `json:"updated_at"`
+}
+
+type actionsSecretList struct {
+	TotalCount int             `json:"total_count"`
+	Secrets    []actionsSecret `json:"secrets"`
+}
+
+// actionsVariable is a GitHub Actions configuration variable. Unlike secrets, variables are
+// stored in plain text, so their values are returned.
+type actionsVariable struct {
+	Name      string           `json:"name"`
+	Value     string           `json:"value"`
+	UpdatedAt github.Timestamp `json:"updated_at"`
+}
+
+type actionsVariableList struct {
+	TotalCount int               `json:"total_count"`
+	Variables  []actionsVariable `json:"variables"`
+}
+
+// workflowRun is a run of a workflow with what triggered it, and the API URLs of its jobs and logs.
+type workflowRun struct {
+	ID              int64             `json:"id"`
+	Name            string            `json:"name"`
+	DisplayTitle    string            `json:"display_title,omitempty"`
+	WorkflowID      int64             `json:"workflow_id"`
+	RunNumber       int               `json:"run_number"`
+	RunAttempt      int               `json:"run_attempt"`
+	Event           string            `json:"event"`
+	Status          string            `json:"status"`
+	Conclusion      string            `json:"conclusion,omitempty"`
+	Actor           string            `json:"actor,omitempty"`
+	TriggeringActor string            `json:"triggering_actor,omitempty"`
+	HeadBranch      string            `json:"head_branch"`
+	HeadSHA         string            `json:"head_sha"`
+	PullRequests    []int             `json:"pull_requests,omitempty"`
+	CreatedAt       *github.Timestamp `json:"created_at,omitempty"`
+	RunStartedAt    *github.Timestamp `json:"run_started_at,omitempty"`
+	UpdatedAt       *github.Timestamp `json:"updated_at,omitempty"`
+	HTMLURL         string            `json:"html_url"`
+	JobsURL         string            `json:"jobs_url"`
+	LogsURL         string            `json:"logs_url"`
+}
+
+func newWorkflowRun(r *github.WorkflowRun) workflowRun {
+	run := workflowRun{
+		ID:              r.GetID(),
+		Name:            r.GetName(),
+		DisplayTitle:    r.GetDisplayTitle(),
+		WorkflowID:      r.GetWorkflowID(),
+		RunNumber:       r.GetRunNumber(),
+		RunAttempt:      r.GetRunAttempt(),
+		Event:           r.GetEvent(),
+		Status:          r.GetStatus(),
+		Conclusion:      r.GetConclusion(),
+		Actor:           r.GetActor().GetLogin(),
+		TriggeringActor: r.GetTriggeringActor().GetLogin(),
+		HeadBranch:      r.GetHeadBranch(),
+		HeadSHA:         r.GetHeadSHA(),
+		CreatedAt:       r.CreatedAt,
+		RunStartedAt:    r.RunStartedAt,
+		UpdatedAt:       r.UpdatedAt,
+		HTMLURL:         r.GetHTMLURL(),
+		JobsURL:         r.GetJobsURL(),
+		LogsURL:         r.GetLogsURL(),
+	}
+	for _, pr := range r.PullRequests {
+		run.PullRequests = append(run.PullRequests, pr.GetNumber())
+	}
+	return run
+}
+
+// workflowJobFilters are the executions of a workflow run whose jobs can be listed.
+var workflowJobFilters = []string{"latest", "all"}
+
+// workflowJob is a job of a workflow run with the outcome of each of its steps, enough to find the
+// step that failed without downloading the logs.
+type workflowJob struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Status      string            `json:"status"`
+	Conclusion  string            `json:"conclusion,omitempty"`
+	RunAttempt  int64             `json:"run_attempt"`
+	StartedAt   *github.Timestamp `json:"started_at,omitempty"`
+	CompletedAt *github.Timestamp `json:"completed_at,omitempty"`
+	HTMLURL     string            `json:"html_url"`
+	Steps       []workflowStep    `json:"steps"`
+}
+
+type workflowStep struct {
+	Number     int64  `json:"number"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion,omitempty"`
+}
+
+type workflowJobList struct {
+	TotalCount int           `json:"total_count"`
+	Jobs       []workflowJob `json:"jobs"`
+}
+
+func newWorkflowJob(j *github.WorkflowJob) workflowJob {
+	job := workflowJob{
+		ID:          j.GetID(),
+		Name:        j.GetName(),
+		Status:      j.GetStatus(),
+		Conclusion:  j.GetConclusion(),
+		RunAttempt:  j.GetRunAttempt(),
+		StartedAt:   j.StartedAt,
+		CompletedAt: j.CompletedAt,
+		HTMLURL:     j.GetHTMLURL(),
+		Steps:       make([]workflowStep, 0, len(j.Steps)),
+	}
+	for _, s := range j.Steps {
+		job.Steps = append(job.Steps, workflowStep{
+			Number:     s.GetNumber(),
+			Name:       s.GetName(),
+			Status:     s.GetStatus(),
+			Conclusion: s.GetConclusion(),
+		})
+	}
+	return job
+}
+
+// workflowRunUsage is the billable time of a workflow run for each runner operating system. GitHub
+// only reports it once the run has completed, until then UsageAvailable is false.
+type workflowRunUsage struct {
+	RunID           int64           `json:"run_id"`
+	UsageAvailable  bool            `json:"usage_available"`
+	Message         string          `json:"message,omitempty"`
+	RunDurationMS   *int64          `json:"run_duration_ms,omitempty"`
+	BillableMinutes int64           `json:"billable_minutes"`
+	Billable        []runnerOSUsage `json:"billable"`
+}
+
+// runnerOSUsage is the billable time of the jobs of a workflow run that ran on one operating
+// system, e.g. UBUNTU, MACOS or WINDOWS.
+type runnerOSUsage struct {
+	OS              string `json:"os"`
+	Jobs            int    `json:"jobs"`
+	TotalMS         int64  `json:"total_ms"`
+	BillableMinutes int64  `json:"billable_minutes"`
+}
+
+// billableMinutes converts the billable time of a runner operating system to minutes. GitHub
+// rounds the time of each job up to the next minute, so the jobs are rounded one by one when
+// their times are known.
+func billableMinutes(bill *github.WorkflowRunBill) int64 {
+	if len(bill.JobRuns) == 0 {
+		return (bill.GetTotalMS() + 59999) / 60000
+	}
+	var minutes int64
+	for _, j := range bill.JobRuns {
+		minutes += (j.GetDurationMS() + 59999) / 60000
+	}
+	return minutes
+}
+
+// artifact is a file archive uploaded by a workflow run. Expired artifacts are still listed, but
+// can no longer be downloaded.
+type artifact struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	SizeInBytes int64             `json:"size_in_bytes"`
+	Expired     bool              `json:"expired"`
+	CreatedAt   *github.Timestamp `json:"created_at,omitempty"`
+	ExpiresAt   *github.Timestamp `json:"expires_at,omitempty"`
+}
+
+type artifactList struct {
+	TotalCount int64      `json:"total_count"`
+	Artifacts  []artifact `json:"artifacts"`
+}
+
+// maxArtifactTextSize is the largest artifact, compressed or not, whose files are returned by
+// download_artifact. Larger artifacts are only returned as a download URL.
+const maxArtifactTextSize = 1 << 20
+
+// artifactDownload is a downloaded artifact. Files holds the content of the files in the archive
+// when they are all text and small enough, otherwise Message tells why only the URL is returned.
+type artifactDownload struct {
+	ID          int64          `json:"id"`
+	DownloadURL string         `json:"download_url"`
+	Files       []artifactFile `json:"files,omitempty"`
+	Message     string         `json:"message,omitempty"`
+}
+
+type artifactFile struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// readTextArtifact downloads the zip archive of an artifact and returns the content of its files.
+// When the archive is too large or holds a binary file, it returns no files and the reason.
+// downloadURL is pre-signed and on another host than the API, so it is fetched without the
+// GitHub client, whose transport would send the token along.
+func readTextArtifact(ctx context.Context, downloadURL string) ([]artifactFile, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read response body: %w", err)
+		}
+		return nil, "", fmt.Errorf("failed to fetch artifact archive: %s", string(body))
+	}
+
+	tooLarge := fmt.Sprintf("the artifact is larger than %d bytes, download it from download_url", maxArtifactTextSize)
+	if resp.ContentLength > maxArtifactTextSize {
+		return nil, tooLarge, nil
+	}
+	archive, err := io.ReadAll(io.LimitReader(resp.Body, maxArtifactTextSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read artifact archive: %w", err)
+	}
+	if len(archive) > maxArtifactTextSize {
+		return nil, tooLarge, nil
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open artifact archive: %w", err)
+	}
+	var size uint64
+	for _, f := range zr.File {
+		size += f.UncompressedSize64
+	}
+	if size > maxArtifactTextSize {
+		return nil, tooLarge, nil
+	}
+
+	files := make([]artifactFile, 0, len(zr.File))
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to open %s in artifact archive: %w", f.Name, err)
+		}
+		content, err := io.ReadAll(io.LimitReader(rc, maxArtifactTextSize))
+		_ = rc.Close()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read %s in artifact archive: %w", f.Name, err)
+		}
+		if !utf8.Valid(content) || bytes.IndexByte(content, 0) >= 0 {
+			return nil, fmt.Sprintf("the artifact contains the binary file %s, download it from download_url", f.Name), nil
+		}
+		files = append(files, artifactFile{Name: f.Name, Content: string(content)})
+	}
+	return files, "", nil
+}
+
+// ListActionsSecrets creates a tool to list the names of the GitHub Actions secrets of a repository.
+func ListActionsSecrets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_actions_secrets",
+			mcp.WithDescription(t("TOOL_LIST_ACTIONS_SECRETS_DESCRIPTION", "List the names and update times of the GitHub Actions secrets of a repository. Secret values are never returned. Requires admin access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			secrets, resp, err := client.Actions.ListRepoSecrets(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list actions secrets: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list actions secrets: %s", string(body))), nil
+			}
+
+			result := actionsSecretList{
+				TotalCount: secrets.TotalCount,
+				Secrets:    make([]actionsSecret, 0, len(secrets.Secrets)),
+			}
+			for _, s := range secrets.Secrets {
+				result.Secrets = append(result.Secrets, actionsSecret{
+					Name:      s.Name,
+					CreatedAt: s.CreatedAt,
+					UpdatedAt: s.UpdatedAt,
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// encryptSecret encrypts value for the GitHub Actions API: a libsodium sealed box for the
+// base64-encoded public key of the repository, itself base64-encoded.
+func encryptSecret(publicKey, value string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	if len(decoded) != 32 {
+		return "", fmt.Errorf("invalid public key: expected 32 bytes, got %d", len(decoded))
+	}
+	var key [32]byte
+	copy(key[:], decoded)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &key, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// CreateActionsSecret creates a tool to create or update a GitHub Actions secret of a repository.
+func CreateActionsSecret(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_actions_secret",
+			mcp.WithDescription(t("TOOL_CREATE_ACTIONS_SECRET_DESCRIPTION", "Create or update a GitHub Actions secret of a repository. The value is encrypted with the public key of the repository before it is sent, and is never returned. Requires admin access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("secret_name",
+				mcp.Required(),
+				mcp.Description("Name of the secret, e.g. 'DEPLOY_TOKEN'"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("Value of the secret"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			secretName, err := requiredParam[string](request, "secret_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			value, err := requiredParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			publicKey, resp, err := client.Actions.GetRepoPublicKey(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository not found or not administered by the caller: %s/%s", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository public key: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			encrypted, err := encryptSecret(publicKey.GetKey(), value)
+			if err != nil {
+				return nil, err
+			}
+
+			// The error of a failed request holds the response, never the encrypted value
+			resp, err = client.Actions.CreateOrUpdateRepoSecret(ctx, owner, repo, &github.EncryptedSecret{
+				Name:           secretName,
+				KeyID:          publicKey.GetKeyID(),
+				EncryptedValue: encrypted,
+			})
+			if err != nil {
+				// Invalid secret names are reported as validation failures
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to create secret %s: %s", secretName, err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to create secret: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			switch resp.StatusCode {
+			case http.StatusCreated:
+				return mcp.NewToolResultText(fmt.Sprintf("Created secret %s in %s/%s", secretName, owner, repo)), nil
+			case http.StatusNoContent:
+				return mcp.NewToolResultText(fmt.Sprintf("Updated secret %s in %s/%s", secretName, owner, repo)), nil
+			default:
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create secret: %s", string(body))), nil
+			}
+		}
+}
+
+// ListActionsVariables creates a tool to list the GitHub Actions variables of a repository.
+func ListActionsVariables(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_actions_variables",
+			mcp.WithDescription(t("TOOL_LIST_ACTIONS_VARIABLES_DESCRIPTION", "List the GitHub Actions configuration variables of a repository with their values. Requires admin access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			variables, resp, err := client.Actions.ListRepoVariables(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list actions variables: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list actions variables: %s", string(body))), nil
+			}
+
+			result := actionsVariableList{
+				TotalCount: variables.TotalCount,
+				Variables:  make([]actionsVariable, 0, len(variables.Variables)),
+			}
+			for _, v := range variables.Variables {
+				result.Variables = append(result.Variables, actionsVariable{
+					Name:      v.Name,
+					Value:     v.Value,
+					UpdatedAt: v.GetUpdatedAt(),
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CancelWorkflowRun creates a tool to cancel a GitHub Actions workflow run.
+func CancelWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cancel_workflow_run",
+			mcp.WithDescription(t("TOOL_CANCEL_WORKFLOW_RUN_DESCRIPTION", "Cancel a queued or in-progress GitHub Actions workflow run")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("ID of the workflow run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Actions.CancelWorkflowRunByID(ctx, owner, repo, int64(runID))
+			if err != nil {
+				switch {
+				case resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err):
+					// GitHub cancels the run asynchronously and answers 202, which is not a real error.
+					return mcp.NewToolResultText(fmt.Sprintf("Cancellation of workflow run %d in %s/%s requested", runID, owner, repo)), nil
+				case resp != nil && resp.StatusCode == http.StatusConflict:
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run %d in %s/%s cannot be cancelled, it has already completed", runID, owner, repo)), nil
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run not found: %d in %s/%s", runID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to cancel workflow run: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusAccepted {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to cancel workflow run: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Cancellation of workflow run %d in %s/%s requested", runID, owner, repo)), nil
+		}
+}
+
+// DispatchWorkflow creates a tool to trigger a workflow that runs on workflow_dispatch events.
+func DispatchWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("dispatch_workflow",
+			mcp.WithDescription(t("TOOL_DISPATCH_WORKFLOW_DESCRIPTION", "Manually trigger a GitHub Actions workflow that runs on the workflow_dispatch event")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("workflow_id",
+				mcp.Required(),
+				mcp.Description("File name of the workflow, e.g. 'deploy.yml', or its numeric ID"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch or tag to run the workflow on"),
+			),
+			mcp.WithObject("inputs",
+				mcp.Description("Values of the workflow's inputs, keyed by input name. Inputs that are not given use their default"),
+				mcp.AdditionalProperties(map[string]interface{}{
+					"type": "string",
+				}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			workflowID, err := requiredParam[string](request, "workflow_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := requiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			inputs, err := OptionalParam[map[string]interface{}](request, "inputs")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			for name, value := range inputs {
+				if _, ok := value.(string); !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid input %s, value must be a string", name)), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// The dispatch endpoint accepts a numeric ID in place of the file name.
+			resp, err := client.Actions.CreateWorkflowDispatchEventByFileName(ctx, owner, repo, workflowID, github.CreateWorkflowDispatchEventRequest{
+				Ref:    ref,
+				Inputs: inputs,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow not found: %s in %s/%s", workflowID, owner, repo)), nil
+				}
+				// Missing required inputs, unknown inputs, unknown refs and workflows without a
+				// workflow_dispatch trigger are all reported as validation failures.
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to dispatch workflow: %s", err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to dispatch workflow: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to dispatch workflow: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Dispatched workflow %s on %s in %s/%s", workflowID, ref, owner, repo)), nil
+		}
+}
+
+// GetWorkflowRun creates a tool to get the details of a GitHub Actions workflow run.
+func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_workflow_run",
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_DESCRIPTION", "Get a GitHub Actions workflow run: its status and conclusion, the event and actor that triggered it, its head branch and SHA, and the URLs of its jobs and logs")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("ID of the workflow run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			run, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, int64(runID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run not found: %d in %s/%s", runID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get workflow run: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get workflow run: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newWorkflowRun(run))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListWorkflowJobs creates a tool to list the jobs of a workflow run and the outcome of their steps.
+func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_workflow_jobs",
+			mcp.WithDescription(t("TOOL_LIST_WORKFLOW_JOBS_DESCRIPTION", "List the jobs of a GitHub Actions workflow run with the status and conclusion of each of their steps. Use this to find the step that failed before fetching logs")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("ID of the workflow run"),
+			),
+			mcp.WithString("filter",
+				mcp.Description("'latest' for the jobs of the most recent attempt of the run, 'all' to include the jobs of earlier attempts (default: latest)"),
+				mcp.Enum(workflowJobFilters...),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			filter, err := OptionalParam[string](request, "filter")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if filter != "" && !slices.Contains(workflowJobFilters, filter) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid filter %q, must be one of: %s", filter, strings.Join(workflowJobFilters, ", "))), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListWorkflowJobsOptions{
+				Filter: filter,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, int64(runID), opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run not found: %d in %s/%s", runID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list workflow jobs: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list workflow jobs: %s", string(body))), nil
+			}
+
+			result := workflowJobList{
+				TotalCount: jobs.GetTotalCount(),
+				Jobs:       make([]workflowJob, 0, len(jobs.Jobs)),
+			}
+			for _, j := range jobs.Jobs {
+				result.Jobs = append(result.Jobs, newWorkflowJob(j))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetWorkflowRunUsage creates a tool to get the billable minutes of a workflow run.
+func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_workflow_run_usage",
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_USAGE_DESCRIPTION", "Get the billable minutes of a GitHub Actions workflow run for each runner operating system, and how long the run took. Use this to attribute CI spend to specific runs")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("ID of the workflow run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			usage, resp, err := client.Actions.GetWorkflowRunUsageByID(ctx, owner, repo, int64(runID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run not found: %d in %s/%s", runID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get workflow run usage: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get workflow run usage: %s", string(body))), nil
+			}
+
+			result := workflowRunUsage{
+				RunID:          int64(runID),
+				UsageAvailable: usage.RunDurationMS != nil,
+				RunDurationMS:  usage.RunDurationMS,
+				Billable:       []runnerOSUsage{},
+			}
+			if usage.Billable != nil {
+				for runnerOS, bill := range *usage.Billable {
+					if bill == nil {
+						continue
+					}
+					osUsage := runnerOSUsage{
+						OS:              runnerOS,
+						Jobs:            bill.GetJobs(),
+						TotalMS:         bill.GetTotalMS(),
+						BillableMinutes: billableMinutes(bill),
+					}
+					result.BillableMinutes += osUsage.BillableMinutes
+					result.Billable = append(result.Billable, osUsage)
+				}
+			}
+			slices.SortFunc(result.Billable, func(a, b runnerOSUsage) int {
+				return strings.Compare(a.OS, b.OS)
+			})
+
+			switch {
+			case !result.UsageAvailable:
+				result.Message = "usage is not available yet, GitHub reports it once the run has completed"
+			case len(result.Billable) == 0:
+				result.Message = "the run has no billable minutes, e.g. because it ran in a public repository or on self-hosted runners"
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListArtifacts creates a tool to list the artifacts uploaded by a workflow run.
+func ListArtifacts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_artifacts",
+			mcp.WithDescription(t("TOOL_LIST_ARTIFACTS_DESCRIPTION", "List the artifacts uploaded by a GitHub Actions workflow run, such as build outputs, logs and test reports, with their size and expiration")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("ID of the workflow run"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			artifacts, resp, err := client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, int64(runID), opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow run not found: %d in %s/%s", runID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list artifacts: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list artifacts: %s", string(body))), nil
+			}
+
+			result := artifactList{
+				TotalCount: artifacts.GetTotalCount(),
+				Artifacts:  make([]artifact, 0, len(artifacts.Artifacts)),
+			}
+			for _, a := range artifacts.Artifacts {
+				result.Artifacts = append(result.Artifacts, artifact{
+					ID:          a.GetID(),
+					Name:        a.GetName(),
+					SizeInBytes: a.GetSizeInBytes(),
+					Expired:     a.GetExpired(),
+					CreatedAt:   a.CreatedAt,
+					ExpiresAt:   a.ExpiresAt,
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DownloadArtifact creates a tool to download an artifact of a workflow run.
+func DownloadArtifact(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("download_artifact",
+			mcp.WithDescription(t("TOOL_DOWNLOAD_ARTIFACT_DESCRIPTION", "Download an artifact of a GitHub Actions workflow run. Returns the content of its files when they are small text files, such as logs and reports, and a short-lived download URL of the zip archive otherwise")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("artifact_id",
+				mcp.Required(),
+				mcp.Description("ID of the artifact, as returned by list_artifacts"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			artifactID, err := RequiredInt(request, "artifact_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			downloadURL, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, int64(artifactID), 1)
+			if err != nil {
+				if resp != nil {
+					switch resp.StatusCode {
+					case http.StatusNotFound:
+						return mcp.NewToolResultError(fmt.Sprintf("artifact not found: %d in %s/%s", artifactID, owner, repo)), nil
+					case http.StatusGone:
+						return mcp.NewToolResultError(fmt.Sprintf("artifact %d in %s/%s has expired and can no longer be downloaded", artifactID, owner, repo)), nil
+					}
+				}
+				return nil, fmt.Errorf("failed to download artifact: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			files, message, err := readTextArtifact(ctx, downloadURL.String())
+			if err != nil {
+				return nil, err
+			}
+
+			r, err := json.Marshal(artifactDownload{
+				ID:          int64(artifactID),
+				DownloadURL: downloadURL.String(),
+				Files:       files,
+				Message:     message,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,1621 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func Test_ListActionsSecrets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListActionsSecrets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_actions_secrets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	createdAt := github.Timestamp{Time: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+	updatedAt := github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockSecrets := &github.Secrets{
+		TotalCount: 2,
+		Secrets: []*github.Secret{
+			{Name: "DEPLOY_KEY", CreatedAt: createdAt, UpdatedAt: updatedAt},
+			{Name: "NPM_TOKEN", CreatedAt: createdAt, UpdatedAt: createdAt},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedSecrets actionsSecretList
+		expectedErrMsg  string
+	}{
+		{
+			name: "list secrets",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsSecretsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSecrets),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedSecrets: actionsSecretList{
+				TotalCount: 2,
+				Secrets: []actionsSecret{
+					{Name: "DEPLOY_KEY", CreatedAt: createdAt, UpdatedAt: updatedAt},
+					{Name: "NPM_TOKEN", CreatedAt: createdAt, UpdatedAt: createdAt},
+				},
+			},
+		},
+		{
+			name: "list secrets fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsSecretsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list actions secrets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListActionsSecrets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returned actionsSecretList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			require.Len(t, returned.Secrets, len(tc.expectedSecrets.Secrets))
+			assert.Equal(t, tc.expectedSecrets.TotalCount, returned.TotalCount)
+			for i, secret := range tc.expectedSecrets.Secrets {
+				assert.Equal(t, secret.Name, returned.Secrets[i].Name)
+				assert.True(t, secret.CreatedAt.Equal(returned.Secrets[i].CreatedAt))
+				assert.True(t, secret.UpdatedAt.Equal(returned.Secrets[i].UpdatedAt))
+			}
+		})
+	}
+}
+
+func Test_CreateActionsSecret(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateActionsSecret(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_actions_secret", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "secret_name")
+	assert.Contains(t, tool.InputSchema.Properties, "value")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "secret_name", "value"})
+
+	const secretValue = "s3cr3t-t0k3n"
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	repoPublicKey := &github.PublicKey{
+		KeyID: github.Ptr("568250167242549743"),
+		Key:   github.Ptr(base64.StdEncoding.EncodeToString(publicKey[:])),
+	}
+
+	// expectEncryptedSecret checks the secret is sent encrypted for the repository public key
+	expectEncryptedSecret := func(status int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var secret github.EncryptedSecret
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&secret))
+			assert.Equal(t, repoPublicKey.GetKeyID(), secret.KeyID)
+
+			sealed, err := base64.StdEncoding.DecodeString(secret.EncryptedValue)
+			require.NoError(t, err)
+			value, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+			require.True(t, ok, "secret is not sealed for the repository public key")
+			assert.Equal(t, secretValue, string(value))
+
+			w.WriteHeader(status)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectToolErr  bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "create secret",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsSecretsPublicKeyByOwnerByRepo,
+					repoPublicKey,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposActionsSecretsByOwnerByRepoBySecretName,
+					expectEncryptedSecret(http.StatusCreated),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"secret_name": "DEPLOY_TOKEN",
+				"value":       secretValue,
+			},
+			expectError:  false,
+			expectedText: "Created secret DEPLOY_TOKEN in owner/repo",
+		},
+		{
+			name: "update secret",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsSecretsPublicKeyByOwnerByRepo,
+					repoPublicKey,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposActionsSecretsByOwnerByRepoBySecretName,
+					expectEncryptedSecret(http.StatusNoContent),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"secret_name": "DEPLOY_TOKEN",
+				"value":       secretValue,
+			},
+			expectError:  false,
+			expectedText: "Updated secret DEPLOY_TOKEN in owner/repo",
+		},
+		{
+			name: "invalid secret name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsSecretsPublicKeyByOwnerByRepo,
+					repoPublicKey,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposActionsSecretsByOwnerByRepoBySecretName,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Secret names must not start with GITHUB_"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"secret_name": "GITHUB_TOKEN",
+				"value":       secretValue,
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "Secret names must not start with GITHUB_",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsSecretsPublicKeyByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "missing",
+				"secret_name": "DEPLOY_TOKEN",
+				"value":       secretValue,
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "repository not found or not administered by the caller: owner/missing",
+		},
+		{
+			name: "public key is not base64",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsSecretsPublicKeyByOwnerByRepo,
+					&github.PublicKey{KeyID: github.Ptr("1"), Key: github.Ptr("not base64!")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"secret_name": "DEPLOY_TOKEN",
+				"value":       secretValue,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to decode public key",
+		},
+		{
+			name: "public key too short",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsSecretsPublicKeyByOwnerByRepo,
+					&github.PublicKey{KeyID: github.Ptr("1"), Key: github.Ptr(base64.StdEncoding.EncodeToString(publicKey[:16]))},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"secret_name": "DEPLOY_TOKEN",
+				"value":       secretValue,
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid public key: expected 32 bytes, got 16",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateActionsSecret(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				assert.NotContains(t, err.Error(), secretValue)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.NotContains(t, textContent.Text, secretValue)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedText)
+				return
+			}
+
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_ListActionsVariables(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListActionsVariables(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_actions_variables", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	updatedAt := github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockVariables := &github.ActionsVariables{
+		TotalCount: 1,
+		Variables: []*github.ActionsVariable{
+			{Name: "GO_VERSION", Value: "1.23", UpdatedAt: &updatedAt},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedVariables actionsVariableList
+		expectedErrMsg    string
+	}{
+		{
+			name: "list variables",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsVariablesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockVariables),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError: false,
+			expectedVariables: actionsVariableList{
+				TotalCount: 1,
+				Variables: []actionsVariable{
+					{Name: "GO_VERSION", Value: "1.23", UpdatedAt: updatedAt},
+				},
+			},
+		},
+		{
+			name: "list variables fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsVariablesByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list actions variables",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListActionsVariables(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returned actionsVariableList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			require.Len(t, returned.Variables, len(tc.expectedVariables.Variables))
+			assert.Equal(t, tc.expectedVariables.TotalCount, returned.TotalCount)
+			for i, variable := range tc.expectedVariables.Variables {
+				assert.Equal(t, variable.Name, returned.Variables[i].Name)
+				assert.Equal(t, variable.Value, returned.Variables[i].Value)
+				assert.True(t, variable.UpdatedAt.Equal(returned.Variables[i].UpdatedAt))
+			}
+		})
+	}
+}
+
+func Test_CancelWorkflowRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CancelWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "cancel_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "cancellation requested",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsCancelByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusAccepted, map[string]any{}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError:  false,
+			expectedText: "Cancellation of workflow run 42 in owner/repo requested",
+		},
+		{
+			name: "run already completed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsCancelByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "Cannot cancel a workflow run that is completed."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError:  false,
+			expectedText: "workflow run 42 in owner/repo cannot be cancelled, it has already completed",
+		},
+		{
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsCancelByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(7),
+			},
+			expectError:  false,
+			expectedText: "workflow run not found: 7 in owner/repo",
+		},
+		{
+			name:         "missing run_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:  false,
+			expectedText: "missing required parameter: run_id",
+		},
+		{
+			name: "cancel workflow run fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunsCancelByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to cancel workflow run",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CancelWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_DispatchWorkflow(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DispatchWorkflow(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "dispatch_workflow", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "workflow_id")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "inputs")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "workflow_id", "ref"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectToolErr  bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "dispatch with inputs",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "main",
+						"inputs": map[string]interface{}{
+							"environment": "staging",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "deploy.yml",
+				"ref":         "main",
+				"inputs": map[string]interface{}{
+					"environment": "staging",
+				},
+			},
+			expectError:  false,
+			expectedText: "Dispatched workflow deploy.yml on main in owner/repo",
+		},
+		{
+			name: "dispatch by numeric ID without inputs",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "v1.0.0",
+					}).andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "161335",
+				"ref":         "v1.0.0",
+			},
+			expectError:  false,
+			expectedText: "Dispatched workflow 161335 on v1.0.0 in owner/repo",
+		},
+		{
+			name: "missing required input",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Required input 'environment' not provided"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "deploy.yml",
+				"ref":         "main",
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "Required input 'environment' not provided",
+		},
+		{
+			name: "workflow not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "missing.yml",
+				"ref":         "main",
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "workflow not found: missing.yml in owner/repo",
+		},
+		{
+			name:         "non-string input",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "deploy.yml",
+				"ref":         "main",
+				"inputs": map[string]interface{}{
+					"dry_run": true,
+				},
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "invalid input dry_run, value must be a string",
+		},
+		{
+			name: "dispatch workflow fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "deploy.yml",
+				"ref":         "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to dispatch workflow",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DispatchWorkflow(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedText)
+				return
+			}
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_GetWorkflowRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	mockRun := &github.WorkflowRun{
+		ID:              github.Ptr(int64(42)),
+		Name:            github.Ptr("CI"),
+		DisplayTitle:    github.Ptr("Fix the build"),
+		WorkflowID:      github.Ptr(int64(7)),
+		RunNumber:       github.Ptr(101),
+		RunAttempt:      github.Ptr(2),
+		Event:           github.Ptr("pull_request"),
+		Status:          github.Ptr("completed"),
+		Conclusion:      github.Ptr("failure"),
+		Actor:           &github.User{Login: github.Ptr("octocat")},
+		TriggeringActor: &github.User{Login: github.Ptr("hubot")},
+		HeadBranch:      github.Ptr("fix-build"),
+		HeadSHA:         github.Ptr("abc123"),
+		PullRequests:    []*github.PullRequest{{Number: github.Ptr(12)}},
+		CreatedAt:       &github.Timestamp{Time: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)},
+		RunStartedAt:    &github.Timestamp{Time: time.Date(2025, 3, 1, 10, 5, 0, 0, time.UTC)},
+		UpdatedAt:       &github.Timestamp{Time: time.Date(2025, 3, 1, 10, 15, 0, 0, time.UTC)},
+		HTMLURL:         github.Ptr("https://github.com/owner/repo/actions/runs/42"),
+		JobsURL:         github.Ptr("https://api.github.com/repos/owner/repo/actions/runs/42/jobs"),
+		LogsURL:         github.Ptr("https://api.github.com/repos/owner/repo/actions/runs/42/logs"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "get workflow run",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockRun,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectedResult: `{
+				"id": 42,
+				"name": "CI",
+				"display_title": "Fix the build",
+				"workflow_id": 7,
+				"run_number": 101,
+				"run_attempt": 2,
+				"event": "pull_request",
+				"status": "completed",
+				"conclusion": "failure",
+				"actor": "octocat",
+				"triggering_actor": "hubot",
+				"head_branch": "fix-build",
+				"head_sha": "abc123",
+				"pull_requests": [12],
+				"created_at": "2025-03-01T10:00:00Z",
+				"run_started_at": "2025-03-01T10:05:00Z",
+				"updated_at": "2025-03-01T10:15:00Z",
+				"html_url": "https://github.com/owner/repo/actions/runs/42",
+				"jobs_url": "https://api.github.com/repos/owner/repo/actions/runs/42/jobs",
+				"logs_url": "https://api.github.com/repos/owner/repo/actions/runs/42/logs"
+			}`,
+		},
+		{
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(7),
+			},
+			expectedErrMsg: "workflow run not found: 7 in owner/repo",
+		},
+		{
+			name: "get workflow run fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get workflow run",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
+
+func Test_ListWorkflowJobs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWorkflowJobs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_workflow_jobs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "filter")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	mockJobs := &github.Jobs{
+		TotalCount: github.Ptr(2),
+		Jobs: []*github.WorkflowJob{
+			{
+				ID:         github.Ptr(int64(1)),
+				Name:       github.Ptr("build"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("success"),
+				RunAttempt: github.Ptr(int64(1)),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/42/job/1"),
+				Steps: []*github.TaskStep{
+					{Number: github.Ptr(int64(1)), Name: github.Ptr("Set up job"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+				},
+			},
+			{
+				ID:         github.Ptr(int64(2)),
+				Name:       github.Ptr("test"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+				RunAttempt: github.Ptr(int64(1)),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/42/job/2"),
+				Steps: []*github.TaskStep{
+					{Number: github.Ptr(int64(1)), Name: github.Ptr("Set up job"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+					{Number: github.Ptr(int64(2)), Name: github.Ptr("Run tests"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+					{Number: github.Ptr(int64(3)), Name: github.Ptr("Upload coverage"), Status: github.Ptr("completed"), Conclusion: github.Ptr("skipped")},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectToolErr  bool
+		expectedJobs   workflowJobList
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "list jobs of the latest attempt",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockJobs),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError: false,
+			expectedJobs: workflowJobList{
+				TotalCount: 2,
+				Jobs: []workflowJob{
+					{
+						ID:         1,
+						Name:       "build",
+						Status:     "completed",
+						Conclusion: "success",
+						RunAttempt: 1,
+						HTMLURL:    "https://github.com/owner/repo/actions/runs/42/job/1",
+						Steps: []workflowStep{
+							{Number: 1, Name: "Set up job", Status: "completed", Conclusion: "success"},
+						},
+					},
+					{
+						ID:         2,
+						Name:       "test",
+						Status:     "completed",
+						Conclusion: "failure",
+						RunAttempt: 1,
+						HTMLURL:    "https://github.com/owner/repo/actions/runs/42/job/2",
+						Steps: []workflowStep{
+							{Number: 1, Name: "Set up job", Status: "completed", Conclusion: "success"},
+							{Number: 2, Name: "Run tests", Status: "completed", Conclusion: "failure"},
+							{Number: 3, Name: "Upload coverage", Status: "completed", Conclusion: "skipped"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "list jobs of all attempts",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					expectQueryParams(t, map[string]string{
+						"filter":   "all",
+						"page":     "2",
+						"per_page": "1",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Jobs{TotalCount: github.Ptr(0)}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"run_id":  float64(42),
+				"filter":  "all",
+				"page":    float64(2),
+				"perPage": float64(1),
+			},
+			expectError:  false,
+			expectedJobs: workflowJobList{Jobs: []workflowJob{}},
+		},
+		{
+			name:         "invalid filter",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+				"filter": "failed",
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  `invalid filter "failed", must be one of: latest, all`,
+		},
+		{
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(7),
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "workflow run not found: 7 in owner/repo",
+		},
+		{
+			name: "list workflow jobs fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list workflow jobs",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWorkflowJobs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedJobs workflowJobList
+			err = json.Unmarshal([]byte(textContent.Text), &returnedJobs)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedJobs, returnedJobs)
+		})
+	}
+}
+
+func Test_GetWorkflowRunUsage(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowRunUsage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_workflow_run_usage", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	mockUsage := &github.WorkflowRunUsage{
+		Billable: &github.WorkflowRunBillMap{
+			"UBUNTU": {
+				TotalMS: github.Ptr(int64(150000)),
+				Jobs:    github.Ptr(2),
+				JobRuns: []*github.WorkflowRunJobRun{
+					{JobID: github.Ptr(1), DurationMS: github.Ptr(int64(30000))},
+					{JobID: github.Ptr(2), DurationMS: github.Ptr(int64(120000))},
+				},
+			},
+			"MACOS": {
+				TotalMS: github.Ptr(int64(61000)),
+				Jobs:    github.Ptr(1),
+			},
+		},
+		RunDurationMS: github.Ptr(int64(240000)),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectToolErr  bool
+		expectedUsage  workflowRunUsage
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "get usage of a completed run",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsTimingByOwnerByRepoByRunId,
+					mockUsage,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError: false,
+			expectedUsage: workflowRunUsage{
+				RunID:           42,
+				UsageAvailable:  true,
+				RunDurationMS:   github.Ptr(int64(240000)),
+				BillableMinutes: 5,
+				Billable: []runnerOSUsage{
+					{OS: "MACOS", Jobs: 1, TotalMS: 61000, BillableMinutes: 2},
+					{OS: "UBUNTU", Jobs: 2, TotalMS: 150000, BillableMinutes: 3},
+				},
+			},
+		},
+		{
+			name: "usage not available yet",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsTimingByOwnerByRepoByRunId,
+					&github.WorkflowRunUsage{Billable: &github.WorkflowRunBillMap{}},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(43),
+			},
+			expectError: false,
+			expectedUsage: workflowRunUsage{
+				RunID:          43,
+				UsageAvailable: false,
+				Message:        "usage is not available yet, GitHub reports it once the run has completed",
+				Billable:       []runnerOSUsage{},
+			},
+		},
+		{
+			name: "run without billable minutes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsTimingByOwnerByRepoByRunId,
+					&github.WorkflowRunUsage{Billable: &github.WorkflowRunBillMap{}, RunDurationMS: github.Ptr(int64(5000))},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(44),
+			},
+			expectError: false,
+			expectedUsage: workflowRunUsage{
+				RunID:          44,
+				UsageAvailable: true,
+				Message:        "the run has no billable minutes, e.g. because it ran in a public repository or on self-hosted runners",
+				RunDurationMS:  github.Ptr(int64(5000)),
+				Billable:       []runnerOSUsage{},
+			},
+		},
+		{
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsTimingByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(7),
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "workflow run not found: 7 in owner/repo",
+		},
+		{
+			name: "get workflow run usage fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsTimingByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get workflow run usage",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWorkflowRunUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedUsage workflowRunUsage
+			err = json.Unmarshal([]byte(textContent.Text), &returnedUsage)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedUsage, returnedUsage)
+		})
+	}
+}
+
+func Test_ListArtifacts(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListArtifacts(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_artifacts", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	expiresAt := &github.Timestamp{Time: time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)}
+	mockArtifacts := &github.ArtifactList{
+		TotalCount: github.Ptr(int64(2)),
+		Artifacts: []*github.Artifact{
+			{ID: github.Ptr(int64(11)), Name: github.Ptr("test-report"), SizeInBytes: github.Ptr(int64(2048)), Expired: github.Ptr(false), ExpiresAt: expiresAt},
+			{ID: github.Ptr(int64(12)), Name: github.Ptr("coverage"), SizeInBytes: github.Ptr(int64(4096)), Expired: github.Ptr(true), ExpiresAt: expiresAt},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectToolErr     bool
+		expectedArtifacts artifactList
+		expectedText      string
+		expectedErrMsg    string
+	}{
+		{
+			name: "list artifacts",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsArtifactsByOwnerByRepoByRunId,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockArtifacts),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError: false,
+			expectedArtifacts: artifactList{
+				TotalCount: 2,
+				Artifacts: []artifact{
+					{ID: 11, Name: "test-report", SizeInBytes: 2048, Expired: false, ExpiresAt: expiresAt},
+					{ID: 12, Name: "coverage", SizeInBytes: 4096, Expired: true, ExpiresAt: expiresAt},
+				},
+			},
+		},
+		{
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsArtifactsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(7),
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "workflow run not found: 7 in owner/repo",
+		},
+		{
+			name: "list artifacts fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsArtifactsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list artifacts",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListArtifacts(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedArtifacts artifactList
+			err = json.Unmarshal([]byte(textContent.Text), &returnedArtifacts)
+			require.NoError(t, err)
+			require.Len(t, returnedArtifacts.Artifacts, len(tc.expectedArtifacts.Artifacts))
+			assert.Equal(t, tc.expectedArtifacts.TotalCount, returnedArtifacts.TotalCount)
+			for i, expected := range tc.expectedArtifacts.Artifacts {
+				assert.Equal(t, expected.ID, returnedArtifacts.Artifacts[i].ID)
+				assert.Equal(t, expected.Name, returnedArtifacts.Artifacts[i].Name)
+				assert.Equal(t, expected.SizeInBytes, returnedArtifacts.Artifacts[i].SizeInBytes)
+				assert.Equal(t, expected.Expired, returnedArtifacts.Artifacts[i].Expired)
+				assert.True(t, expected.ExpiresAt.Equal(*returnedArtifacts.Artifacts[i].ExpiresAt))
+			}
+		})
+	}
+}
+
+// zipArchive returns a zip archive holding files, keyed by their name.
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func Test_DownloadArtifact(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DownloadArtifact(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "download_artifact", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "artifact_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "artifact_id"})
+
+	// The pre-signed URLs an artifact download is redirected to are on another host than the
+	// API, which must not get the GitHub token
+	archives := map[string][]byte{
+		"/text.zip":   zipArchive(t, map[string]string{"report.txt": "42 tests passed\n"}),
+		"/binary.zip": zipArchive(t, map[string]string{"app": "\x7fELF\x00\x01"}),
+		"/large.zip":  zipArchive(t, map[string]string{"build.log": strings.Repeat("a", maxArtifactTextSize+1)}),
+	}
+	archiveHost := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("artifact archive request has an Authorization header: %q", auth)
+		}
+		archive, ok := archives[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write(archive)
+	}))
+	defer archiveHost.Close()
+	redirectTo := func(archiveURL string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, archiveURL, http.StatusFound)
+		}
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectToolErr    bool
+		expectedDownload artifactDownload
+		expectedText     string
+		expectedErrMsg   string
+	}{
+		{
+			name: "download text artifact",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+					redirectTo(archiveHost.URL+"/text.zip"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(11),
+			},
+			expectError: false,
+			expectedDownload: artifactDownload{
+				ID:          11,
+				DownloadURL: archiveHost.URL + "/text.zip",
+				Files:       []artifactFile{{Name: "report.txt", Content: "42 tests passed\n"}},
+			},
+		},
+		{
+			name: "download binary artifact",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+					redirectTo(archiveHost.URL+"/binary.zip"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(11),
+			},
+			expectError: false,
+			expectedDownload: artifactDownload{
+				ID:          11,
+				DownloadURL: archiveHost.URL + "/binary.zip",
+				Message:     "the artifact contains the binary file app, download it from download_url",
+			},
+		},
+		{
+			name: "download large artifact",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+					redirectTo(archiveHost.URL+"/large.zip"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(11),
+			},
+			expectError: false,
+			expectedDownload: artifactDownload{
+				ID:          11,
+				DownloadURL: archiveHost.URL + "/large.zip",
+				Message:     "the artifact is larger than 1048576 bytes, download it from download_url",
+			},
+		},
+		{
+			name: "artifact expired",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+					mockResponse(t, http.StatusGone, map[string]string{"message": "Artifact has expired"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(12),
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "artifact 12 in owner/repo has expired and can no longer be downloaded",
+		},
+		{
+			name: "artifact not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(13),
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "artifact not found: 13 in owner/repo",
+		},
+		{
+			name: "download artifact fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsArtifactsByOwnerByRepoByArtifactIdByArchiveFormat,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"artifact_id": float64(11),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to download artifact",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient).WithAuthToken("test-token")
+			_, handler := DownloadArtifact(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedDownload artifactDownload
+			err = json.Unmarshal([]byte(textContent.Text), &returnedDownload)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDownload, returnedDownload)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// withStrictArguments wraps the handler of tool so that calls passing arguments the tool does
+// not declare fail instead of having them silently ignored. A model inventing parameters, or a
+// description out of step with the schema, then shows up as an error naming the extra arguments.
+func withStrictArguments(tool mcp.Tool, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	declared := declaredArguments(tool)
+	accepted := make([]string, 0, len(declared))
+	for name := range declared {
+		accepted = append(accepted, name)
+	}
+	sort.Strings(accepted)
+
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var unexpected []string
+		for name := range request.Params.Arguments {
+			if _, ok := declared[name]; !ok {
+				unexpected = append(unexpected, name)
+			}
+		}
+		if len(unexpected) > 0 {
+			sort.Strings(unexpected)
+			return mcp.NewToolResultError(fmt.Sprintf("unexpected arguments for %s: %s; accepted arguments are: %s",
+				tool.Name, strings.Join(unexpected, ", "), strings.Join(accepted, ", "))), nil
+		}
+		return handler(ctx, request)
+	}
+}
+
+// declaredArguments returns the names of the parameters in the input schema of tool, which is
+// either built with tool options or given as raw JSON.
+func declaredArguments(tool mcp.Tool) map[string]struct{} {
+	properties := map[string]struct{}{}
+	if tool.RawInputSchema != nil {
+		var schema struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		}
+		// A schema that does not parse declares no parameters, so every argument is rejected
+		_ = json.Unmarshal(tool.RawInputSchema, &schema)
+		for name := range schema.Properties {
+			properties[name] = struct{}{}
+		}
+		return properties
+	}
+	for name := range tool.InputSchema.Properties {
+		properties[name] = struct{}{}
+	}
+	return properties
+}
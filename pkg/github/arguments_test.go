@@ -0,0 +1,103 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WithStrictArguments(t *testing.T) {
+	called := false
+	handler := func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		called = true
+		return mcp.NewToolResultText("ok"), nil
+	}
+
+	tests := []struct {
+		name         string
+		tool         mcp.Tool
+		args         map[string]interface{}
+		expectCalled bool
+		expectedText string
+	}{
+		{
+			name:         "declared arguments",
+			tool:         mcp.NewTool("get_issue", mcp.WithString("owner"), mcp.WithString("repo"), mcp.WithNumber("issue_number")),
+			args:         map[string]interface{}{"owner": "owner", "repo": "repo"},
+			expectCalled: true,
+			expectedText: "ok",
+		},
+		{
+			name:         "undeclared arguments",
+			tool:         mcp.NewTool("get_issue", mcp.WithString("owner"), mcp.WithString("repo"), mcp.WithNumber("issue_number")),
+			args:         map[string]interface{}{"owner": "owner", "repo": "repo", "number": float64(1), "include_comments": true},
+			expectCalled: false,
+			expectedText: "unexpected arguments for get_issue: include_comments, number; accepted arguments are: issue_number, owner, repo",
+		},
+		{
+			name:         "raw input schema",
+			tool:         mcp.NewToolWithRawSchema("graphql", "", json.RawMessage(`{"type": "object", "properties": {"query": {"type": "string"}}}`)),
+			args:         map[string]interface{}{"query": "{ viewer { login } }", "variables": map[string]interface{}{}},
+			expectCalled: false,
+			expectedText: "unexpected arguments for graphql: variables; accepted arguments are: query",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			called = false
+			result, err := withStrictArguments(tc.tool, handler)(context.Background(), createMCPRequest(tc.args))
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectCalled, called)
+			assert.Equal(t, !tc.expectCalled, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_NewServer_StrictArguments(t *testing.T) {
+	type callResult struct {
+		IsError bool `json:"isError"`
+		Content []struct {
+			Text string `json:"text"`
+		} `json:"content"`
+	}
+	callGetMe := func(cfg ServerConfig) callResult {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetUser,
+				&github.User{Login: github.Ptr("octocat")},
+			),
+		))
+		s := NewServer(stubGetClientFn(client), "test", cfg, translations.NullTranslationHelper)
+
+		response := s.HandleMessage(context.Background(), []byte(`{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "get_me", "arguments": {"reason": "greeting", "verbose": true}}}`))
+		b, err := json.Marshal(response)
+		require.NoError(t, err)
+
+		var callResponse struct {
+			Result callResult `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(b, &callResponse))
+		require.Len(t, callResponse.Result.Content, 1)
+		return callResponse.Result
+	}
+
+	// Lenient mode ignores the extra argument
+	lenient := callGetMe(ServerConfig{})
+	assert.False(t, lenient.IsError)
+	assert.Contains(t, lenient.Content[0].Text, "octocat")
+
+	// Strict mode rejects the call, naming the extra argument
+	strict := callGetMe(ServerConfig{StrictArguments: true})
+	assert.True(t, strict.IsError)
+	assert.Equal(t, "unexpected arguments for get_me: verbose; accepted arguments are: reason", strict.Content[0].Text)
+}
@@ -0,0 +1,315 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// branchProtection is the subset of a branch's protection returned by the branch protection tools.
+// Unprotected branches are reported with Protected set to false and every rule left empty.
+type branchProtection struct {
+	Branch                string                  `json:"branch"`
+	Protected             bool                    `json:"protected"`
+	RequiredStatusChecks  *protectionStatusChecks `json:"required_status_checks,omitempty"`
+	RequiredReviews       *protectionReviews      `json:"required_pull_request_reviews,omitempty"`
+	EnforceAdmins         bool                    `json:"enforce_admins"`
+	RequiredLinearHistory bool                    `json:"required_linear_history"`
+	AllowForcePushes      bool                    `json:"allow_force_pushes"`
+	AllowDeletions        bool                    `json:"allow_deletions"`
+	Restrictions          *protectionRestrictions `json:"restrictions,omitempty"`
+}
+
+type protectionStatusChecks struct {
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+type protectionReviews struct {
+	RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+	DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+	RequireLastPushApproval      bool `json:"require_last_push_approval"`
+}
+
+// protectionRestrictions lists who may push to the branch. It is only present when pushes are restricted.
+type protectionRestrictions struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+	Apps  []string `json:"apps"`
+}
+
+func newBranchProtection(branch string, p *github.Protection) branchProtection {
+	bp := branchProtection{
+		Branch:                branch,
+		Protected:             true,
+		EnforceAdmins:         p.EnforceAdmins != nil && p.EnforceAdmins.Enabled,
+		RequiredLinearHistory: p.RequireLinearHistory != nil && p.RequireLinearHistory.Enabled,
+		AllowForcePushes:      p.AllowForcePushes != nil && p.AllowForcePushes.Enabled,
+		AllowDeletions:        p.AllowDeletions != nil && p.AllowDeletions.Enabled,
+	}
+	if checks := p.GetRequiredStatusChecks(); checks != nil {
+		bp.RequiredStatusChecks = &protectionStatusChecks{Strict: checks.Strict, Contexts: []string{}}
+		// Checks supersedes the deprecated Contexts list, but older protections may only have the latter.
+		if checks.Checks != nil {
+			for _, c := range *checks.Checks {
+				bp.RequiredStatusChecks.Contexts = append(bp.RequiredStatusChecks.Contexts, c.Context)
+			}
+		} else if checks.Contexts != nil {
+			bp.RequiredStatusChecks.Contexts = append(bp.RequiredStatusChecks.Contexts, *checks.Contexts...)
+		}
+	}
+	if reviews := p.GetRequiredPullRequestReviews(); reviews != nil {
+		bp.RequiredReviews = &protectionReviews{
+			RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+			DismissStaleReviews:          reviews.DismissStaleReviews,
+			RequireCodeOwnerReviews:      reviews.RequireCodeOwnerReviews,
+			RequireLastPushApproval:      reviews.RequireLastPushApproval,
+		}
+	}
+	if restrictions := p.GetRestrictions(); restrictions != nil {
+		bp.Restrictions = &protectionRestrictions{Users: []string{}, Teams: []string{}, Apps: []string{}}
+		for _, u := range restrictions.Users {
+			bp.Restrictions.Users = append(bp.Restrictions.Users, u.GetLogin())
+		}
+		for _, team := range restrictions.Teams {
+			bp.Restrictions.Teams = append(bp.Restrictions.Teams, team.GetSlug())
+		}
+		for _, app := range restrictions.Apps {
+			bp.Restrictions.Apps = append(bp.Restrictions.Apps, app.GetSlug())
+		}
+	}
+	return bp
+}
+
+// GetBranchProtection creates a tool to get the protection rules of a branch.
+func GetBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch_protection",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_PROTECTION_DESCRIPTION", "Get the protection rules of a branch: required status checks, required reviews, admin enforcement and push restrictions. Requires admin access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result := branchProtection{Branch: branch}
+			protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+			switch {
+			case errors.Is(err, github.ErrBranchNotProtected):
+				// Not an error for the caller: an unprotected branch is a valid answer.
+			case err != nil:
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("branch not found: %s in %s/%s", branch, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get branch protection: %w", err)
+			default:
+				defer func() { _ = resp.Body.Close() }()
+
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					if err != nil {
+						return nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get branch protection: %s", string(body))), nil
+				}
+				result = newBranchProtection(branch, protection)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// maxRequiredApprovingReviews is the largest number of approving reviews GitHub lets a branch require.
+const maxRequiredApprovingReviews = 6
+
+// UpdateBranchProtection creates a tool to replace the protection rules of a branch.
+func UpdateBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_branch_protection",
+			mcp.WithDescription(t("TOOL_UPDATE_BRANCH_PROTECTION_DESCRIPTION", "Protect a branch or replace its protection rules. Rules that are not provided are disabled, and existing push restrictions are removed. Requires admin access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+			mcp.WithNumber("required_approving_review_count",
+				mcp.Description("Require pull requests with this many approving reviews (0-6) before merging. Omit to allow pushing without a pull request"),
+				mcp.Min(0),
+				mcp.Max(maxRequiredApprovingReviews),
+			),
+			mcp.WithBoolean("dismiss_stale_reviews",
+				mcp.Description("Dismiss approvals when new commits are pushed, requires required_approving_review_count"),
+			),
+			mcp.WithBoolean("require_code_owner_reviews",
+				mcp.Description("Require an approval from a code owner, requires required_approving_review_count"),
+			),
+			mcp.WithArray("status_checks",
+				mcp.Description("Status check contexts that must pass before merging. Omit to not require status checks"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithBoolean("strict",
+				mcp.Description("Require branches to be up to date with the base branch before merging, requires status_checks"),
+			),
+			mcp.WithBoolean("enforce_admins",
+				mcp.Description("Apply the rules to repository administrators too (default: false)"),
+			),
+			mcp.WithBoolean("required_linear_history",
+				mcp.Description("Prevent merge commits from being pushed to the branch (default: false)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewCount, hasReviews, err := OptionalParamOK[float64](request, "required_approving_review_count")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dismissStale, hasDismissStale, err := OptionalParamOK[bool](request, "dismiss_stale_reviews")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			codeOwners, hasCodeOwners, err := OptionalParamOK[bool](request, "require_code_owner_reviews")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			strict, hasStrict, err := OptionalParamOK[bool](request, "strict")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			enforceAdmins, err := OptionalParam[bool](request, "enforce_admins")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			linearHistory, err := OptionalParam[bool](request, "required_linear_history")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			protectionRequest := &github.ProtectionRequest{
+				EnforceAdmins:        enforceAdmins,
+				RequireLinearHistory: github.Ptr(linearHistory),
+			}
+
+			if hasReviews {
+				if reviewCount < 0 || reviewCount > maxRequiredApprovingReviews || reviewCount != float64(int(reviewCount)) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid required_approving_review_count %v, must be a whole number from 0 to %d", reviewCount, maxRequiredApprovingReviews)), nil
+				}
+				protectionRequest.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+					RequiredApprovingReviewCount: int(reviewCount),
+					DismissStaleReviews:          dismissStale,
+					RequireCodeOwnerReviews:      codeOwners,
+				}
+			} else if hasDismissStale || hasCodeOwners {
+				return mcp.NewToolResultError("dismiss_stale_reviews and require_code_owner_reviews require required_approving_review_count"), nil
+			}
+
+			// An empty list is meaningful (require an up to date branch without any checks), so
+			// status checks are enabled whenever the field was provided.
+			if _, ok := request.Params.Arguments["status_checks"]; ok {
+				contexts, err := OptionalStringArrayParam(request, "status_checks")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				checks := make([]*github.RequiredStatusCheck, 0, len(contexts))
+				for _, c := range contexts {
+					checks = append(checks, &github.RequiredStatusCheck{Context: c})
+				}
+				protectionRequest.RequiredStatusChecks = &github.RequiredStatusChecks{
+					Strict: strict,
+					Checks: &checks,
+				}
+			} else if hasStrict {
+				return mcp.NewToolResultError("strict requires status_checks"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			protection, resp, err := client.Repositories.UpdateBranchProtection(ctx, owner, repo, branch, protectionRequest)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("branch not found: %s in %s/%s", branch, owner, repo)), nil
+				}
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to update branch protection: %s", err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to update branch protection: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update branch protection: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newBranchProtection(branch, protection))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,403 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetBranchProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_branch_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mockProtection := &github.Protection{
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Strict:   true,
+			Contexts: &[]string{"ci/build"},
+			Checks:   &[]*github.RequiredStatusCheck{{Context: "ci/build"}, {Context: "ci/lint"}},
+		},
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+			RequiredApprovingReviewCount: 2,
+			DismissStaleReviews:          true,
+			RequireCodeOwnerReviews:      true,
+		},
+		EnforceAdmins:        &github.AdminEnforcement{Enabled: true},
+		RequireLinearHistory: &github.RequireLinearHistory{Enabled: true},
+		Restrictions: &github.BranchRestrictions{
+			Users: []*github.User{{Login: github.Ptr("octocat")}},
+			Teams: []*github.Team{{Slug: github.Ptr("release")}},
+			Apps:  []*github.App{},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedProtection branchProtection
+		expectedErrMsg     string
+	}{
+		{
+			name: "protected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockProtection,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError: false,
+			expectedProtection: branchProtection{
+				Branch:    "main",
+				Protected: true,
+				RequiredStatusChecks: &protectionStatusChecks{
+					Strict:   true,
+					Contexts: []string{"ci/build", "ci/lint"},
+				},
+				RequiredReviews: &protectionReviews{
+					RequiredApprovingReviewCount: 2,
+					DismissStaleReviews:          true,
+					RequireCodeOwnerReviews:      true,
+				},
+				EnforceAdmins:         true,
+				RequiredLinearHistory: true,
+				Restrictions: &protectionRestrictions{
+					Users: []string{"octocat"},
+					Teams: []string{"release"},
+					Apps:  []string{},
+				},
+			},
+		},
+		{
+			name: "unprotected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "feature",
+			},
+			expectError:        false,
+			expectedProtection: branchProtection{Branch: "feature", Protected: false},
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "branch not found: missing in owner/repo",
+		},
+		{
+			name: "get branch protection fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get branch protection",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned branchProtection
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedProtection, returned)
+		})
+	}
+}
+
+func Test_UpdateBranchProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_branch_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "required_approving_review_count")
+	assert.Contains(t, tool.InputSchema.Properties, "dismiss_stale_reviews")
+	assert.Contains(t, tool.InputSchema.Properties, "require_code_owner_reviews")
+	assert.Contains(t, tool.InputSchema.Properties, "status_checks")
+	assert.Contains(t, tool.InputSchema.Properties, "strict")
+	assert.Contains(t, tool.InputSchema.Properties, "enforce_admins")
+	assert.Contains(t, tool.InputSchema.Properties, "required_linear_history")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mockProtection := &github.Protection{
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Strict: true,
+			Checks: &[]*github.RequiredStatusCheck{{Context: "ci/build"}},
+		},
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+			RequiredApprovingReviewCount: 2,
+			DismissStaleReviews:          true,
+		},
+		EnforceAdmins:        &github.AdminEnforcement{Enabled: true},
+		RequireLinearHistory: &github.RequireLinearHistory{Enabled: false},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedProtection branchProtection
+		expectedErrMsg     string
+	}{
+		{
+			name: "protect branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+					expectRequestBody(t, map[string]any{
+						"required_status_checks": map[string]any{
+							"strict": true,
+							"checks": []any{map[string]any{"context": "ci/build"}},
+						},
+						"required_pull_request_reviews": map[string]any{
+							"required_approving_review_count": float64(2),
+							"dismiss_stale_reviews":           true,
+							"require_code_owner_reviews":      false,
+						},
+						"enforce_admins":          true,
+						"required_linear_history": false,
+						"restrictions":            nil,
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockProtection),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                           "owner",
+				"repo":                            "repo",
+				"branch":                          "main",
+				"required_approving_review_count": float64(2),
+				"dismiss_stale_reviews":           true,
+				"status_checks":                   []any{"ci/build"},
+				"strict":                          true,
+				"enforce_admins":                  true,
+			},
+			expectError: false,
+			expectedProtection: branchProtection{
+				Branch:    "main",
+				Protected: true,
+				RequiredStatusChecks: &protectionStatusChecks{
+					Strict:   true,
+					Contexts: []string{"ci/build"},
+				},
+				RequiredReviews: &protectionReviews{
+					RequiredApprovingReviewCount: 2,
+					DismissStaleReviews:          true,
+				},
+				EnforceAdmins: true,
+			},
+		},
+		{
+			name: "omitted rules are disabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+					expectRequestBody(t, map[string]any{
+						"required_status_checks":        nil,
+						"required_pull_request_reviews": nil,
+						"enforce_admins":                false,
+						"required_linear_history":       true,
+						"restrictions":                  nil,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Protection{
+							EnforceAdmins:        &github.AdminEnforcement{Enabled: false},
+							RequireLinearHistory: &github.RequireLinearHistory{Enabled: true},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                   "owner",
+				"repo":                    "repo",
+				"branch":                  "main",
+				"required_linear_history": true,
+			},
+			expectError: false,
+			expectedProtection: branchProtection{
+				Branch:                "main",
+				Protected:             true,
+				RequiredLinearHistory: true,
+			},
+		},
+		{
+			name:         "review options without review count",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":                      "owner",
+				"repo":                       "repo",
+				"branch":                     "main",
+				"require_code_owner_reviews": true,
+			},
+			expectError:    false,
+			expectedErrMsg: "dismiss_stale_reviews and require_code_owner_reviews require required_approving_review_count",
+		},
+		{
+			name:         "strict without status checks",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"strict": true,
+			},
+			expectError:    false,
+			expectedErrMsg: "strict requires status_checks",
+		},
+		{
+			name:         "too many required reviews",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":                           "owner",
+				"repo":                            "repo",
+				"branch":                          "main",
+				"required_approving_review_count": float64(7),
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid required_approving_review_count 7, must be a whole number from 0 to 6",
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "branch not found: missing in owner/repo",
+		},
+		{
+			name: "update branch protection fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update branch protection",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned branchProtection
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedProtection, returned)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// capabilityTokenParam is the argument through which a caller presents a capability token
+// to run a write tool on a read-only server.
+const capabilityTokenParam = "capability_token"
+
+// NewCapabilityToken issues a capability token that lets its holder run the given write tools
+// on a read-only server configured with the same secret, until the token expires.
+//
+// A token has the form "<expiry unix seconds>.<comma-separated tool names>.<base64url
+// HMAC-SHA256 of the expiry and tool names>".
+func NewCapabilityToken(secret []byte, tools []string, expiresAt time.Time) string {
+	payload := strconv.FormatInt(expiresAt.Unix(), 10) + "." + strings.Join(tools, ",")
+	return payload + "." + base64.RawURLEncoding.EncodeToString(capabilitySignature(secret, payload))
+}
+
+// verifyCapabilityToken checks that the token was issued with the secret, allows the tool
+// and has not expired.
+func verifyCapabilityToken(secret []byte, token string, tool string, now time.Time) error {
+	if token == "" {
+		return errors.New("missing capability token")
+	}
+	i := strings.LastIndex(token, ".")
+	if i < 0 {
+		return errors.New("malformed capability token")
+	}
+	payload, signature := token[:i], token[i+1:]
+	decoded, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return errors.New("malformed capability token")
+	}
+	if !hmac.Equal(decoded, capabilitySignature(secret, payload)) {
+		return errors.New("invalid capability token")
+	}
+	expiry, tools, ok := strings.Cut(payload, ".")
+	if !ok {
+		return errors.New("malformed capability token")
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return errors.New("malformed capability token")
+	}
+	if now.Unix() >= expiresAt {
+		return fmt.Errorf("capability token expired at %s", time.Unix(expiresAt, 0).UTC().Format(time.RFC3339))
+	}
+	if !slices.Contains(strings.Split(tools, ","), tool) {
+		return fmt.Errorf("capability token does not allow %s", tool)
+	}
+	return nil
+}
+
+func capabilitySignature(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// requireCapability wraps a write tool so that it only runs when the caller presents a
+// capability token signed with the secret that allows the tool. The token is removed from the arguments
+// before the tool's own handler sees them.
+func requireCapability(secret []byte, tool mcp.Tool, handler server.ToolHandlerFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	mcp.WithString(capabilityTokenParam,
+		mcp.Description("Capability token authorizing this write operation on a read-only server"),
+	)(&tool)
+
+	return tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		token, err := OptionalParam[string](request, capabilityTokenParam)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if err := verifyCapabilityToken(secret, token, tool.Name, time.Now()); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("server is read-only, %s requires a valid capability token: %s", tool.Name, err.Error())), nil
+		}
+
+		arguments := make(map[string]interface{}, len(request.Params.Arguments))
+		for k, v := range request.Params.Arguments {
+			if k != capabilityTokenParam {
+				arguments[k] = v
+			}
+		}
+		request.Params.Arguments = arguments
+
+		return handler(ctx, request)
+	}
+}
@@ -0,0 +1,201 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VerifyCapabilityToken(t *testing.T) {
+	secret := []byte("s3cret")
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	tools := []string{"add_issue_comment", "create_issue"}
+	validToken := NewCapabilityToken(secret, tools, now.Add(time.Hour))
+	expiry, rest, _ := strings.Cut(validToken, ".")
+	_, signature, _ := strings.Cut(rest, ".")
+
+	tests := []struct {
+		name           string
+		token          string
+		tool           string
+		expectedErrMsg string
+	}{
+		{
+			name:  "valid token",
+			token: validToken,
+			tool:  "create_issue",
+		},
+		{
+			name:           "missing token",
+			token:          "",
+			tool:           "create_issue",
+			expectedErrMsg: "missing capability token",
+		},
+		{
+			name:           "token signed with another secret",
+			token:          NewCapabilityToken([]byte("other"), tools, now.Add(time.Hour)),
+			tool:           "create_issue",
+			expectedErrMsg: "invalid capability token",
+		},
+		{
+			name:           "tampered expiry",
+			token:          "9999999999." + rest,
+			tool:           "create_issue",
+			expectedErrMsg: "invalid capability token",
+		},
+		{
+			name:           "tampered tools",
+			token:          expiry + ".add_issue_comment,create_issue,merge_pull_request." + signature,
+			tool:           "merge_pull_request",
+			expectedErrMsg: "invalid capability token",
+		},
+		{
+			name:           "tool not allowed",
+			token:          validToken,
+			tool:           "merge_pull_request",
+			expectedErrMsg: "capability token does not allow merge_pull_request",
+		},
+		{
+			name:           "expired token",
+			token:          NewCapabilityToken(secret, tools, now.Add(-time.Minute)),
+			tool:           "create_issue",
+			expectedErrMsg: "capability token expired at 2025-04-01T11:59:00Z",
+		},
+		{
+			name:           "malformed token",
+			token:          "not-a-token",
+			tool:           "create_issue",
+			expectedErrMsg: "malformed capability token",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifyCapabilityToken(secret, tc.token, tc.tool, now)
+			if tc.expectedErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErrMsg)
+		})
+	}
+}
+
+func Test_NewServer_CapabilityToken(t *testing.T) {
+	secret := []byte("s3cret")
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+			expectRequestBody(t, map[string]interface{}{
+				"body": "Elevated comment",
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.IssueComment{ID: github.Ptr(int64(1)), Body: github.Ptr("Elevated comment")}),
+			),
+		),
+	)
+	s := NewServer(stubGetClientFn(github.NewClient(mockedClient)), "test", ServerConfig{ReadOnly: true, CapabilitySecret: secret}, translations.NullTranslationHelper)
+
+	// Write tools stay registered and advertise the token parameter
+	var addIssueComment bool
+	for _, tool := range listServerTools(t, s) {
+		if tool.Name == "add_issue_comment" {
+			addIssueComment = true
+			assert.Contains(t, tool.InputSchema.Properties, capabilityTokenParam)
+		}
+	}
+	require.True(t, addIssueComment)
+
+	tests := []struct {
+		name           string
+		token          interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:  "valid token",
+			token: NewCapabilityToken(secret, []string{"add_issue_comment"}, time.Now().Add(time.Hour)),
+		},
+		{
+			name:           "invalid token",
+			token:          NewCapabilityToken([]byte("other"), []string{"add_issue_comment"}, time.Now().Add(time.Hour)),
+			expectedErrMsg: "add_issue_comment requires a valid capability token: invalid capability token",
+		},
+		{
+			name:           "token for another tool",
+			token:          NewCapabilityToken(secret, []string{"create_issue"}, time.Now().Add(time.Hour)),
+			expectedErrMsg: "add_issue_comment requires a valid capability token: capability token does not allow add_issue_comment",
+		},
+		{
+			name:           "missing token",
+			expectedErrMsg: "add_issue_comment requires a valid capability token: missing capability token",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			arguments := map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": 42,
+				"body":         "Elevated comment",
+			}
+			if tc.token != nil {
+				arguments[capabilityTokenParam] = tc.token
+			}
+			message, err := json.Marshal(map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"method":  "tools/call",
+				"params": map[string]interface{}{
+					"name":      "add_issue_comment",
+					"arguments": arguments,
+				},
+			})
+			require.NoError(t, err)
+
+			response := s.HandleMessage(context.Background(), message)
+			b, err := json.Marshal(response)
+			require.NoError(t, err)
+
+			var callResponse struct {
+				Result struct {
+					Content []struct {
+						Text string `json:"text"`
+					} `json:"content"`
+					IsError bool `json:"isError"`
+				} `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(b, &callResponse))
+			require.Len(t, callResponse.Result.Content, 1)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, callResponse.Result.IsError)
+				assert.Contains(t, callResponse.Result.Content[0].Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.False(t, callResponse.Result.IsError)
+			var comment github.IssueComment
+			require.NoError(t, json.Unmarshal([]byte(callResponse.Result.Content[0].Text), &comment))
+			assert.Equal(t, "Elevated comment", comment.GetBody())
+		})
+	}
+}
+
+func Test_NewServer_ReadOnlyWithoutCapabilitySecret(t *testing.T) {
+	s := NewServer(stubGetClientFn(github.NewClient(nil)), "test", ServerConfig{ReadOnly: true}, translations.NullTranslationHelper)
+
+	for _, tool := range listServerTools(t, s) {
+		assert.NotEqual(t, "add_issue_comment", tool.Name)
+		assert.NotContains(t, tool.InputSchema.Properties, capabilityTokenParam)
+	}
+}
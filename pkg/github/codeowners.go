@@ -0,0 +1,284 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// codeownersLocations are the paths GitHub reads a CODEOWNERS file from, in order of precedence.
+var codeownersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// codeownersRule is a line of a CODEOWNERS file. A rule without owners takes the ownership
+// of the files it matches away from earlier rules.
+type codeownersRule struct {
+	Line    int      `json:"line"`
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+
+	re *regexp.Regexp
+}
+
+// matches reports whether the rule applies to path, a path relative to the repository root.
+func (r codeownersRule) matches(path string) bool {
+	return r.re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// parseCodeowners parses the rules of a CODEOWNERS file. Lines with a pattern that can't be
+// used, e.g. a negation, are returned as errors and otherwise ignored, as GitHub does.
+func parseCodeowners(content string) (rules []codeownersRule, errs []string) {
+	rules = []codeownersRule{}
+	for i, line := range strings.Split(content, "\n") {
+		fields := codeownersFields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		re, err := codeownersPattern(fields[0])
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("line %d: %s", i+1, err.Error()))
+			continue
+		}
+		rules = append(rules, codeownersRule{
+			Line:    i + 1,
+			Pattern: fields[0],
+			Owners:  append([]string{}, fields[1:]...),
+			re:      re,
+		})
+	}
+	return rules, errs
+}
+
+// codeownersFields splits a CODEOWNERS line into its pattern and owners, dropping comments.
+// A backslash escapes the next character, so that patterns can contain spaces and "#".
+func codeownersFields(line string) []string {
+	var fields []string
+	var field strings.Builder
+	escaped := false
+	for _, c := range strings.TrimRight(line, "\r") {
+		if escaped {
+			field.WriteRune(c)
+			escaped = false
+			continue
+		}
+		if c == '#' {
+			// The rest of the line is a comment
+			break
+		}
+		switch c {
+		case '\\':
+			escaped = true
+		case ' ', '\t':
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+		default:
+			field.WriteRune(c)
+		}
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// codeownersPattern compiles a CODEOWNERS pattern, which follows the gitignore syntax, into a
+// regular expression matching the paths it applies to:
+//   - A pattern starting with or containing a "/" is relative to the repository root; any
+//     other pattern matches at any depth.
+//   - "*" and "?" match within a path segment, while "**" matches across segments.
+//   - A pattern matching a directory also matches everything below it, except when it ends
+//     with "/*", which only matches the files directly in the directory.
+func codeownersPattern(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return nil, fmt.Errorf("negated pattern %s is not supported", pattern)
+	}
+	if strings.ContainsAny(pattern, "[]") {
+		return nil, fmt.Errorf("character range in pattern %s is not supported", pattern)
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	trimmed := strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(trimmed, "/")
+	trimmed = strings.TrimPrefix(trimmed, "/")
+	if trimmed == "" {
+		return nil, fmt.Errorf("pattern %s matches no path", pattern)
+	}
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+	segments := strings.Split(trimmed, "/")
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		if segment == "**" {
+			if last {
+				// A trailing "/**" matches everything inside the directory
+				b.WriteString(".*")
+			} else {
+				// A leading or inner "**/" matches any number of directories
+				b.WriteString("(?:.*/)?")
+			}
+			continue
+		}
+		for _, c := range segment {
+			switch c {
+			case '*':
+				b.WriteString("[^/]*")
+			case '?':
+				b.WriteString("[^/]")
+			default:
+				b.WriteString(regexp.QuoteMeta(string(c)))
+			}
+		}
+		if !last {
+			b.WriteString("/")
+		}
+	}
+
+	switch last := segments[len(segments)-1]; {
+	case last == "**":
+		// Already matches everything inside the directory
+	case dirOnly:
+		b.WriteString("/.*")
+	case last == "*" && len(segments) > 1:
+		// "docs/*" owns docs/a.md but not docs/sub/b.md
+	default:
+		b.WriteString("(?:/.*)?")
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+// codeownersFile is the CODEOWNERS file at Path, with the lines that could not be parsed.
+type codeownersFile struct {
+	Path   string           `json:"path"`
+	Rules  []codeownersRule `json:"rules"`
+	Errors []string         `json:"errors,omitempty"`
+}
+
+// fileCodeowners are the owners of File according to the CODEOWNERS file at Path. Files no
+// rule matches, or whose rule lists no owners, have no owners.
+type fileCodeowners struct {
+	Path        string          `json:"path"`
+	File        string          `json:"file"`
+	MatchedRule *codeownersRule `json:"matched_rule,omitempty"`
+	Owners      []string        `json:"owners"`
+	Errors      []string        `json:"errors,omitempty"`
+}
+
+// GetCodeowners creates a tool to get the code owners of a repository, or of a file in it.
+func GetCodeowners(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_codeowners",
+			mcp.WithDescription(t("TOOL_GET_CODEOWNERS_DESCRIPTION", "Get the rules of the CODEOWNERS file of a GitHub repository, or the owners of a single file, e.g. to find who should review a change")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit to read the CODEOWNERS file from (defaults to the default branch)"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Path of a file, relative to the repository root. When given, only the rule that applies to it and its owners are returned"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// GitHub uses the first CODEOWNERS file it finds
+			var location, content string
+			for _, candidate := range codeownersLocations {
+				fileContent, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, candidate, &github.RepositoryContentGetOptions{Ref: ref})
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						continue
+					}
+					return nil, fmt.Errorf("failed to get %s: %w", candidate, err)
+				}
+				_ = resp.Body.Close()
+				if fileContent == nil {
+					continue
+				}
+				content, err = fileContent.GetContent()
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to read %s: %s", candidate, err.Error())), nil
+				}
+				location = candidate
+				break
+			}
+			if location == "" {
+				where := fmt.Sprintf("%s/%s", owner, repo)
+				if ref != "" {
+					// A missing ref is reported as 404 too, so tell it apart from a missing file
+					_, resp, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, ref, "")
+					if err != nil {
+						if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+							return mcp.NewToolResultError(fmt.Sprintf("ref %s not found in %s/%s", ref, owner, repo)), nil
+						}
+						return nil, fmt.Errorf("failed to resolve ref: %w", err)
+					}
+					_ = resp.Body.Close()
+					where += " at ref " + ref
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("no CODEOWNERS file found in %s, looked in %s", where, strings.Join(codeownersLocations, ", "))), nil
+			}
+
+			rules, errs := parseCodeowners(content)
+			var result any = codeownersFile{Path: location, Rules: rules, Errors: errs}
+			if path != "" {
+				owners := fileCodeowners{Path: location, File: path, Owners: []string{}, Errors: errs}
+				// The last matching rule wins
+				for i := len(rules) - 1; i >= 0; i-- {
+					if rules[i].matches(path) {
+						owners.MatchedRule = &rules[i]
+						owners.Owners = rules[i].Owners
+						break
+					}
+				}
+				result = owners
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,301 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CodeownersPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		matching []string
+		other    []string
+	}{
+		{
+			pattern:  "*",
+			matching: []string{"README.md", "src/main.go"},
+		},
+		{
+			pattern:  "*.js",
+			matching: []string{"app.js", "src/lib/app.js"},
+			other:    []string{"app.jsx", "app.ts"},
+		},
+		{
+			pattern:  "/build/logs/",
+			matching: []string{"build/logs/out.txt", "build/logs/2025/out.txt"},
+			other:    []string{"build/logs", "src/build/logs/out.txt"},
+		},
+		{
+			pattern:  "docs/*",
+			matching: []string{"docs/getting-started.md"},
+			other:    []string{"docs/build-app/troubleshooting.md", "src/docs/a.md"},
+		},
+		{
+			pattern:  "apps/",
+			matching: []string{"apps/a.go", "src/apps/a.go", "src/apps/nested/a.go"},
+			other:    []string{"apps", "myapps/a.go"},
+		},
+		{
+			pattern:  "/docs/",
+			matching: []string{"docs/a.md", "docs/sub/b.md"},
+			other:    []string{"src/docs/a.md"},
+		},
+		{
+			pattern:  "**/logs",
+			matching: []string{"logs/a.txt", "build/logs/a.txt", "deeply/nested/logs/a.txt"},
+			other:    []string{"build/logsfile"},
+		},
+		{
+			pattern:  "/apps/github",
+			matching: []string{"apps/github", "apps/github/a.go"},
+			other:    []string{"apps/githubber/a.go", "src/apps/github/a.go"},
+		},
+		{
+			pattern:  "docs/**/*.md",
+			matching: []string{"docs/a.md", "docs/sub/dir/a.md"},
+			other:    []string{"src/docs/a.md", "docs/a.txt"},
+		},
+		{
+			pattern:  "/scripts/**",
+			matching: []string{"scripts/a.sh", "scripts/ci/b.sh"},
+			other:    []string{"scripts", "src/scripts/a.sh"},
+		},
+		{
+			pattern:  "file?.txt",
+			matching: []string{"file1.txt", "dir/fileA.txt"},
+			other:    []string{"file10.txt", "file.txt"},
+		},
+		{
+			pattern:  "/a.b+c",
+			matching: []string{"a.b+c"},
+			other:    []string{"axb+c", "a.bbc"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			re, err := codeownersPattern(tc.pattern)
+			require.NoError(t, err)
+			rule := codeownersRule{Pattern: tc.pattern, re: re}
+			for _, path := range tc.matching {
+				assert.True(t, rule.matches(path), "%s should match %s", tc.pattern, path)
+			}
+			for _, path := range tc.other {
+				assert.False(t, rule.matches(path), "%s should not match %s", tc.pattern, path)
+			}
+		})
+	}
+}
+
+func Test_ParseCodeowners(t *testing.T) {
+	content := "# Default owners\n" +
+		"*       @global-owner1 @global-owner2\n" +
+		"\n" +
+		"*.js    @js-owner # inline comment\n" +
+		"/docs/  docs@example.com\r\n" +
+		"/apps/github\n" +
+		"!*.md   @nobody\n" +
+		"my\\ file.txt @spaces\n" +
+		"\\#notes @hash\n"
+
+	rules, errs := parseCodeowners(content)
+	assert.Equal(t, []string{"line 7: negated pattern !*.md is not supported"}, errs)
+
+	type rule struct {
+		Line    int
+		Pattern string
+		Owners  []string
+	}
+	got := make([]rule, 0, len(rules))
+	for _, r := range rules {
+		got = append(got, rule{r.Line, r.Pattern, r.Owners})
+	}
+	assert.Equal(t, []rule{
+		{2, "*", []string{"@global-owner1", "@global-owner2"}},
+		{4, "*.js", []string{"@js-owner"}},
+		{5, "/docs/", []string{"docs@example.com"}},
+		{6, "/apps/github", []string{}},
+		{8, "my file.txt", []string{"@spaces"}},
+		{9, "#notes", []string{"@hash"}},
+	}, got)
+}
+
+func Test_GetCodeowners(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCodeowners(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_codeowners", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	codeownersFile := "* @org/core\n/docs/ @org/docs\n/docs/generated/\n"
+	fileContent := &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(codeownersFile))),
+	}
+	// Serves the CODEOWNERS file at path only, and 404 for the other locations
+	contentsAt := func(path string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/repos/owner/repo/contents/"+path {
+				mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, fileContent)(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "all rules from docs location",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					contentsAt("docs/CODEOWNERS"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: `{"path": "docs/CODEOWNERS", "rules": [
+				{"line": 1, "pattern": "*", "owners": ["@org/core"]},
+				{"line": 2, "pattern": "/docs/", "owners": ["@org/docs"]},
+				{"line": 3, "pattern": "/docs/generated/", "owners": []}
+			]}`,
+		},
+		{
+			name: "owners of a path",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{"ref": "v1.0"}).andThen(
+						contentsAt(".github/CODEOWNERS"),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "v1.0",
+				"path":  "docs/guide.md",
+			},
+			expectedResult: `{"path": ".github/CODEOWNERS", "file": "docs/guide.md",
+				"matched_rule": {"line": 2, "pattern": "/docs/", "owners": ["@org/docs"]},
+				"owners": ["@org/docs"]}`,
+		},
+		{
+			name: "path owned by nobody",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					contentsAt("CODEOWNERS"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "docs/generated/api.md",
+			},
+			expectedResult: `{"path": "CODEOWNERS", "file": "docs/generated/api.md",
+				"matched_rule": {"line": 3, "pattern": "/docs/generated/", "owners": []}, "owners": []}`,
+		},
+		{
+			name: "no CODEOWNERS file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedErrMsg: "no CODEOWNERS file found in owner/repo, looked in .github/CODEOWNERS, CODEOWNERS, docs/CODEOWNERS",
+		},
+		{
+			name: "unknown ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "missing",
+			},
+			expectedErrMsg: "ref missing not found in owner/repo",
+		},
+		{
+			name: "contents request fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get .github/CODEOWNERS",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCodeowners(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// collaboratorAffiliations are the affiliations collaborators can be filtered by.
+var collaboratorAffiliations = []string{"outside", "direct", "all"}
+
+// collaboratorRoles are the repository roles collaborators can be filtered by or granted.
+var collaboratorRoles = []string{"pull", "triage", "push", "maintain", "admin"}
+
+// collaborator is the subset of a repository collaborator returned by the collaborator tools.
+// Permission is the coarse admin/write/read level, RoleName the repository role behind it.
+type collaborator struct {
+	Login      string `json:"login"`
+	Permission string `json:"permission"`
+	RoleName   string `json:"role_name,omitempty"`
+}
+
+// repositoryInvitation is an invitation of the authenticated user to collaborate on a repository.
+type repositoryInvitation struct {
+	ID          int64             `json:"id"`
+	Repository  string            `json:"repository"`
+	Inviter     string            `json:"inviter"`
+	Permissions string            `json:"permissions"`
+	Expired     bool              `json:"expired"`
+	CreatedAt   *github.Timestamp `json:"created_at,omitempty"`
+	HTMLURL     string            `json:"html_url,omitempty"`
+}
+
+// permissionLevel reduces the role flags of a collaborator to the admin/write/read levels
+// reported by the repository permission API.
+func permissionLevel(permissions map[string]bool) string {
+	switch {
+	case permissions["admin"]:
+		return "admin"
+	case permissions["maintain"], permissions["push"]:
+		return "write"
+	case permissions["triage"], permissions["pull"]:
+		return "read"
+	default:
+		return "none"
+	}
+}
+
+// ListCollaborators creates a tool to list the collaborators of a repository.
+func ListCollaborators(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_collaborators",
+			mcp.WithDescription(t("TOOL_LIST_COLLABORATORS_DESCRIPTION", "List the collaborators of a repository with their permission level. Requires push access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("affiliation",
+				mcp.Description("Filter by affiliation: 'outside' for outside collaborators of an organization repository, 'direct' for all collaborators with direct permissions, 'all' for everyone with access (default: all)"),
+				mcp.Enum(collaboratorAffiliations...),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Only list collaborators with this role"),
+				mcp.Enum(collaboratorRoles...),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			affiliation, err := OptionalParam[string](request, "affiliation")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if affiliation != "" && !slices.Contains(collaboratorAffiliations, affiliation) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid affiliation %q, must be one of: %s", affiliation, strings.Join(collaboratorAffiliations, ", "))), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if permission != "" && !slices.Contains(collaboratorRoles, permission) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid permission %q, must be one of: %s", permission, strings.Join(collaboratorRoles, ", "))), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListCollaboratorsOptions{
+				Affiliation: affiliation,
+				Permission:  permission,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			users, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list collaborators: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list collaborators: %s", string(body))), nil
+			}
+
+			result := make([]collaborator, 0, len(users))
+			for _, u := range users {
+				result = append(result, collaborator{
+					Login:      u.GetLogin(),
+					Permission: permissionLevel(u.Permissions),
+					RoleName:   u.GetRoleName(),
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetCollaboratorPermission creates a tool to get the permission a user has on a repository.
+func GetCollaboratorPermission(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_collaborator_permission",
+			mcp.WithDescription(t("TOOL_GET_COLLABORATOR_PERMISSION_DESCRIPTION", "Get the permission level (admin, write, read or none) a user has on a repository. Requires push access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username to check"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			level, resp, err := client.Repositories.GetPermissionLevel(ctx, owner, repo, username)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("user %s or repository %s/%s not found", username, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get collaborator permission: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get collaborator permission: %s", string(body))), nil
+			}
+
+			login := level.GetUser().GetLogin()
+			if login == "" {
+				login = username
+			}
+
+			r, err := json.Marshal(collaborator{
+				Login:      login,
+				Permission: level.GetPermission(),
+				RoleName:   level.GetRoleName(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddCollaborator creates a tool to invite a user to collaborate on a repository.
+func AddCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_collaborator",
+			mcp.WithDescription(t("TOOL_ADD_COLLABORATOR_DESCRIPTION", "Invite a user to collaborate on a repository, or change the role of an existing collaborator. Requires admin access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username to add"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Role to grant, only applies to organization repositories (default: push)"),
+				mcp.Enum(collaboratorRoles...),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if permission != "" && !slices.Contains(collaboratorRoles, permission) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid permission %q, must be one of: %s", permission, strings.Join(collaboratorRoles, ", "))), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			invitation, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, username, &github.RepositoryAddCollaboratorOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to add collaborator: %s", err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to add collaborator: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			switch resp.StatusCode {
+			case http.StatusCreated:
+				return mcp.NewToolResultText(fmt.Sprintf("Invited %s to collaborate on %s/%s, invitation %d is pending", username, owner, repo, invitation.GetID())), nil
+			case http.StatusNoContent:
+				// The user already has access, either as a collaborator or as an organization member.
+				return mcp.NewToolResultText(fmt.Sprintf("%s already has access to %s/%s", username, owner, repo)), nil
+			default:
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add collaborator: %s", string(body))), nil
+			}
+		}
+}
+
+// ListRepositoryInvitations creates a tool to list the open repository invitations of the authenticated user.
+func ListRepositoryInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_invitations",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_INVITATIONS_DESCRIPTION", "List the open invitations of the authenticated user to collaborate on repositories, with the repository and the user who sent each of them")),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			invitations, resp, err := client.Users.ListInvitations(ctx, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list repository invitations: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list repository invitations: %s", string(body))), nil
+			}
+
+			result := make([]repositoryInvitation, 0, len(invitations))
+			for _, i := range invitations {
+				result = append(result, repositoryInvitation{
+					ID:          i.GetID(),
+					Repository:  i.GetRepo().GetFullName(),
+					Inviter:     i.GetInviter().GetLogin(),
+					Permissions: i.GetPermissions(),
+					Expired:     i.GetExpired(),
+					CreatedAt:   i.CreatedAt,
+					HTMLURL:     i.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AcceptRepositoryInvitation creates a tool to accept an invitation of the authenticated user to collaborate on a repository.
+func AcceptRepositoryInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("accept_repository_invitation",
+			mcp.WithDescription(t("TOOL_ACCEPT_REPOSITORY_INVITATION_DESCRIPTION", "Accept an invitation of the authenticated user to collaborate on a repository, giving it access to the repository")),
+			mcp.WithNumber("invitation_id",
+				mcp.Required(),
+				mcp.Description("ID of the invitation, as returned by list_repository_invitations"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			invitationID, err := RequiredInt(request, "invitation_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Users.AcceptInvitation(ctx, int64(invitationID))
+			if err != nil {
+				// GitHub forgets invitations once they are accepted, so accepting one twice ends up here too.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultText(fmt.Sprintf("Invitation %d is not open: it was already accepted, or it was declined, withdrawn or never existed", invitationID)), nil
+				}
+				return nil, fmt.Errorf("failed to accept repository invitation: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to accept repository invitation: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Accepted invitation %d", invitationID)), nil
+		}
+}
@@ -0,0 +1,609 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListCollaborators(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCollaborators(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_collaborators", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "affiliation")
+	assert.Contains(t, tool.InputSchema.Properties, "permission")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockUsers := []*github.User{
+		{
+			Login:       github.Ptr("octocat"),
+			Permissions: map[string]bool{"admin": true, "maintain": true, "push": true, "triage": true, "pull": true},
+			RoleName:    github.Ptr("admin"),
+		},
+		{
+			Login:       github.Ptr("hubot"),
+			Permissions: map[string]bool{"maintain": true, "push": true, "triage": true, "pull": true},
+			RoleName:    github.Ptr("maintain"),
+		},
+		{
+			Login:       github.Ptr("monalisa"),
+			Permissions: map[string]bool{"triage": true, "pull": true},
+			RoleName:    github.Ptr("triage"),
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		mockedClient          *http.Client
+		requestArgs           map[string]interface{}
+		expectError           bool
+		expectedCollaborators []collaborator
+		expectedErrMsg        string
+	}{
+		{
+			name: "list collaborators",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockUsers),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedCollaborators: []collaborator{
+				{Login: "octocat", Permission: "admin", RoleName: "admin"},
+				{Login: "hubot", Permission: "write", RoleName: "maintain"},
+				{Login: "monalisa", Permission: "read", RoleName: "triage"},
+			},
+		},
+		{
+			name: "list outside collaborators with push access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"affiliation": "outside",
+						"permission":  "push",
+						"page":        "2",
+						"per_page":    "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockUsers[1:2]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"affiliation": "outside",
+				"permission":  "push",
+				"page":        float64(2),
+				"perPage":     float64(10),
+			},
+			expectError: false,
+			expectedCollaborators: []collaborator{
+				{Login: "hubot", Permission: "write", RoleName: "maintain"},
+			},
+		},
+		{
+			name:         "invalid affiliation",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"affiliation": "everyone",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid affiliation "everyone", must be one of: outside, direct, all`,
+		},
+		{
+			name: "list collaborators fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have push access to view repository collaborators."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list collaborators",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCollaborators(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned []collaborator
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCollaborators, returned)
+		})
+	}
+}
+
+func Test_GetCollaboratorPermission(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCollaboratorPermission(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_collaborator_permission", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	tests := []struct {
+		name                 string
+		mockedClient         *http.Client
+		requestArgs          map[string]interface{}
+		expectError          bool
+		expectedCollaborator collaborator
+		expectedErrMsg       string
+	}{
+		{
+			name: "get permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					&github.RepositoryPermissionLevel{
+						Permission: github.Ptr("write"),
+						RoleName:   github.Ptr("maintain"),
+						User:       &github.User{Login: github.Ptr("hubot")},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "hubot",
+			},
+			expectError:          false,
+			expectedCollaborator: collaborator{Login: "hubot", Permission: "write", RoleName: "maintain"},
+		},
+		{
+			name: "user not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "ghost",
+			},
+			expectError:    false,
+			expectedErrMsg: "user ghost or repository owner/repo not found",
+		},
+		{
+			name: "get permission fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "hubot",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get collaborator permission",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCollaboratorPermission(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned collaborator
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCollaborator, returned)
+		})
+	}
+}
+
+func Test_AddCollaborator(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_collaborator", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "permission")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "invite collaborator",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					expectRequestBody(t, map[string]any{
+						"permission": "triage",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.CollaboratorInvitation{ID: github.Ptr(int64(42))}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"username":   "monalisa",
+				"permission": "triage",
+			},
+			expectError:  false,
+			expectedText: "Invited monalisa to collaborate on owner/repo, invitation 42 is pending",
+		},
+		{
+			name: "user already has access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "hubot",
+			},
+			expectError:  false,
+			expectedText: "hubot already has access to owner/repo",
+		},
+		{
+			name:         "invalid permission",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"username":   "hubot",
+				"permission": "write",
+			},
+			expectError:  false,
+			expectedText: `invalid permission "write", must be one of: pull, triage, push, maintain, admin`,
+		},
+		{
+			name: "validation failure is reported to the caller",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "hubot",
+			},
+			expectError:  false,
+			expectedText: "Validation Failed",
+		},
+		{
+			name: "add collaborator fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "hubot",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add collaborator",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_ListRepositoryInvitations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryInvitations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_invitations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	mockInvitations := []*github.RepositoryInvitation{
+		{
+			ID:          github.Ptr(int64(42)),
+			Repo:        &github.Repository{FullName: github.Ptr("octo-org/service")},
+			Inviter:     &github.User{Login: github.Ptr("monalisa")},
+			Permissions: github.Ptr("write"),
+			Expired:     github.Ptr(false),
+			HTMLURL:     github.Ptr("https://github.com/octo-org/service/invitations"),
+		},
+	}
+
+	tests := []struct {
+		name                string
+		mockedClient        *http.Client
+		requestArgs         map[string]interface{}
+		expectError         bool
+		expectedInvitations []repositoryInvitation
+		expectedErrMsg      string
+	}{
+		{
+			name: "list invitations",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserRepositoryInvitations,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockInvitations),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError: false,
+			expectedInvitations: []repositoryInvitation{
+				{
+					ID:          42,
+					Repository:  "octo-org/service",
+					Inviter:     "monalisa",
+					Permissions: "write",
+					HTMLURL:     "https://github.com/octo-org/service/invitations",
+				},
+			},
+		},
+		{
+			name: "list invitations fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserRepositoryInvitations,
+					mockResponse(t, http.StatusUnauthorized, map[string]string{"message": "Requires authentication"}),
+				),
+			),
+			requestArgs:    map[string]interface{}{},
+			expectError:    true,
+			expectedErrMsg: "failed to list repository invitations",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositoryInvitations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedInvitations []repositoryInvitation
+			err = json.Unmarshal([]byte(textContent.Text), &returnedInvitations)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedInvitations, returnedInvitations)
+		})
+	}
+}
+
+func Test_AcceptRepositoryInvitation(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AcceptRepositoryInvitation(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "accept_repository_invitation", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "invitation_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"invitation_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "accept invitation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchUserRepositoryInvitationsByInvitationId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"invitation_id": float64(42),
+			},
+			expectError:  false,
+			expectedText: "Accepted invitation 42",
+		},
+		{
+			name: "invitation already accepted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchUserRepositoryInvitationsByInvitationId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"invitation_id": float64(42),
+			},
+			expectError:  false,
+			expectedText: "Invitation 42 is not open: it was already accepted, or it was declined, withdrawn or never existed",
+		},
+		{
+			name: "accept invitation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchUserRepositoryInvitationsByInvitationId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"invitation_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to accept repository invitation",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AcceptRepositoryInvitation(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
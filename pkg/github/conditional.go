@@ -0,0 +1,90 @@
+package github
+
+import (
+	"net/http"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// WithConditionalRequest returns a ToolOption that adds the "if_none_match" parameter to the tool.
+// Tools with this option report the ETag of the response in the "etag" field of the result's _meta,
+// and clients can pass it back to skip unchanged data without spending rate limit.
+func WithConditionalRequest() mcp.ToolOption {
+	return mcp.WithString("if_none_match",
+		mcp.Description("ETag returned by a previous call. If the data has not changed, the tool returns a not modified result instead"),
+	)
+}
+
+// conditionalClient returns a copy of the client that sends an If-None-Match header with the
+// ETag given in the request's "if_none_match" parameter, or the client itself when there is none.
+func conditionalClient(client *github.Client, request mcp.CallToolRequest) (*github.Client, error) {
+	etag, err := OptionalParam[string](request, "if_none_match")
+	if err != nil {
+		return nil, err
+	}
+	if etag == "" {
+		return client, nil
+	}
+	return ifNoneMatchClient(client, etag), nil
+}
+
+// ifNoneMatchClient returns a copy of the client that sends an If-None-Match header with the ETag.
+func ifNoneMatchClient(client *github.Client, etag string) *github.Client {
+	return withTransport(client, func(transport http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.Header.Set("If-None-Match", etag)
+			return transport.RoundTrip(req)
+		})
+	})
+}
+
+// withTransport returns a copy of the client whose HTTP transport is wrapped by wrap.
+func withTransport(client *github.Client, wrap func(http.RoundTripper) http.RoundTripper) *github.Client {
+	httpClient := client.Client()
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpClient.Transport = wrap(transport)
+
+	wrapped := github.NewClient(httpClient)
+	wrapped.BaseURL = client.BaseURL
+	wrapped.UploadURL = client.UploadURL
+	wrapped.UserAgent = client.UserAgent
+	return wrapped
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+// isNotModified reports whether GitHub answered a conditional request with 304 Not Modified.
+// go-github surfaces that status as an error, so check the response before handling the error.
+func isNotModified(resp *github.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotModified
+}
+
+// notModifiedResult is returned instead of the data when it has not changed since the ETag was issued.
+func notModifiedResult(resp *github.Response) *mcp.CallToolResult {
+	result := mcp.NewToolResultText("Not modified")
+	result.Meta = map[string]interface{}{
+		"etag":         resp.Header.Get("ETag"),
+		"not_modified": true,
+	}
+	return result
+}
+
+// withETag records the ETag of the response in the result's _meta.
+func withETag(result *mcp.CallToolResult, resp *github.Response) *mcp.CallToolResult {
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		if result.Meta == nil {
+			result.Meta = map[string]interface{}{}
+		}
+		result.Meta["etag"] = etag
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConditionalRequests(t *testing.T) {
+	// notModifiedUnless answers 304 when the client presents the current ETag, and the data otherwise.
+	notModifiedUnless := func(t *testing.T, etag string, body interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("ETag", etag)
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			mockResponse(t, http.StatusOK, body)(w, r)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		newTool     func(GetClientFn, translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc)
+		endpoint    mock.EndpointPattern
+		body        interface{}
+		requestArgs map[string]interface{}
+	}{
+		{
+			name:        "get_repository",
+			newTool:     GetRepository,
+			endpoint:    mock.GetReposByOwnerByRepo,
+			body:        &github.Repository{FullName: github.Ptr("owner/repo")},
+			requestArgs: map[string]interface{}{"owner": "owner", "repo": "repo"},
+		},
+		{
+			name:        "list_commits",
+			newTool:     ListCommits,
+			endpoint:    mock.GetReposCommitsByOwnerByRepo,
+			body:        []*github.RepositoryCommit{{SHA: github.Ptr("abc123")}},
+			requestArgs: map[string]interface{}{"owner": "owner", "repo": "repo"},
+		},
+		{
+			name:        "get_file_contents",
+			newTool:     GetFileContents,
+			endpoint:    mock.GetReposContentsByOwnerByRepoByPath,
+			body:        &github.RepositoryContent{Type: github.Ptr("file"), Path: github.Ptr("README.md")},
+			requestArgs: map[string]interface{}{"owner": "owner", "repo": "repo", "path": "README.md"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(tc.endpoint, notModifiedUnless(t, `"v1"`, tc.body)),
+			)
+			_, handler := tc.newTool(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+			// An unconditional request returns the data and its ETag
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.NotEqual(t, "Not modified", textContent.Text)
+			assert.Equal(t, `"v1"`, result.Meta["etag"])
+
+			// Presenting the ETag returns the not modified sentinel
+			args := map[string]interface{}{"if_none_match": `"v1"`}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err = handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			textContent = getTextResult(t, result)
+			assert.False(t, result.IsError)
+			assert.Equal(t, "Not modified", textContent.Text)
+			assert.Equal(t, true, result.Meta["not_modified"])
+			assert.Equal(t, `"v1"`, result.Meta["etag"])
+
+			// A stale ETag returns the data again
+			args["if_none_match"] = `"v0"`
+			result, err = handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			textContent = getTextResult(t, result)
+			assert.NotEqual(t, "Not modified", textContent.Text)
+			assert.Nil(t, result.Meta["not_modified"])
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// sbomPackage is a dependency listed in the SBOM of a repository. GitHub names packages
+// "ecosystem:name", e.g. "npm:lodash", which is split into Ecosystem and Name.
+type sbomPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem,omitempty"`
+	Version   string `json:"version,omitempty"`
+	License   string `json:"license,omitempty"`
+}
+
+// dependencySBOM is the package list of the SPDX SBOM of a repository.
+type dependencySBOM struct {
+	Name        string            `json:"name"`
+	SPDXVersion string            `json:"spdx_version,omitempty"`
+	Created     *github.Timestamp `json:"created,omitempty"`
+	Packages    []sbomPackage     `json:"packages"`
+}
+
+func newSBOMPackage(p *github.RepoDependencies) sbomPackage {
+	pkg := sbomPackage{
+		Name:    p.GetName(),
+		Version: p.GetVersionInfo(),
+		License: p.GetLicenseConcluded(),
+	}
+	if pkg.License == "" || pkg.License == "NOASSERTION" {
+		pkg.License = p.GetLicenseDeclared()
+	}
+	if pkg.License == "NOASSERTION" {
+		pkg.License = ""
+	}
+	if ecosystem, name, ok := strings.Cut(pkg.Name, ":"); ok {
+		pkg.Ecosystem, pkg.Name = ecosystem, name
+	}
+	return pkg
+}
+
+// GetDependencyGraphSBOM creates a tool to list the dependencies of a repository from its SBOM.
+func GetDependencyGraphSBOM(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_dependency_graph_sbom",
+			mcp.WithDescription(t("TOOL_GET_DEPENDENCY_GRAPH_SBOM_DESCRIPTION", "List the dependencies of a GitHub repository, with their ecosystem, version and license, from the SPDX software bill of materials (SBOM) of its dependency graph")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			sbom, resp, err := client.DependencyGraph.GetSBOM(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("no dependency graph found for %s/%s, the repository may not exist or have the dependency graph disabled", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get SBOM: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get SBOM: %s", string(body))), nil
+			}
+
+			info := sbom.GetSBOM()
+			result := dependencySBOM{
+				Name:        info.GetName(),
+				SPDXVersion: info.GetSPDXVersion(),
+				Packages:    make([]sbomPackage, 0, len(info.Packages)),
+			}
+			if info.CreationInfo != nil {
+				result.Created = info.CreationInfo.Created
+			}
+			for _, p := range info.Packages {
+				// The document describes the repository itself, which is not one of its dependencies
+				if slices.Contains(info.DocumentDescribes, p.GetSPDXID()) {
+					continue
+				}
+				result.Packages = append(result.Packages, newSBOMPackage(p))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// dependencyVulnerability is a security advisory affecting a version of a dependency.
+type dependencyVulnerability struct {
+	Severity        string `json:"severity"`
+	AdvisoryGHSAID  string `json:"advisory_ghsa_id"`
+	AdvisorySummary string `json:"advisory_summary"`
+	AdvisoryURL     string `json:"advisory_url"`
+}
+
+// dependencyChange is a dependency added, removed or updated between two refs. For updated
+// dependencies, PreviousVersion is the version on the base ref and Version the one on the
+// head ref; Vulnerabilities are those of Version.
+type dependencyChange struct {
+	Manifest        string                    `json:"manifest"`
+	Ecosystem       string                    `json:"ecosystem"`
+	Name            string                    `json:"name"`
+	Version         string                    `json:"version"`
+	PreviousVersion string                    `json:"previous_version,omitempty"`
+	Scope           string                    `json:"scope,omitempty"`
+	License         string                    `json:"license,omitempty"`
+	Vulnerabilities []dependencyVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// key identifies the dependency regardless of its version.
+func (c dependencyChange) key() string {
+	return c.Manifest + "\x00" + c.Ecosystem + "\x00" + c.Name
+}
+
+// dependencyReview is the difference between the dependencies of two refs.
+// VulnerabilitiesIntroduced counts the vulnerabilities of added and updated dependencies.
+type dependencyReview struct {
+	Base                      string             `json:"base"`
+	Head                      string             `json:"head"`
+	Added                     []dependencyChange `json:"added"`
+	Removed                   []dependencyChange `json:"removed"`
+	Updated                   []dependencyChange `json:"updated"`
+	VulnerabilitiesIntroduced int                `json:"vulnerabilities_introduced"`
+}
+
+// GetDependencyReview creates a tool to compare the dependencies of two refs of a repository.
+func GetDependencyReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_dependency_review",
+			mcp.WithDescription(t("TOOL_GET_DEPENDENCY_REVIEW_DESCRIPTION", "Compare the dependencies of two refs of a GitHub repository, e.g. the base and head of a pull request, listing the dependencies added, removed and updated and the known vulnerabilities of the ones introduced")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Base branch, tag or commit SHA to compare from"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Head branch, tag or commit SHA to compare to"),
+			),
+			mcp.WithString("manifest",
+				mcp.Description("Only compare the dependencies of this manifest file, e.g. 'package-lock.json'"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			manifest, err := OptionalParam[string](request, "manifest")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github has no method for this endpoint
+			u := fmt.Sprintf("repos/%s/%s/dependency-graph/compare/%s...%s", url.PathEscape(owner), url.PathEscape(repo), url.PathEscape(base), url.PathEscape(head))
+			if manifest != "" {
+				u += "?" + url.Values{"name": {manifest}}.Encode()
+			}
+			req, err := client.NewRequest(http.MethodGet, u, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var changes []struct {
+				ChangeType      string                    `json:"change_type"`
+				Manifest        string                    `json:"manifest"`
+				Ecosystem       string                    `json:"ecosystem"`
+				Name            string                    `json:"name"`
+				Version         string                    `json:"version"`
+				License         string                    `json:"license"`
+				Scope           string                    `json:"scope"`
+				Vulnerabilities []dependencyVulnerability `json:"vulnerabilities"`
+			}
+			resp, err := client.Do(ctx, req, &changes)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("could not compare %s...%s in %s/%s, the refs or the repository may not exist or have the dependency graph disabled", base, head, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to compare dependencies: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare dependencies: %s", string(body))), nil
+			}
+
+			var added, removed []dependencyChange
+			for _, c := range changes {
+				change := dependencyChange{
+					Manifest:        c.Manifest,
+					Ecosystem:       c.Ecosystem,
+					Name:            c.Name,
+					Version:         c.Version,
+					Scope:           c.Scope,
+					License:         c.License,
+					Vulnerabilities: c.Vulnerabilities,
+				}
+				switch c.ChangeType {
+				case "added":
+					added = append(added, change)
+				case "removed":
+					// Vulnerabilities of removed versions are not introduced by the head ref
+					change.Vulnerabilities = nil
+					removed = append(removed, change)
+				}
+			}
+
+			review := dependencyReview{
+				Base:    base,
+				Head:    head,
+				Added:   []dependencyChange{},
+				Removed: []dependencyChange{},
+				Updated: []dependencyChange{},
+			}
+			// A dependency removed and added in the same manifest was updated to another version
+			removedIndexes := make(map[string][]int)
+			for i, change := range removed {
+				key := change.key()
+				removedIndexes[key] = append(removedIndexes[key], i)
+			}
+			replaced := make([]bool, len(removed))
+			for _, change := range added {
+				review.VulnerabilitiesIntroduced += len(change.Vulnerabilities)
+				key := change.key()
+				if indexes := removedIndexes[key]; len(indexes) > 0 {
+					removedIndexes[key] = indexes[1:]
+					replaced[indexes[0]] = true
+					change.PreviousVersion = removed[indexes[0]].Version
+					review.Updated = append(review.Updated, change)
+					continue
+				}
+				review.Added = append(review.Added, change)
+			}
+			for i, change := range removed {
+				if !replaced[i] {
+					review.Removed = append(review.Removed, change)
+				}
+			}
+
+			r, err := json.Marshal(review)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,330 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetDependencyGraphSBOM(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDependencyGraphSBOM(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_dependency_graph_sbom", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockSBOM := &github.SBOM{
+		SBOM: &github.SBOMInfo{
+			SPDXID:            github.Ptr("SPDXRef-DOCUMENT"),
+			SPDXVersion:       github.Ptr("SPDX-2.3"),
+			Name:              github.Ptr("com.github.owner/repo"),
+			CreationInfo:      &github.CreationInfo{Created: &github.Timestamp{Time: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)}},
+			DocumentDescribes: []string{"SPDXRef-com.github.owner-repo"},
+			Packages: []*github.RepoDependencies{
+				{
+					SPDXID:      github.Ptr("SPDXRef-com.github.owner-repo"),
+					Name:        github.Ptr("com.github.owner/repo"),
+					VersionInfo: github.Ptr("main"),
+				},
+				{
+					SPDXID:           github.Ptr("SPDXRef-npm-lodash-4.17.21"),
+					Name:             github.Ptr("npm:lodash"),
+					VersionInfo:      github.Ptr("4.17.21"),
+					LicenseConcluded: github.Ptr("MIT"),
+				},
+				{
+					SPDXID:           github.Ptr("SPDXRef-go-github.com-stretchr-testify-1.10.0"),
+					Name:             github.Ptr("go:github.com/stretchr/testify"),
+					VersionInfo:      github.Ptr("1.10.0"),
+					LicenseConcluded: github.Ptr("NOASSERTION"),
+					LicenseDeclared:  github.Ptr("MIT"),
+				},
+				{
+					SPDXID:           github.Ptr("SPDXRef-actions-actions-checkout-4"),
+					Name:             github.Ptr("actions:actions/checkout"),
+					VersionInfo:      github.Ptr("4"),
+					LicenseConcluded: github.Ptr("NOASSERTION"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "dependencies of the repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposDependencyGraphSbomByOwnerByRepo,
+					mockSBOM,
+				),
+			),
+			expectedResult: `{
+				"name": "com.github.owner/repo",
+				"spdx_version": "SPDX-2.3",
+				"created": "2025-03-01T10:00:00Z",
+				"packages": [
+					{"name": "lodash", "ecosystem": "npm", "version": "4.17.21", "license": "MIT"},
+					{"name": "github.com/stretchr/testify", "ecosystem": "go", "version": "1.10.0", "license": "MIT"},
+					{"name": "actions/checkout", "ecosystem": "actions", "version": "4"}
+				]
+			}`,
+		},
+		{
+			name: "dependency graph disabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphSbomByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectedErrMsg: "no dependency graph found for owner/repo, the repository may not exist or have the dependency graph disabled",
+		},
+		{
+			name: "server error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphSbomByOwnerByRepo,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get SBOM",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDependencyGraphSBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
+
+func Test_GetDependencyReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDependencyReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_dependency_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "manifest")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	mockChanges := []map[string]interface{}{
+		{
+			"change_type": "removed",
+			"manifest":    "package-lock.json",
+			"ecosystem":   "npm",
+			"name":        "lodash",
+			"version":     "4.17.20",
+			"license":     "MIT",
+			"scope":       "runtime",
+			"vulnerabilities": []map[string]interface{}{
+				{"severity": "high", "advisory_ghsa_id": "GHSA-35jh-r3h4-6jhm", "advisory_summary": "Command Injection in lodash", "advisory_url": "https://github.com/advisories/GHSA-35jh-r3h4-6jhm"},
+			},
+		},
+		{
+			"change_type":     "added",
+			"manifest":        "package-lock.json",
+			"ecosystem":       "npm",
+			"name":            "lodash",
+			"version":         "4.17.21",
+			"license":         "MIT",
+			"scope":           "runtime",
+			"vulnerabilities": []map[string]interface{}{},
+		},
+		{
+			"change_type": "added",
+			"manifest":    "package-lock.json",
+			"ecosystem":   "npm",
+			"name":        "minimist",
+			"version":     "0.0.8",
+			"license":     nil,
+			"scope":       "development",
+			"vulnerabilities": []map[string]interface{}{
+				{"severity": "critical", "advisory_ghsa_id": "GHSA-xvch-5gv4-984h", "advisory_summary": "Prototype Pollution in minimist", "advisory_url": "https://github.com/advisories/GHSA-xvch-5gv4-984h"},
+			},
+		},
+		{
+			"change_type":     "removed",
+			"manifest":        "go.mod",
+			"ecosystem":       "gomod",
+			"name":            "github.com/pkg/errors",
+			"version":         "0.9.1",
+			"license":         "BSD-2-Clause",
+			"scope":           "runtime",
+			"vulnerabilities": []map[string]interface{}{},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "added, removed and updated dependencies",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/dependency-graph/compare/main...feature", r.URL.Path)
+						mockResponse(t, http.StatusOK, mockChanges)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectedResult: `{
+				"base": "main",
+				"head": "feature",
+				"added": [
+					{"manifest": "package-lock.json", "ecosystem": "npm", "name": "minimist", "version": "0.0.8", "scope": "development", "vulnerabilities": [
+						{"severity": "critical", "advisory_ghsa_id": "GHSA-xvch-5gv4-984h", "advisory_summary": "Prototype Pollution in minimist", "advisory_url": "https://github.com/advisories/GHSA-xvch-5gv4-984h"}
+					]}
+				],
+				"removed": [
+					{"manifest": "go.mod", "ecosystem": "gomod", "name": "github.com/pkg/errors", "version": "0.9.1", "scope": "runtime", "license": "BSD-2-Clause"}
+				],
+				"updated": [
+					{"manifest": "package-lock.json", "ecosystem": "npm", "name": "lodash", "version": "4.17.21", "previous_version": "4.17.20", "scope": "runtime", "license": "MIT"}
+				],
+				"vulnerabilities_introduced": 1
+			}`,
+		},
+		{
+			name: "single manifest",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					expectQueryParams(t, map[string]string{"name": "go.mod"}).andThen(
+						mockResponse(t, http.StatusOK, []map[string]interface{}{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"base":     "main",
+				"head":     "feature",
+				"manifest": "go.mod",
+			},
+			expectedResult: `{"base": "main", "head": "feature", "added": [], "removed": [], "updated": [], "vulnerabilities_introduced": 0}`,
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "missing",
+			},
+			expectedErrMsg: "could not compare main...missing in owner/repo, the refs or the repository may not exist or have the dependency graph disabled",
+		},
+		{
+			name: "dependency review not enabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusForbidden, `{"message": "Dependency review is not supported on this repository"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to compare dependencies",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDependencyReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
@@ -0,0 +1,479 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// deployment is the subset of a GitHub deployment returned by the deployment tools.
+// State holds the state of the most recent deployment status, if any.
+type deployment struct {
+	ID          int64             `json:"id"`
+	SHA         string            `json:"sha"`
+	Ref         string            `json:"ref"`
+	Environment string            `json:"environment"`
+	Description string            `json:"description,omitempty"`
+	Creator     string            `json:"creator,omitempty"`
+	CreatedAt   *github.Timestamp `json:"created_at,omitempty"`
+	State       string            `json:"state,omitempty"`
+}
+
+func newDeployment(d *github.Deployment) deployment {
+	return deployment{
+		ID:          d.GetID(),
+		SHA:         d.GetSHA(),
+		Ref:         d.GetRef(),
+		Environment: d.GetEnvironment(),
+		Description: d.GetDescription(),
+		Creator:     d.GetCreator().GetLogin(),
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+// deploymentStatusWorkers is how many deployments list_deployments looks up the latest status
+// of concurrently.
+const deploymentStatusWorkers = 5
+
+// ListDeployments creates a tool to list the deployments of a GitHub repository.
+func ListDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_deployments",
+			mcp.WithDescription(t("TOOL_LIST_DEPLOYMENTS_DESCRIPTION", "List deployments of a GitHub repository, with the state of the latest status of each deployment")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Filter by ref (branch, tag or SHA) that was deployed"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Filter by environment name (e.g. production, staging)"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("Filter by commit SHA that was deployed"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environment, err := OptionalParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.DeploymentsListOptions{
+				Ref:         ref,
+				Environment: environment,
+				SHA:         sha,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			deployments, resp, err := client.Repositories.ListDeployments(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list deployments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list deployments: %s", string(body))), nil
+			}
+
+			result, errs := fanOut(ctx, deployments, deploymentStatusWorkers, func(ctx context.Context, d *github.Deployment) (deployment, error) {
+				// Statuses are returned newest first, so the first one is the current state.
+				statuses, statusResp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, d.GetID(), &github.ListOptions{PerPage: 1})
+				if err != nil {
+					return deployment{}, err
+				}
+				_ = statusResp.Body.Close()
+
+				item := newDeployment(d)
+				if len(statuses) > 0 {
+					item.State = statuses[0].GetState()
+				}
+				return item, nil
+			})
+			if err := errors.Join(errs...); err != nil {
+				return nil, fmt.Errorf("failed to list deployment statuses: %w", err)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateDeployment creates a tool to create a deployment in a GitHub repository.
+func CreateDeployment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_deployment",
+			mcp.WithDescription(t("TOOL_CREATE_DEPLOYMENT_DESCRIPTION", "Create a deployment of a ref in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Ref (branch, tag or SHA) to deploy"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Environment to deploy to (defaults to production)"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the deployment"),
+			),
+			mcp.WithBoolean("auto_merge",
+				mcp.Description("Merge the default branch into the ref if it is behind (defaults to true)"),
+			),
+			mcp.WithArray("required_contexts",
+				mcp.Description("Status check contexts that must pass before deploying. Pass an empty array to skip all checks; omit to require every check"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := requiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			deploymentRequest := &github.DeploymentRequest{
+				Ref: github.Ptr(ref),
+			}
+
+			environment, err := OptionalParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if environment != "" {
+				deploymentRequest.Environment = github.Ptr(environment)
+			}
+
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if description != "" {
+				deploymentRequest.Description = github.Ptr(description)
+			}
+
+			if autoMerge, ok, err := OptionalParamOK[bool](request, "auto_merge"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				deploymentRequest.AutoMerge = github.Ptr(autoMerge)
+			}
+
+			// An empty list is meaningful (skip all checks), so only send the field when it was provided.
+			if _, ok := request.Params.Arguments["required_contexts"]; ok {
+				requiredContexts, err := OptionalStringArrayParam(request, "required_contexts")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				deploymentRequest.RequiredContexts = &requiredContexts
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			created, resp, err := client.Repositories.CreateDeployment(ctx, owner, repo, deploymentRequest)
+			if err != nil {
+				// A 202 means GitHub merged the default branch into the ref instead of creating
+				// the deployment, so the caller has to deploy the new merge commit.
+				var acceptedError *github.AcceptedError
+				if resp != nil && resp.StatusCode == http.StatusAccepted && errors.As(err, &acceptedError) {
+					var message struct {
+						Message string `json:"message"`
+					}
+					_ = json.Unmarshal(acceptedError.Raw, &message)
+					return mcp.NewToolResultText(fmt.Sprintf("Deployment was not created: %s Retry create_deployment to deploy the merged ref.", message.Message)), nil
+				}
+				if resp != nil && resp.StatusCode == http.StatusConflict {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to create deployment: %s", err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to create deployment: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create deployment: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newDeployment(created))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// deploymentStates are the states a deployment status can be created with.
+var deploymentStates = []string{"error", "failure", "inactive", "in_progress", "queued", "pending", "success"}
+
+// deploymentStatus is the subset of a GitHub deployment status returned by the deployment status tools.
+type deploymentStatus struct {
+	ID          int64             `json:"id"`
+	State       string            `json:"state"`
+	Environment string            `json:"environment,omitempty"`
+	Description string            `json:"description,omitempty"`
+	LogURL      string            `json:"log_url,omitempty"`
+	Creator     string            `json:"creator,omitempty"`
+	CreatedAt   *github.Timestamp `json:"created_at,omitempty"`
+}
+
+func newDeploymentStatus(s *github.DeploymentStatus) deploymentStatus {
+	return deploymentStatus{
+		ID:          s.GetID(),
+		State:       s.GetState(),
+		Environment: s.GetEnvironment(),
+		Description: s.GetDescription(),
+		LogURL:      s.GetLogURL(),
+		Creator:     s.GetCreator().GetLogin(),
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+// ListDeploymentStatuses creates a tool to list the statuses of a deployment, newest first.
+func ListDeploymentStatuses(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_deployment_statuses",
+			mcp.WithDescription(t("TOOL_LIST_DEPLOYMENT_STATUSES_DESCRIPTION", "List the statuses of a deployment in a GitHub repository, newest first")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("deployment_id",
+				mcp.Required(),
+				mcp.Description("Deployment ID"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deploymentID, err := RequiredInt(request, "deployment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, int64(deploymentID), opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list deployment statuses: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list deployment statuses: %s", string(body))), nil
+			}
+
+			result := make([]deploymentStatus, 0, len(statuses))
+			for _, status := range statuses {
+				result = append(result, newDeploymentStatus(status))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateDeploymentStatus creates a tool to report the progress of a deployment.
+func CreateDeploymentStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_deployment_status",
+			mcp.WithDescription(t("TOOL_CREATE_DEPLOYMENT_STATUS_DESCRIPTION", "Create a status for a deployment in a GitHub repository to report its progress")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("deployment_id",
+				mcp.Required(),
+				mcp.Description("Deployment ID"),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("State of the deployment"),
+				mcp.Enum(deploymentStates...),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Name of the environment that was deployed to"),
+			),
+			mcp.WithString("log_url",
+				mcp.Description("URL of the deployment output"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the status"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deploymentID, err := RequiredInt(request, "deployment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := requiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !slices.Contains(deploymentStates, state) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state %q, must be one of: %s", state, strings.Join(deploymentStates, ", "))), nil
+			}
+
+			statusRequest := &github.DeploymentStatusRequest{
+				State: github.Ptr(state),
+			}
+
+			environment, err := OptionalParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if environment != "" {
+				statusRequest.Environment = github.Ptr(environment)
+			}
+
+			logURL, err := OptionalParam[string](request, "log_url")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if logURL != "" {
+				statusRequest.LogURL = github.Ptr(logURL)
+			}
+
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if description != "" {
+				statusRequest.Description = github.Ptr(description)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			status, resp, err := client.Repositories.CreateDeploymentStatus(ctx, owner, repo, int64(deploymentID), statusRequest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create deployment status: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create deployment status: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newDeploymentStatus(status))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
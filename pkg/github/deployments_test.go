@@ -0,0 +1,620 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListDeployments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDeployments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_deployments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "environment")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	createdAt := &github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockDeployments := []*github.Deployment{
+		{
+			ID:          github.Ptr(int64(1)),
+			SHA:         github.Ptr("abc123"),
+			Ref:         github.Ptr("main"),
+			Environment: github.Ptr("production"),
+			Description: github.Ptr("Release v1.0"),
+			Creator:     &github.User{Login: github.Ptr("octocat")},
+			CreatedAt:   createdAt,
+		},
+	}
+	mockStatuses := []*github.DeploymentStatus{
+		{ID: github.Ptr(int64(10)), State: github.Ptr("success")},
+	}
+
+	tests := []struct {
+		name                string
+		mockedClient        *http.Client
+		requestArgs         map[string]interface{}
+		expectError         bool
+		expectedDeployments []deployment
+		expectedErrMsg      string
+	}{
+		{
+			name: "successful deployments listing with filters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDeploymentsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"ref":         "main",
+						"environment": "production",
+						"sha":         "abc123",
+						"page":        "1",
+						"per_page":    "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDeployments),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+					expectQueryParams(t, map[string]string{
+						"per_page": "1",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockStatuses),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"ref":         "main",
+				"environment": "production",
+				"sha":         "abc123",
+			},
+			expectError: false,
+			expectedDeployments: []deployment{
+				{
+					ID:          1,
+					SHA:         "abc123",
+					Ref:         "main",
+					Environment: "production",
+					Description: "Release v1.0",
+					Creator:     "octocat",
+					CreatedAt:   createdAt,
+					State:       "success",
+				},
+			},
+		},
+		{
+			name: "deployment without statuses",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposDeploymentsByOwnerByRepo,
+					[]*github.Deployment{{ID: github.Ptr(int64(2)), Ref: github.Ptr("feature"), Environment: github.Ptr("staging")}},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+					[]*github.DeploymentStatus{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedDeployments: []deployment{
+				{ID: 2, Ref: "feature", Environment: "staging"},
+			},
+		},
+		{
+			name: "deployments listing fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDeploymentsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list deployments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListDeployments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedDeployments []deployment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedDeployments)
+			require.NoError(t, err)
+			require.Len(t, returnedDeployments, len(tc.expectedDeployments))
+			for i, expected := range tc.expectedDeployments {
+				actual := returnedDeployments[i]
+				assert.Equal(t, expected.ID, actual.ID)
+				assert.Equal(t, expected.SHA, actual.SHA)
+				assert.Equal(t, expected.Ref, actual.Ref)
+				assert.Equal(t, expected.Environment, actual.Environment)
+				assert.Equal(t, expected.Description, actual.Description)
+				assert.Equal(t, expected.Creator, actual.Creator)
+				assert.Equal(t, expected.State, actual.State)
+				if expected.CreatedAt != nil {
+					require.NotNil(t, actual.CreatedAt)
+					assert.True(t, expected.CreatedAt.Equal(*actual.CreatedAt))
+				}
+			}
+		})
+	}
+}
+
+func Test_CreateDeployment(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateDeployment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_deployment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "environment")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.Contains(t, tool.InputSchema.Properties, "auto_merge")
+	assert.Contains(t, tool.InputSchema.Properties, "required_contexts")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+
+	mockDeployment := &github.Deployment{
+		ID:          github.Ptr(int64(42)),
+		SHA:         github.Ptr("abc123"),
+		Ref:         github.Ptr("main"),
+		Environment: github.Ptr("staging"),
+		Description: github.Ptr("Deploy to staging"),
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedDeployment *deployment
+		expectedText       string
+		expectedErrMsg     string
+	}{
+		{
+			name: "successful deployment creation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref":               "main",
+						"environment":       "staging",
+						"description":       "Deploy to staging",
+						"auto_merge":        false,
+						"required_contexts": []interface{}{},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockDeployment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"ref":               "main",
+				"environment":       "staging",
+				"description":       "Deploy to staging",
+				"auto_merge":        false,
+				"required_contexts": []interface{}{},
+			},
+			expectError: false,
+			expectedDeployment: &deployment{
+				ID:          42,
+				SHA:         "abc123",
+				Ref:         "main",
+				Environment: "staging",
+				Description: "Deploy to staging",
+			},
+		},
+		{
+			name: "minimal deployment creation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "main",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockDeployment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "main",
+			},
+			expectError: false,
+			expectedDeployment: &deployment{
+				ID:          42,
+				SHA:         "abc123",
+				Ref:         "main",
+				Environment: "staging",
+				Description: "Deploy to staging",
+			},
+		},
+		{
+			name: "default branch auto-merged into ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusAccepted)
+						_, _ = w.Write([]byte(`{"message": "Auto-merged main into topic-branch on deployment."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "topic-branch",
+			},
+			expectError:  false,
+			expectedText: "Deployment was not created: Auto-merged main into topic-branch on deployment.",
+		},
+		{
+			name: "merge conflict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"message": "Conflict merging main into topic-branch."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "topic-branch",
+			},
+			expectError:    false,
+			expectedErrMsg: "Conflict merging main into topic-branch",
+		},
+		{
+			name: "deployment creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "No ref found for: missing"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create deployment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateDeployment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			if tc.expectedText != "" {
+				assert.False(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedText)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedDeployment deployment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedDeployment)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedDeployment, returnedDeployment)
+		})
+	}
+}
+
+func Test_ListDeploymentStatuses(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDeploymentStatuses(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_deployment_statuses", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "deployment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "deployment_id"})
+
+	mockStatuses := []*github.DeploymentStatus{
+		{
+			ID:          github.Ptr(int64(11)),
+			State:       github.Ptr("success"),
+			Environment: github.Ptr("production"),
+			LogURL:      github.Ptr("https://ci.example.com/run/2"),
+			Creator:     &github.User{Login: github.Ptr("deploy-bot")},
+		},
+		{
+			ID:          github.Ptr(int64(10)),
+			State:       github.Ptr("in_progress"),
+			Environment: github.Ptr("production"),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedStatuses []deploymentStatus
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful statuses listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "5",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockStatuses),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"deployment_id": float64(42),
+				"page":          float64(2),
+				"perPage":       float64(5),
+			},
+			expectError: false,
+			expectedStatuses: []deploymentStatus{
+				{ID: 11, State: "success", Environment: "production", LogURL: "https://ci.example.com/run/2", Creator: "deploy-bot"},
+				{ID: 10, State: "in_progress", Environment: "production"},
+			},
+		},
+		{
+			name: "deployment not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"deployment_id": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list deployment statuses",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListDeploymentStatuses(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedStatuses []deploymentStatus
+			err = json.Unmarshal([]byte(textContent.Text), &returnedStatuses)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatuses, returnedStatuses)
+		})
+	}
+}
+
+func Test_CreateDeploymentStatus(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateDeploymentStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_deployment_status", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "deployment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "environment")
+	assert.Contains(t, tool.InputSchema.Properties, "log_url")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "deployment_id", "state"})
+
+	mockStatus := &github.DeploymentStatus{
+		ID:          github.Ptr(int64(12)),
+		State:       github.Ptr("in_progress"),
+		Environment: github.Ptr("staging"),
+		Description: github.Ptr("Rolling out"),
+		LogURL:      github.Ptr("https://ci.example.com/run/3"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedStatus *deploymentStatus
+		expectedErrMsg string
+	}{
+		{
+			name: "successful status creation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+					expectRequestBody(t, map[string]interface{}{
+						"state":       "in_progress",
+						"environment": "staging",
+						"description": "Rolling out",
+						"log_url":     "https://ci.example.com/run/3",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockStatus),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"deployment_id": float64(42),
+				"state":         "in_progress",
+				"environment":   "staging",
+				"description":   "Rolling out",
+				"log_url":       "https://ci.example.com/run/3",
+			},
+			expectError: false,
+			expectedStatus: &deploymentStatus{
+				ID:          12,
+				State:       "in_progress",
+				Environment: "staging",
+				Description: "Rolling out",
+				LogURL:      "https://ci.example.com/run/3",
+			},
+		},
+		{
+			name:         "invalid state",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"deployment_id": float64(42),
+				"state":         "done",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid state "done", must be one of: error, failure, inactive, in_progress, queued, pending, success`,
+		},
+		{
+			name: "status creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDeploymentsStatusesByOwnerByRepoByDeploymentId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"deployment_id": float64(999),
+				"state":         "success",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create deployment status",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateDeploymentStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedStatus deploymentStatus
+			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedStatus, returnedStatus)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// environment is the subset of a GitHub deployment environment returned by the environment
+// tools, with its protection rules flattened so it is clear what gates a deployment.
+type environment struct {
+	Name              string                `json:"name"`
+	HTMLURL           string                `json:"html_url,omitempty"`
+	WaitTimer         int                   `json:"wait_timer,omitempty"`
+	RequiredReviewers []environmentReviewer `json:"required_reviewers,omitempty"`
+	PreventSelfReview bool                  `json:"prevent_self_review,omitempty"`
+	CanAdminsBypass   bool                  `json:"can_admins_bypass"`
+	// DeploymentBranchPolicy is nil when any branch can deploy to the environment.
+	DeploymentBranchPolicy *github.BranchPolicy `json:"deployment_branch_policy,omitempty"`
+}
+
+// environmentReviewer is a user or team whose approval is required to deploy to an environment.
+type environmentReviewer struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+func newEnvironment(env *github.Environment) environment {
+	result := environment{
+		Name:                   env.GetName(),
+		HTMLURL:                env.GetHTMLURL(),
+		CanAdminsBypass:        env.GetCanAdminsBypass(),
+		DeploymentBranchPolicy: env.DeploymentBranchPolicy,
+	}
+	for _, rule := range env.ProtectionRules {
+		switch rule.GetType() {
+		case "wait_timer":
+			result.WaitTimer = rule.GetWaitTimer()
+		case "required_reviewers":
+			result.PreventSelfReview = rule.GetPreventSelfReview()
+			for _, reviewer := range rule.Reviewers {
+				switch r := reviewer.Reviewer.(type) {
+				case *github.User:
+					result.RequiredReviewers = append(result.RequiredReviewers, environmentReviewer{Type: "User", Name: r.GetLogin()})
+				case *github.Team:
+					result.RequiredReviewers = append(result.RequiredReviewers, environmentReviewer{Type: "Team", Name: r.GetSlug()})
+				}
+			}
+		}
+	}
+	return result
+}
+
+// ListEnvironments creates a tool to list the deployment environments of a GitHub repository.
+func ListEnvironments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_environments",
+			mcp.WithDescription(t("TOOL_LIST_ENVIRONMENTS_DESCRIPTION", "List the deployment environments of a GitHub repository, with their protection rules")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.EnvironmentListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			environments, resp, err := client.Repositories.ListEnvironments(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list environments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list environments: %s", string(body))), nil
+			}
+
+			result := make([]environment, 0, len(environments.Environments))
+			for _, env := range environments.Environments {
+				result = append(result, newEnvironment(env))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetEnvironment creates a tool to get a deployment environment of a GitHub repository.
+func GetEnvironment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_environment",
+			mcp.WithDescription(t("TOOL_GET_ENVIRONMENT_DESCRIPTION", "Get a deployment environment of a GitHub repository, including the required reviewers, wait timer and deployment branch policy that gate deployments to it")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("environment_name",
+				mcp.Required(),
+				mcp.Description("Environment name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "environment_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			env, resp, err := client.Repositories.GetEnvironment(ctx, owner, repo, name)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("environment not found: %s", name)), nil
+				}
+				return nil, fmt.Errorf("failed to get environment: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get environment: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newEnvironment(env))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
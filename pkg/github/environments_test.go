@@ -0,0 +1,244 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockProductionEnvironment = &github.Environment{
+	Name:            github.Ptr("production"),
+	HTMLURL:         github.Ptr("https://github.com/owner/repo/deployments/activity_log?environments_filter=production"),
+	CanAdminsBypass: github.Ptr(false),
+	DeploymentBranchPolicy: &github.BranchPolicy{
+		ProtectedBranches:    github.Ptr(true),
+		CustomBranchPolicies: github.Ptr(false),
+	},
+	ProtectionRules: []*github.ProtectionRule{
+		{
+			ID:        github.Ptr(int64(1)),
+			Type:      github.Ptr("wait_timer"),
+			WaitTimer: github.Ptr(30),
+		},
+		{
+			ID:                github.Ptr(int64(2)),
+			Type:              github.Ptr("required_reviewers"),
+			PreventSelfReview: github.Ptr(true),
+			Reviewers: []*github.RequiredReviewer{
+				{Type: github.Ptr("User"), Reviewer: &github.User{Login: github.Ptr("octocat")}},
+				{Type: github.Ptr("Team"), Reviewer: &github.Team{Slug: github.Ptr("release-managers")}},
+			},
+		},
+	},
+}
+
+var expectedProductionEnvironment = environment{
+	Name:              "production",
+	HTMLURL:           "https://github.com/owner/repo/deployments/activity_log?environments_filter=production",
+	WaitTimer:         30,
+	PreventSelfReview: true,
+	RequiredReviewers: []environmentReviewer{
+		{Type: "User", Name: "octocat"},
+		{Type: "Team", Name: "release-managers"},
+	},
+	DeploymentBranchPolicy: &github.BranchPolicy{
+		ProtectedBranches:    github.Ptr(true),
+		CustomBranchPolicies: github.Ptr(false),
+	},
+}
+
+func Test_ListEnvironments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListEnvironments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_environments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name                 string
+		mockedClient         *http.Client
+		requestArgs          map[string]interface{}
+		expectError          bool
+		expectedEnvironments []environment
+		expectedErrMsg       string
+	}{
+		{
+			name: "successful environments listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposEnvironmentsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.EnvResponse{
+							TotalCount: github.Ptr(2),
+							Environments: []*github.Environment{
+								mockProductionEnvironment,
+								{Name: github.Ptr("staging"), CanAdminsBypass: github.Ptr(true)},
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedEnvironments: []environment{
+				expectedProductionEnvironment,
+				{Name: "staging", CanAdminsBypass: true},
+			},
+		},
+		{
+			name: "environments listing fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposEnvironmentsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list environments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListEnvironments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedEnvironments []environment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedEnvironments)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEnvironments, returnedEnvironments)
+		})
+	}
+}
+
+func Test_GetEnvironment(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetEnvironment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_environment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "environment_name")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "environment_name"})
+
+	tests := []struct {
+		name                string
+		mockedClient        *http.Client
+		requestArgs         map[string]interface{}
+		expectError         bool
+		expectedEnvironment environment
+		expectedErrMsg      string
+	}{
+		{
+			name: "successful environment retrieval",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposEnvironmentsByOwnerByRepoByEnvironmentName,
+					mockProductionEnvironment,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"environment_name": "production",
+			},
+			expectError:         false,
+			expectedEnvironment: expectedProductionEnvironment,
+		},
+		{
+			name: "environment not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposEnvironmentsByOwnerByRepoByEnvironmentName,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"environment_name": "qa",
+			},
+			expectError:    false,
+			expectedErrMsg: "environment not found: qa",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetEnvironment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedEnvironment environment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedEnvironment)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEnvironment, returnedEnvironment)
+		})
+	}
+}
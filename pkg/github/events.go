@@ -0,0 +1,228 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// activityEvent is an event of the GitHub activity feed. The payload of an event can be large,
+// so it is condensed into a one-line summary such as "opened pull request #12: Fix typo".
+type activityEvent struct {
+	ID         string            `json:"id"`
+	Type       string            `json:"type"`
+	Actor      string            `json:"actor"`
+	Repository string            `json:"repository"`
+	CreatedAt  *github.Timestamp `json:"created_at,omitempty"`
+	Summary    string            `json:"summary,omitempty"`
+}
+
+func newActivityEvent(e *github.Event) activityEvent {
+	return activityEvent{
+		ID:         e.GetID(),
+		Type:       e.GetType(),
+		Actor:      e.GetActor().GetLogin(),
+		Repository: e.GetRepo().GetName(),
+		CreatedAt:  e.CreatedAt,
+		Summary:    summarizeEvent(e),
+	}
+}
+
+// summarizeEvent describes what happened in an event. It returns an empty summary for event
+// types it doesn't know and for payloads that can't be parsed.
+func summarizeEvent(e *github.Event) string {
+	payload, err := e.ParsePayload()
+	if err != nil {
+		return ""
+	}
+
+	switch p := payload.(type) {
+	case *github.PushEvent:
+		commits := "commits"
+		if p.GetSize() == 1 {
+			commits = "commit"
+		}
+		return fmt.Sprintf("pushed %d %s to %s", p.GetSize(), commits, strings.TrimPrefix(p.GetRef(), "refs/heads/"))
+	case *github.PullRequestEvent:
+		action := p.GetAction()
+		if action == "closed" && p.GetPullRequest().GetMerged() {
+			action = "merged"
+		}
+		return fmt.Sprintf("%s pull request #%d: %s", action, p.GetNumber(), p.GetPullRequest().GetTitle())
+	case *github.PullRequestReviewEvent:
+		return fmt.Sprintf("reviewed pull request #%d (%s): %s", p.GetPullRequest().GetNumber(), strings.ToLower(p.GetReview().GetState()), p.GetPullRequest().GetTitle())
+	case *github.PullRequestReviewCommentEvent:
+		return fmt.Sprintf("commented on the diff of pull request #%d: %s", p.GetPullRequest().GetNumber(), p.GetPullRequest().GetTitle())
+	case *github.IssuesEvent:
+		return fmt.Sprintf("%s issue #%d: %s", p.GetAction(), p.GetIssue().GetNumber(), p.GetIssue().GetTitle())
+	case *github.IssueCommentEvent:
+		kind := "issue"
+		if p.GetIssue().IsPullRequest() {
+			kind = "pull request"
+		}
+		return fmt.Sprintf("commented on %s #%d: %s", kind, p.GetIssue().GetNumber(), p.GetIssue().GetTitle())
+	case *github.CommitCommentEvent:
+		return fmt.Sprintf("commented on commit %s", p.GetComment().GetCommitID())
+	case *github.CreateEvent:
+		if p.GetRefType() == "repository" {
+			return "created the repository"
+		}
+		return fmt.Sprintf("created %s %s", p.GetRefType(), p.GetRef())
+	case *github.DeleteEvent:
+		return fmt.Sprintf("deleted %s %s", p.GetRefType(), p.GetRef())
+	case *github.ReleaseEvent:
+		return fmt.Sprintf("%s release %s", p.GetAction(), p.GetRelease().GetTagName())
+	case *github.ForkEvent:
+		return fmt.Sprintf("forked the repository to %s", p.GetForkee().GetFullName())
+	case *github.WatchEvent:
+		return "starred the repository"
+	case *github.MemberEvent:
+		return fmt.Sprintf("%s collaborator %s", p.GetAction(), p.GetMember().GetLogin())
+	case *github.PublicEvent:
+		return "made the repository public"
+	case *github.GollumEvent:
+		return "updated the wiki"
+	default:
+		return ""
+	}
+}
+
+// ListRepositoryEvents creates a tool to list the recent activity in a repository.
+func ListRepositoryEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_events",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_EVENTS_DESCRIPTION", "List the recent activity in a repository, newest first: pushes, pull requests, issues, comments, releases and more, each with its actor, time and a short summary")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			events, resp, err := client.Activity.ListRepositoryEvents(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list repository events: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list repository events: %s", string(body))), nil
+			}
+
+			result := make([]activityEvent, 0, len(events))
+			for _, e := range events {
+				result = append(result, newActivityEvent(e))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListUserEvents creates a tool to list the recent activity of a user across repositories.
+func ListUserEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_user_events",
+			mcp.WithDescription(t("TOOL_LIST_USER_EVENTS_DESCRIPTION", "List the recent activity of a GitHub user across repositories, newest first: pushes, pull requests, reviews, issues, comments and more, each with its repository, time and a short summary. Private events are included only for the authenticated user")),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username"),
+			),
+			mcp.WithBoolean("public_only",
+				mcp.Description("Only list public events, even for the authenticated user"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			publicOnly, err := OptionalParam[bool](request, "public_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			events, resp, err := client.Activity.ListEventsPerformedByUser(ctx, username, publicOnly, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("user not found: %s", username)), nil
+				}
+				return nil, fmt.Errorf("failed to list user events: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list user events: %s", string(body))), nil
+			}
+
+			result := make([]activityEvent, 0, len(events))
+			for _, e := range events {
+				result = append(result, newActivityEvent(e))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
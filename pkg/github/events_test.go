@@ -0,0 +1,286 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryEvents(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryEvents(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_events", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	createdAt := &github.Timestamp{Time: time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)}
+	event := func(id, eventType, payload string) *github.Event {
+		raw := json.RawMessage(payload)
+		return &github.Event{
+			ID:         github.Ptr(id),
+			Type:       github.Ptr(eventType),
+			Actor:      &github.User{Login: github.Ptr("octocat")},
+			Repo:       &github.Repository{Name: github.Ptr("owner/repo")},
+			CreatedAt:  createdAt,
+			RawPayload: &raw,
+		}
+	}
+	mockEvents := []*github.Event{
+		event("1", "PushEvent", `{"ref": "refs/heads/main", "size": 3}`),
+		event("2", "PullRequestEvent", `{"action": "closed", "number": 12, "pull_request": {"title": "Fix typo", "merged": true}}`),
+		event("3", "IssueCommentEvent", `{"action": "created", "issue": {"number": 7, "title": "Crash on start", "pull_request": {}}}`),
+		event("4", "CreateEvent", `{"ref": "v1.0.0", "ref_type": "tag"}`),
+		event("5", "SponsorshipEvent", `{}`),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedEvents []activityEvent
+		expectedErrMsg string
+	}{
+		{
+			name: "list repository events",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposEventsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "5",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockEvents),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(5),
+			},
+			expectError: false,
+			expectedEvents: []activityEvent{
+				{ID: "1", Type: "PushEvent", Actor: "octocat", Repository: "owner/repo", CreatedAt: createdAt, Summary: "pushed 3 commits to main"},
+				{ID: "2", Type: "PullRequestEvent", Actor: "octocat", Repository: "owner/repo", CreatedAt: createdAt, Summary: "merged pull request #12: Fix typo"},
+				{ID: "3", Type: "IssueCommentEvent", Actor: "octocat", Repository: "owner/repo", CreatedAt: createdAt, Summary: "commented on pull request #7: Crash on start"},
+				{ID: "4", Type: "CreateEvent", Actor: "octocat", Repository: "owner/repo", CreatedAt: createdAt, Summary: "created tag v1.0.0"},
+				{ID: "5", Type: "SponsorshipEvent", Actor: "octocat", Repository: "owner/repo", CreatedAt: createdAt},
+			},
+		},
+		{
+			name: "list repository events fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposEventsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list repository events",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositoryEvents(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedEvents []activityEvent
+			err = json.Unmarshal([]byte(textContent.Text), &returnedEvents)
+			require.NoError(t, err)
+			require.Len(t, returnedEvents, len(tc.expectedEvents))
+			for i, expected := range tc.expectedEvents {
+				assert.Equal(t, expected.ID, returnedEvents[i].ID)
+				assert.Equal(t, expected.Type, returnedEvents[i].Type)
+				assert.Equal(t, expected.Actor, returnedEvents[i].Actor)
+				assert.Equal(t, expected.Repository, returnedEvents[i].Repository)
+				assert.True(t, expected.CreatedAt.Equal(*returnedEvents[i].CreatedAt))
+				assert.Equal(t, expected.Summary, returnedEvents[i].Summary)
+			}
+		})
+	}
+}
+
+func Test_ListUserEvents(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListUserEvents(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_user_events", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "public_only")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"username"})
+
+	createdAt := &github.Timestamp{Time: time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)}
+	event := func(id, eventType, repo, payload string) *github.Event {
+		raw := json.RawMessage(payload)
+		return &github.Event{
+			ID:         github.Ptr(id),
+			Type:       github.Ptr(eventType),
+			Actor:      &github.User{Login: github.Ptr("octocat")},
+			Repo:       &github.Repository{Name: github.Ptr(repo)},
+			CreatedAt:  createdAt,
+			RawPayload: &raw,
+		}
+	}
+	mockEvents := []*github.Event{
+		event("1", "PushEvent", "octocat/hello-world", `{"ref": "refs/heads/main", "size": 1}`),
+		event("2", "PullRequestReviewEvent", "github/docs", `{"action": "created", "review": {"state": "APPROVED"}, "pull_request": {"number": 3, "title": "Add guide"}}`),
+	}
+	expectedEvents := []activityEvent{
+		{ID: "1", Type: "PushEvent", Actor: "octocat", Repository: "octocat/hello-world", CreatedAt: createdAt, Summary: "pushed 1 commit to main"},
+		{ID: "2", Type: "PullRequestReviewEvent", Actor: "octocat", Repository: "github/docs", CreatedAt: createdAt, Summary: "reviewed pull request #3 (approved): Add guide"},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedEvents []activityEvent
+		expectedErrMsg string
+	}{
+		{
+			name: "list user events",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersEventsByUsername,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "5",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockEvents),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+				"page":     float64(2),
+				"perPage":  float64(5),
+			},
+			expectedEvents: expectedEvents,
+		},
+		{
+			name: "list public user events",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersEventsPublicByUsername,
+					mockEvents,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username":    "octocat",
+				"public_only": true,
+			},
+			expectedEvents: expectedEvents,
+		},
+		{
+			name: "user not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersEventsByUsername,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "missing",
+			},
+			expectedErrMsg: "user not found: missing",
+		},
+		{
+			name: "list user events fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersEventsByUsername,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Internal Server Error"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list user events",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListUserEvents(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedEvents []activityEvent
+			err = json.Unmarshal([]byte(textContent.Text), &returnedEvents)
+			require.NoError(t, err)
+			require.Len(t, returnedEvents, len(tc.expectedEvents))
+			for i, expected := range tc.expectedEvents {
+				assert.Equal(t, expected.ID, returnedEvents[i].ID)
+				assert.Equal(t, expected.Type, returnedEvents[i].Type)
+				assert.Equal(t, expected.Repository, returnedEvents[i].Repository)
+				assert.True(t, expected.CreatedAt.Equal(*returnedEvents[i].CreatedAt))
+				assert.Equal(t, expected.Summary, returnedEvents[i].Summary)
+			}
+		})
+	}
+}
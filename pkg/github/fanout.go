@@ -0,0 +1,47 @@
+package github
+
+import (
+	"context"
+	"sync"
+)
+
+// fanOut calls fn on each of items, running at most concurrency calls at a time, and returns
+// their results and errors in the order of items: results[i] and errs[i] are those of items[i].
+// A failing call doesn't stop the others, so callers get every result that could be fetched.
+// Items not started yet when ctx is done are skipped, with the error of ctx.
+func fanOut[T, R any](ctx context.Context, items []T, concurrency int, fn func(context.Context, T) (R, error)) ([]R, []error) {
+	results := make([]R, len(items))
+	errs := make([]error, len(items))
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	workers := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		select {
+		case workers <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		// ctx can be done while waiting for a worker, and select picks at random among
+		// ready cases, so check it again.
+		if err := ctx.Err(); err != nil {
+			<-workers
+			errs[i] = err
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			results[i], errs[i] = fn(ctx, item)
+		}()
+	}
+	wg.Wait()
+
+	return results, errs
+}
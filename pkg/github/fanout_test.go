@@ -0,0 +1,97 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fanOut(t *testing.T) {
+	t.Run("results are in the order of items", func(t *testing.T) {
+		items := []int{5, 4, 3, 2, 1}
+		results, errs := fanOut(context.Background(), items, 3, func(_ context.Context, n int) (string, error) {
+			// Later items finish first
+			time.Sleep(time.Duration(n) * time.Millisecond)
+			return fmt.Sprintf("item %d", n), nil
+		})
+		assert.Equal(t, []string{"item 5", "item 4", "item 3", "item 2", "item 1"}, results)
+		assert.Equal(t, []error{nil, nil, nil, nil, nil}, errs)
+	})
+
+	t.Run("failures don't stop the other calls", func(t *testing.T) {
+		errOdd := errors.New("odd")
+		results, errs := fanOut(context.Background(), []int{1, 2, 3, 4}, 2, func(_ context.Context, n int) (int, error) {
+			if n%2 == 1 {
+				return 0, errOdd
+			}
+			return n * 10, nil
+		})
+		assert.Equal(t, []int{0, 20, 0, 40}, results)
+		assert.Equal(t, []error{errOdd, nil, errOdd, nil}, errs)
+	})
+
+	t.Run("at most concurrency calls at a time", func(t *testing.T) {
+		var running, maxRunning atomic.Int32
+		_, errs := fanOut(context.Background(), make([]int, 20), 4, func(_ context.Context, _ int) (struct{}, error) {
+			n := running.Add(1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+			return struct{}{}, nil
+		})
+		assert.Len(t, errs, 20)
+		assert.LessOrEqual(t, maxRunning.Load(), int32(4))
+	})
+
+	t.Run("no items", func(t *testing.T) {
+		results, errs := fanOut(context.Background(), []string{}, 5, func(_ context.Context, s string) (string, error) {
+			return s, nil
+		})
+		assert.Empty(t, results)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("cancelled context skips every item", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var calls atomic.Int32
+		_, errs := fanOut(ctx, []int{1, 2, 3}, 2, func(_ context.Context, _ int) (int, error) {
+			calls.Add(1)
+			return 0, nil
+		})
+		assert.Zero(t, calls.Load())
+		for _, err := range errs {
+			assert.ErrorIs(t, err, context.Canceled)
+		}
+	})
+
+	t.Run("cancellation skips the items not started yet", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		results, errs := fanOut(ctx, []int{1, 2, 3}, 1, func(ctx context.Context, n int) (int, error) {
+			if n == 2 {
+				cancel()
+				return 0, ctx.Err()
+			}
+			return n, nil
+		})
+		assert.Equal(t, []int{1, 0, 0}, results)
+		require.Len(t, errs, 3)
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], context.Canceled)
+		assert.ErrorIs(t, errs[2], context.Canceled)
+	})
+}
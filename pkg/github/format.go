@@ -0,0 +1,289 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// responseFormats are the formats read tools can render their result in.
+var responseFormats = []string{"json", "markdown"}
+
+// WithResponseFormat returns a ToolOption that adds a "response_format" parameter to a tool,
+// letting the caller pick between the JSON result and a compact Markdown summary of it.
+func WithResponseFormat() mcp.ToolOption {
+	return mcp.WithString("response_format",
+		mcp.Description("Format of the result: 'json' (default) for the full object, or 'markdown' for a compact human-readable summary"),
+		mcp.Enum(responseFormats...),
+	)
+}
+
+// OptionalResponseFormat returns the "response_format" parameter from the request, or "json"
+// if it is not present.
+func OptionalResponseFormat(r mcp.CallToolRequest) (string, error) {
+	format, err := OptionalParam[string](r, "response_format")
+	if err != nil {
+		return "", err
+	}
+	if format == "" {
+		return "json", nil
+	}
+	if !slices.Contains(responseFormats, format) {
+		return "", fmt.Errorf("invalid response_format %q, must be one of: %s", format, strings.Join(responseFormats, ", "))
+	}
+	return format, nil
+}
+
+// formattedResult returns v marshaled to JSON, or the Markdown rendered by toMarkdown when
+// format is "markdown".
+func formattedResult(format string, v any, toMarkdown func() string) (*mcp.CallToolResult, error) {
+	if format == "markdown" {
+		return mcp.NewToolResultText(toMarkdown()), nil
+	}
+	r, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// markdownCell makes s safe to use in a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+// markdownTable renders a Markdown table with the given header and rows.
+func markdownTable(header []string, rows [][]string) string {
+	var b strings.Builder
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for _, c := range cells {
+			b.WriteString(" " + markdownCell(c) + " |")
+		}
+		b.WriteString("\n")
+	}
+	writeRow(header)
+	b.WriteString(strings.Repeat("| --- ", len(header)) + "|\n")
+	for _, row := range rows {
+		writeRow(row)
+	}
+	return b.String()
+}
+
+// markdownFields renders a Markdown list of name: value pairs, skipping empty values.
+func markdownFields(fields [][2]string) string {
+	var b strings.Builder
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "- **%s**: %s\n", f[0], f[1])
+	}
+	return b.String()
+}
+
+func markdownDate(ts *github.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.Format("2006-01-02")
+}
+
+func issueLabelNames(labels []*github.Label) string {
+	names := make([]string, 0, len(labels))
+	for _, l := range labels {
+		names = append(names, l.GetName())
+	}
+	return strings.Join(names, ", ")
+}
+
+// pullRequestState tells merged and draft pull requests apart from other closed and open ones.
+func pullRequestState(pr *github.PullRequest) string {
+	switch {
+	case pr.MergedAt != nil:
+		return "merged"
+	case pr.GetDraft() && pr.GetState() == "open":
+		return "draft"
+	default:
+		return pr.GetState()
+	}
+}
+
+// pullRequestBranches renders the head and base branches of a pull request as "head → base".
+func pullRequestBranches(pr *github.PullRequest) string {
+	if pr.GetHead().GetRef() == "" && pr.GetBase().GetRef() == "" {
+		return ""
+	}
+	return pr.GetHead().GetRef() + " → " + pr.GetBase().GetRef()
+}
+
+func issuesMarkdown(issues []*github.Issue) string {
+	if len(issues) == 0 {
+		return "No issues found.\n"
+	}
+	rows := make([][]string, 0, len(issues))
+	for _, i := range issues {
+		rows = append(rows, []string{
+			"#" + strconv.Itoa(i.GetNumber()),
+			i.GetTitle(),
+			i.GetState(),
+			i.GetUser().GetLogin(),
+			issueLabelNames(i.Labels),
+			strconv.Itoa(i.GetComments()),
+			strconv.Itoa(i.GetReactions().GetTotalCount()),
+			markdownDate(i.UpdatedAt),
+		})
+	}
+	return markdownTable([]string{"Number", "Title", "State", "Author", "Labels", "Comments", "Reactions", "Updated"}, rows)
+}
+
+func pullRequestsMarkdown(prs []*github.PullRequest) string {
+	if len(prs) == 0 {
+		return "No pull requests found.\n"
+	}
+	rows := make([][]string, 0, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, []string{
+			"#" + strconv.Itoa(pr.GetNumber()),
+			pr.GetTitle(),
+			pullRequestState(pr),
+			pr.GetUser().GetLogin(),
+			pullRequestBranches(pr),
+			markdownDate(pr.UpdatedAt),
+		})
+	}
+	return markdownTable([]string{"Number", "Title", "State", "Author", "Branches", "Updated"}, rows)
+}
+
+func pullRequestMarkdown(pr *github.PullRequest) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# #%d %s\n\n", pr.GetNumber(), pr.GetTitle())
+
+	var mergeable string
+	if pr.Mergeable != nil {
+		mergeable = strconv.FormatBool(pr.GetMergeable())
+		if state := pr.GetMergeableState(); state != "" {
+			mergeable += " (" + state + ")"
+		}
+	}
+	var changes string
+	if pr.ChangedFiles != nil {
+		changes = fmt.Sprintf("+%d -%d in %d files", pr.GetAdditions(), pr.GetDeletions(), pr.GetChangedFiles())
+	}
+	b.WriteString(markdownFields([][2]string{
+		{"State", pullRequestState(pr)},
+		{"Author", pr.GetUser().GetLogin()},
+		{"Branches", pullRequestBranches(pr)},
+		{"Mergeable", mergeable},
+		{"Changes", changes},
+		{"Created", markdownDate(pr.CreatedAt)},
+		{"Merged", markdownDate(pr.MergedAt)},
+		{"URL", pr.GetHTMLURL()},
+	}))
+	if body := strings.TrimSpace(pr.GetBody()); body != "" {
+		b.WriteString("\n" + body + "\n")
+	}
+	return b.String()
+}
+
+func repositoryMarkdown(repo *github.Repository) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", repo.GetFullName())
+	if desc := repo.GetDescription(); desc != "" {
+		b.WriteString(desc + "\n\n")
+	}
+
+	var flags []string
+	if repo.GetFork() {
+		fork := "fork"
+		if parent := repo.GetParent().GetFullName(); parent != "" {
+			fork += " of " + parent
+		}
+		flags = append(flags, fork)
+	}
+	if repo.GetArchived() {
+		flags = append(flags, "archived")
+	}
+	b.WriteString(markdownFields([][2]string{
+		{"Visibility", repo.GetVisibility()},
+		{"Default branch", repo.GetDefaultBranch()},
+		{"Language", repo.GetLanguage()},
+		{"Stars", strconv.Itoa(repo.GetStargazersCount())},
+		{"Forks", strconv.Itoa(repo.GetForksCount())},
+		{"Open issues", strconv.Itoa(repo.GetOpenIssuesCount())},
+		{"Topics", strings.Join(repo.Topics, ", ")},
+		{"Status", strings.Join(flags, ", ")},
+		{"Last push", markdownDate(repo.PushedAt)},
+		{"URL", repo.GetHTMLURL()},
+	}))
+	return b.String()
+}
+
+// pullRequestIncludesMarkdown renders the related lists fetched for get_pull_request, one
+// section per requested list, in the order they were requested.
+func pullRequestIncludesMarkdown(included pullRequestIncluded) string {
+	var b strings.Builder
+	for _, name := range included.include {
+		var title, table string
+		switch name {
+		case "files":
+			title = "Files"
+			rows := make([][]string, 0, len(included.files))
+			for _, f := range included.files {
+				rows = append(rows, []string{f.GetFilename(), f.GetStatus(), fmt.Sprintf("+%d -%d", f.GetAdditions(), f.GetDeletions())})
+			}
+			table = markdownSection(rows, []string{"File", "Status", "Changes"})
+		case "reviews":
+			title = "Reviews"
+			rows := make([][]string, 0, len(included.reviews))
+			for _, r := range included.reviews {
+				rows = append(rows, []string{r.GetUser().GetLogin(), r.GetState(), markdownDate(r.SubmittedAt)})
+			}
+			table = markdownSection(rows, []string{"Reviewer", "State", "Submitted"})
+		case "comments":
+			title = "Review comments"
+			rows := make([][]string, 0, len(included.comments))
+			for _, c := range included.comments {
+				var line string
+				if c.Line != nil {
+					line = strconv.Itoa(c.GetLine())
+				}
+				rows = append(rows, []string{c.GetUser().GetLogin(), c.GetPath(), line, c.GetBody()})
+			}
+			table = markdownSection(rows, []string{"Author", "Path", "Line", "Comment"})
+		case "commits":
+			title = "Commits"
+			rows := make([][]string, 0, len(included.commits))
+			for _, c := range included.commits {
+				sha := c.SHA
+				if len(sha) > 7 {
+					sha = sha[:7]
+				}
+				message, _, _ := strings.Cut(c.Message, "\n")
+				author := c.Author.Login
+				if author == "" {
+					author = c.Author.Name
+				}
+				rows = append(rows, []string{sha, message, author})
+			}
+			table = markdownSection(rows, []string{"SHA", "Message", "Author"})
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n%s", title, table)
+	}
+	return b.String()
+}
+
+// markdownSection renders rows as a table, or says there are none.
+func markdownSection(rows [][]string, header []string) string {
+	if len(rows) == 0 {
+		return "None.\n"
+	}
+	return markdownTable(header, rows)
+}
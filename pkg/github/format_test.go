@@ -0,0 +1,216 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OptionalResponseFormat(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		expected       string
+		expectedErrMsg string
+	}{
+		{
+			name:     "defaults to json",
+			args:     map[string]interface{}{},
+			expected: "json",
+		},
+		{
+			name:     "markdown",
+			args:     map[string]interface{}{"response_format": "markdown"},
+			expected: "markdown",
+		},
+		{
+			name:           "unknown format",
+			args:           map[string]interface{}{"response_format": "yaml"},
+			expectedErrMsg: `invalid response_format "yaml", must be one of: json, markdown`,
+		},
+		{
+			name:           "wrong type",
+			args:           map[string]interface{}{"response_format": float64(1)},
+			expectedErrMsg: "parameter response_format is not of type string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			format, err := OptionalResponseFormat(createMCPRequest(tc.args))
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, format)
+		})
+	}
+}
+
+func Test_MarkdownTable(t *testing.T) {
+	table := markdownTable(
+		[]string{"Number", "Title"},
+		[][]string{
+			{"#1", "Fix a | b"},
+			{"#2", "Two\nlines"},
+		},
+	)
+	assert.Equal(t, "| Number | Title |\n| --- | --- |\n| #1 | Fix a \\| b |\n| #2 | Two lines |\n", table)
+}
+
+func Test_PullRequestMarkdown(t *testing.T) {
+	pr := &github.PullRequest{
+		Number:         github.Ptr(42),
+		Title:          github.Ptr("Add feature"),
+		State:          github.Ptr("open"),
+		Draft:          github.Ptr(true),
+		User:           &github.User{Login: github.Ptr("octocat")},
+		Head:           &github.PullRequestBranch{Ref: github.Ptr("feature")},
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+		Mergeable:      github.Ptr(true),
+		MergeableState: github.Ptr("clean"),
+		Additions:      github.Ptr(10),
+		Deletions:      github.Ptr(2),
+		ChangedFiles:   github.Ptr(3),
+		CreatedAt:      &github.Timestamp{Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+		HTMLURL:        github.Ptr("https://github.com/owner/repo/pull/42"),
+		Body:           github.Ptr("Adds the feature.\n"),
+	}
+
+	expected := "# #42 Add feature\n\n" +
+		"- **State**: draft\n" +
+		"- **Author**: octocat\n" +
+		"- **Branches**: feature → main\n" +
+		"- **Mergeable**: true (clean)\n" +
+		"- **Changes**: +10 -2 in 3 files\n" +
+		"- **Created**: 2025-01-02\n" +
+		"- **URL**: https://github.com/owner/repo/pull/42\n" +
+		"\nAdds the feature.\n"
+	assert.Equal(t, expected, pullRequestMarkdown(pr))
+}
+
+func Test_RepositoryMarkdown(t *testing.T) {
+	repo := &github.Repository{
+		FullName:        github.Ptr("owner/repo"),
+		Description:     github.Ptr("A test repository"),
+		Visibility:      github.Ptr("public"),
+		DefaultBranch:   github.Ptr("main"),
+		Language:        github.Ptr("Go"),
+		StargazersCount: github.Ptr(5),
+		ForksCount:      github.Ptr(1),
+		OpenIssuesCount: github.Ptr(0),
+		Topics:          []string{"mcp", "github"},
+		Fork:            github.Ptr(true),
+		Parent:          &github.Repository{FullName: github.Ptr("upstream/repo")},
+		Archived:        github.Ptr(true),
+		HTMLURL:         github.Ptr("https://github.com/owner/repo"),
+	}
+
+	expected := "# owner/repo\n\n" +
+		"A test repository\n\n" +
+		"- **Visibility**: public\n" +
+		"- **Default branch**: main\n" +
+		"- **Language**: Go\n" +
+		"- **Stars**: 5\n" +
+		"- **Forks**: 1\n" +
+		"- **Open issues**: 0\n" +
+		"- **Topics**: mcp, github\n" +
+		"- **Status**: fork of upstream/repo, archived\n" +
+		"- **URL**: https://github.com/owner/repo\n"
+	assert.Equal(t, expected, repositoryMarkdown(repo))
+}
+
+func Test_ListIssues_MarkdownFormat(t *testing.T) {
+	mockIssues := []*github.Issue{
+		{
+			Number:    github.Ptr(1),
+			Title:     github.Ptr("Crash on start"),
+			State:     github.Ptr("open"),
+			User:      &github.User{Login: github.Ptr("octocat")},
+			Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("p1")}},
+			Comments:  github.Ptr(3),
+			Reactions: &github.Reactions{TotalCount: github.Ptr(7)},
+			UpdatedAt: &github.Timestamp{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedText   string
+		expectToolErr  bool
+		expectedErrMsg string
+	}{
+		{
+			name: "renders a table",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepo,
+					mockIssues,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"response_format": "markdown",
+			},
+			expectedText: "| Number | Title | State | Author | Labels | Comments | Reactions | Updated |\n" +
+				"| --- | --- | --- | --- | --- | --- | --- | --- |\n" +
+				"| #1 | Crash on start | open | octocat | bug, p1 | 3 | 7 | 2025-02-01 |\n",
+		},
+		{
+			name: "no issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepo,
+					[]*github.Issue{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"response_format": "markdown",
+			},
+			expectedText: "No issues found.\n",
+		},
+		{
+			name:         "invalid format",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"response_format": "html",
+			},
+			expectToolErr:  true,
+			expectedErrMsg: `invalid response_format "html", must be one of: json, markdown`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// maxGraphQLQueryLength is the maximum length in bytes of a query accepted by the graphql tool.
+	maxGraphQLQueryLength = 10000
+	// maxGraphQLQueryDepth is the maximum selection set nesting of a query accepted by the graphql tool.
+	maxGraphQLQueryDepth = 10
+)
+
+// graphQLResponse is the response of the GitHub GraphQL API. GraphQL reports most failures
+// in Errors with a 200 status, possibly alongside partial Data.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []graphQLError  `json:"errors,omitempty"`
+}
+
+type graphQLError struct {
+	Type    string        `json:"type,omitempty"`
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// err returns the errors of the response as a single error, or nil if there are none.
+func (r *graphQLResponse) err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		messages = append(messages, e.Message)
+	}
+	return fmt.Errorf("graphql: %s", strings.Join(messages, "; "))
+}
+
+// queryGraphQL posts a query to the GraphQL API of the client's GitHub instance, with the same
+// authentication as its REST requests. The endpoint is resolved relative to the REST base URL,
+// which maps https://api.github.com/ to https://api.github.com/graphql and
+// https://HOST/api/v3/ to https://HOST/api/graphql on GitHub Enterprise Server.
+func queryGraphQL(ctx context.Context, client *github.Client, query string, variables map[string]interface{}) (*graphQLResponse, *github.Response, error) {
+	body := struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{
+		Query:     query,
+		Variables: variables,
+	}
+
+	req, err := client.NewRequest(http.MethodPost, "../graphql", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result graphQLResponse
+	resp, err := client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+// graphQLQueryDepth returns the deepest nesting of selection sets in the query,
+// ignoring braces inside strings and comments.
+func graphQLQueryDepth(query string) int {
+	depth, maxDepth := 0, 0
+	inString, inComment := false, false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case inComment:
+			if c == '\n' {
+				inComment = false
+			}
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '#':
+			inComment = true
+		case c == '"':
+			inString = true
+		case c == '{':
+			depth++
+			if depth > maxDepth {
+				maxDepth = depth
+			}
+		case c == '}':
+			depth--
+		}
+	}
+	return maxDepth
+}
+
+// GraphQLQuery creates a tool to run an arbitrary query against the GitHub GraphQL API.
+func GraphQLQuery(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("graphql",
+			mcp.WithDescription(t("TOOL_GRAPHQL_DESCRIPTION", "Run a query or mutation against the GitHub GraphQL API, for data not covered by the other tools. Returns the raw data and errors of the response")),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description(fmt.Sprintf("GraphQL query or mutation (at most %d bytes and %d levels of nesting)", maxGraphQLQueryLength, maxGraphQLQueryDepth)),
+			),
+			mcp.WithObject("variables",
+				mcp.Description("Values of the variables used in the query"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := requiredParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			variables, err := OptionalParam[map[string]interface{}](request, "variables")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if len(query) > maxGraphQLQueryLength {
+				return mcp.NewToolResultError(fmt.Sprintf("query is %d bytes long, the limit is %d", len(query), maxGraphQLQueryLength)), nil
+			}
+			if depth := graphQLQueryDepth(query); depth > maxGraphQLQueryDepth {
+				return mcp.NewToolResultError(fmt.Sprintf("query is nested %d levels deep, the limit is %d", depth, maxGraphQLQueryDepth)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := queryGraphQL(ctx, client, query, variables)
+			if err != nil {
+				return nil, fmt.Errorf("failed to run graphql query: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			if len(result.Errors) > 0 && (len(result.Data) == 0 || string(result.Data) == "null") {
+				return mcp.NewToolResultError(string(r)), nil
+			}
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,236 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// postGraphQL is the endpoint of the GraphQL API for clients using the default base URL.
+var postGraphQL = mock.EndpointPattern{
+	Pattern: "/graphql",
+	Method:  http.MethodPost,
+}
+
+func Test_GraphQLQueryDepth(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected int
+	}{
+		{
+			name:     "flat query",
+			query:    `query { viewer { login } }`,
+			expected: 2,
+		},
+		{
+			name:     "nested connections",
+			query:    `query { repository(owner: "o", name: "r") { issues(first: 1) { nodes { title } } } }`,
+			expected: 4,
+		},
+		{
+			name:     "braces in strings and comments are ignored",
+			query:    "query {\n  # {{{{\n  search(query: \"{{\\\"{{\", type: ISSUE) { issueCount }\n}",
+			expected: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, graphQLQueryDepth(tc.query))
+		})
+	}
+}
+
+func Test_GraphQLQuery(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GraphQLQuery(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "graphql", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "variables")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
+
+	query := `query($owner: String!) { repositoryOwner(login: $owner) { login } }`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful query",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					expectRequestBody(t, map[string]interface{}{
+						"query":     query,
+						"variables": map[string]interface{}{"owner": "octocat"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]interface{}{
+							"data": map[string]interface{}{"repositoryOwner": map[string]interface{}{"login": "octocat"}},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":     query,
+				"variables": map[string]interface{}{"owner": "octocat"},
+			},
+			expectError:    false,
+			expectedResult: `{"data":{"repositoryOwner":{"login":"octocat"}}}`,
+		},
+		{
+			name: "partial data with errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockResponse(t, http.StatusOK, map[string]interface{}{
+						"data":   map[string]interface{}{"repositoryOwner": nil},
+						"errors": []map[string]interface{}{{"type": "NOT_FOUND", "message": "Could not resolve to a RepositoryOwner with the login of 'ghost'."}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": query,
+			},
+			expectError:    false,
+			expectedResult: `{"data":{"repositoryOwner":null},"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a RepositoryOwner with the login of 'ghost'."}]}`,
+		},
+		{
+			name: "query fails to parse",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockResponse(t, http.StatusOK, map[string]interface{}{
+						"errors": []map[string]interface{}{{"message": "Parse error on \"}\" (RCURLY) at [1, 10]"}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "query { }",
+			},
+			expectError:    false,
+			expectedErrMsg: "Parse error",
+		},
+		{
+			name:         "query too long",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query": "query { viewer { login } }" + strings.Repeat(" ", maxGraphQLQueryLength),
+			},
+			expectError:    false,
+			expectedErrMsg: "the limit is 10000",
+		},
+		{
+			name:         "query too deep",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query": "query " + strings.Repeat("{ a ", maxGraphQLQueryDepth+1) + strings.Repeat("}", maxGraphQLQueryDepth+1),
+			},
+			expectError:    false,
+			expectedErrMsg: "query is nested 11 levels deep, the limit is 10",
+		},
+		{
+			name: "unauthorized",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockResponse(t, http.StatusUnauthorized, `{"message": "Bad credentials"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": query,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to run graphql query",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GraphQLQuery(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
+
+func Test_QueryGraphQL_EnterpriseEndpoint(t *testing.T) {
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.EndpointPattern{Pattern: "/api/graphql", Method: http.MethodPost},
+			map[string]interface{}{"data": map[string]interface{}{"viewer": map[string]interface{}{"login": "octocat"}}},
+		),
+	)
+	client, err := github.NewClient(mockedClient).WithEnterpriseURLs("https://ghe.example.com/", "https://ghe.example.com/")
+	require.NoError(t, err)
+
+	result, resp, err := queryGraphQL(context.Background(), client, "query { viewer { login } }", nil)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, "/api/graphql", resp.Request.URL.Path)
+	var data struct {
+		Viewer struct {
+			Login string `json:"login"`
+		} `json:"viewer"`
+	}
+	require.NoError(t, json.Unmarshal(result.Data, &data))
+	assert.Equal(t, "octocat", data.Viewer.Login)
+}
+
+func Test_NewServer_GraphQLOptIn(t *testing.T) {
+	hasGraphQL := func(cfg ServerConfig) bool {
+		s := NewServer(stubGetClientFn(github.NewClient(nil)), "test", cfg, translations.NullTranslationHelper)
+		for _, tool := range listServerTools(t, s) {
+			if tool.Name == "graphql" {
+				return true
+			}
+		}
+		return false
+	}
+
+	assert.False(t, hasGraphQL(ServerConfig{}))
+	assert.True(t, hasGraphQL(ServerConfig{EnableGraphQL: true}))
+	// The tool can run mutations, so read-only servers leave it out
+	assert.False(t, hasGraphQL(ServerConfig{EnableGraphQL: true, ReadOnly: true}))
+}
@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Limits of grep_file: the largest file it searches, how many matching lines it returns, and
+// how many bytes of each line. Long lines are usually minified code that would flood the context.
+const (
+	maxGrepFileSize   = 1 << 20
+	maxGrepMatches    = 100
+	maxGrepLineLength = 500
+)
+
+// grepMatch is a line of a file that matches the pattern. Line numbers start at 1.
+type grepMatch struct {
+	Line int    `json:"line"`
+	Text string `json:"text"`
+}
+
+// grepResult lists the lines of a file that match a pattern. Truncated is set when more lines
+// match than are returned.
+type grepResult struct {
+	Path       string      `json:"path"`
+	SHA        string      `json:"sha"`
+	TotalLines int         `json:"total_lines"`
+	Matches    []grepMatch `json:"matches"`
+	Truncated  bool        `json:"truncated,omitempty"`
+}
+
+// compileGrepPattern compiles the regular expression pattern, case insensitively if ignoreCase is set.
+func compileGrepPattern(pattern string, ignoreCase bool) (*regexp.Regexp, error) {
+	if ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	return re, nil
+}
+
+// grepLines returns the lines of content matching re, at most maxGrepMatches of them, and the
+// number of lines of content.
+func grepLines(content string, re *regexp.Regexp) ([]grepMatch, int, bool) {
+	lines := strings.Split(content, "\n")
+	// A trailing newline terminates the last line rather than starting another one.
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	matches := []grepMatch{}
+	for i, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+		if !re.MatchString(line) {
+			continue
+		}
+		if len(matches) == maxGrepMatches {
+			return matches, len(lines), true
+		}
+		if len(line) > maxGrepLineLength {
+			line = strings.ToValidUTF8(line[:maxGrepLineLength], "") + "..."
+		}
+		matches = append(matches, grepMatch{Line: i + 1, Text: line})
+	}
+	return matches, len(lines), false
+}
+
+// GrepFile creates a tool to find the lines of a file of a repository that match a regular expression.
+func GrepFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("grep_file",
+			mcp.WithDescription(t("TOOL_GREP_FILE_DESCRIPTION", "Find the lines of a file in a GitHub repository that match a regular expression, with their line numbers, without reading the whole file. Returns up to 100 matching lines")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file to search"),
+			),
+			mcp.WithString("pattern",
+				mcp.Required(),
+				mcp.Description("Regular expression in Go RE2 syntax, matched against each line, e.g. 'func \\w+Handler'"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to search the file at (default: the default branch)"),
+			),
+			mcp.WithBoolean("ignore_case",
+				mcp.Description("Match the pattern case insensitively"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pattern, err := requiredParam[string](request, "pattern")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ignoreCase, err := OptionalParam[bool](request, "ignore_case")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Validate the pattern before fetching anything
+			re, err := compileGrepPattern(pattern, ignoreCase)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			entry := fetchFileContents(ctx, client, owner, repo, path, ref)
+			if entry.Error != "" {
+				return mcp.NewToolResultError(fmt.Sprintf("cannot search %s: %s", path, entry.Error)), nil
+			}
+			if entry.Size > maxGrepFileSize {
+				return mcp.NewToolResultError(fmt.Sprintf("cannot search %s: the file is %d bytes, more than the limit of %d", path, entry.Size, maxGrepFileSize)), nil
+			}
+
+			matches, totalLines, truncated := grepLines(entry.Content, re)
+			r, err := json.Marshal(grepResult{
+				Path:       path,
+				SHA:        entry.SHA,
+				TotalLines: totalLines,
+				Matches:    matches,
+				Truncated:  truncated,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,289 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_compileGrepPattern(t *testing.T) {
+	tests := []struct {
+		name        string
+		pattern     string
+		ignoreCase  bool
+		line        string
+		expectMatch bool
+		expectedErr string
+	}{
+		{
+			name:        "regular expression",
+			pattern:     `func \w+Handler\(`,
+			line:        "func RepositoryResourceContentsHandler(getClient GetClientFn) {",
+			expectMatch: true,
+		},
+		{
+			name:        "case sensitive by default",
+			pattern:     "todo",
+			line:        "// TODO: remove",
+			expectMatch: false,
+		},
+		{
+			name:        "ignore case",
+			pattern:     "todo",
+			ignoreCase:  true,
+			line:        "// TODO: remove",
+			expectMatch: true,
+		},
+		{
+			name:        "unbalanced parenthesis",
+			pattern:     "func (",
+			expectedErr: "invalid pattern: error parsing regexp: missing closing ): `func (`",
+		},
+		{
+			name:        "unsupported backreference",
+			pattern:     `(a)\1`,
+			expectedErr: "invalid pattern: error parsing regexp: invalid escape sequence: `\\1`",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			re, err := compileGrepPattern(tc.pattern, tc.ignoreCase)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectMatch, re.MatchString(tc.line))
+		})
+	}
+}
+
+func Test_grepLines(t *testing.T) {
+	re, err := compileGrepPattern("match", false)
+	require.NoError(t, err)
+
+	t.Run("line numbers and CRLF line endings", func(t *testing.T) {
+		matches, totalLines, truncated := grepLines("first match\r\nsecond\r\nthird match\r\n", re)
+		assert.Equal(t, []grepMatch{{Line: 1, Text: "first match"}, {Line: 3, Text: "third match"}}, matches)
+		assert.Equal(t, 3, totalLines)
+		assert.False(t, truncated)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		matches, totalLines, truncated := grepLines("nothing here", re)
+		assert.Empty(t, matches)
+		assert.Equal(t, 1, totalLines)
+		assert.False(t, truncated)
+	})
+
+	t.Run("too many matches", func(t *testing.T) {
+		content := strings.Repeat("match\n", maxGrepMatches+1)
+		matches, totalLines, truncated := grepLines(content, re)
+		assert.Len(t, matches, maxGrepMatches)
+		assert.Equal(t, maxGrepMatches+1, totalLines)
+		assert.True(t, truncated)
+	})
+
+	t.Run("long line", func(t *testing.T) {
+		matches, _, _ := grepLines("match"+strings.Repeat("x", maxGrepLineLength), re)
+		require.Len(t, matches, 1)
+		assert.Equal(t, "match"+strings.Repeat("x", maxGrepLineLength-5)+"...", matches[0].Text)
+	})
+}
+
+func Test_GrepFile(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GrepFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "grep_file", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "pattern")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "ignore_case")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path", "pattern"})
+
+	source := "package main\n\nimport \"fmt\"\n\nfunc main() {\n\t// TODO: greet properly\n\tfmt.Println(\"hi\")\n}\n"
+	mockFile := &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Name:     github.Ptr("main.go"),
+		Path:     github.Ptr("main.go"),
+		SHA:      github.Ptr("abc123"),
+		Size:     github.Ptr(len(source)),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(source))),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult grepResult
+		expectedErrMsg string
+	}{
+		{
+			name: "matching lines at a ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{"ref": "v1.0.0"}).andThen(
+						mockResponse(t, http.StatusOK, mockFile),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "main.go",
+				"pattern": `fmt|todo`,
+				"ref":     "v1.0.0",
+				// The case of TODO differs from the pattern
+				"ignore_case": true,
+			},
+			expectedResult: grepResult{
+				Path:       "main.go",
+				SHA:        "abc123",
+				TotalLines: 8,
+				Matches: []grepMatch{
+					{Line: 3, Text: `import "fmt"`},
+					{Line: 6, Text: "\t// TODO: greet properly"},
+					{Line: 7, Text: "\tfmt.Println(\"hi\")"},
+				},
+			},
+		},
+		{
+			name: "no matching lines",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockFile,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "main.go",
+				"pattern": "goroutine",
+			},
+			expectedResult: grepResult{
+				Path:       "main.go",
+				SHA:        "abc123",
+				TotalLines: 8,
+				Matches:    []grepMatch{},
+			},
+		},
+		{
+			name: "invalid pattern is rejected before fetching the file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						t.Error("the file must not be fetched")
+						w.WriteHeader(http.StatusInternalServerError)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "main.go",
+				"pattern": "[a-",
+			},
+			expectedErrMsg: "invalid pattern: error parsing regexp: missing closing ]: `[a-`",
+		},
+		{
+			name: "file not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "missing.go",
+				"pattern": "main",
+			},
+			expectedErrMsg: "cannot search missing.go: not found",
+		},
+		{
+			name: "directory",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					[]*github.RepositoryContent{mockFile},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "cmd",
+				"pattern": "main",
+			},
+			expectedErrMsg: "cannot search cmd: is a directory",
+		},
+		{
+			name: "file too large",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					&github.RepositoryContent{
+						Type:     github.Ptr("file"),
+						Path:     github.Ptr("data.json"),
+						SHA:      github.Ptr("def456"),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr(base64.StdEncoding.EncodeToString(make([]byte, maxGrepFileSize+1))),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "data.json",
+				"pattern": "id",
+			},
+			expectedErrMsg: fmt.Sprintf("cannot search data.json: the file is %d bytes, more than the limit of %d", maxGrepFileSize+1, maxGrepFileSize),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GrepFile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned grepResult
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// healthStatus is the result of the health_check tool.
+type healthStatus struct {
+	APIURL string `json:"api_url"`
+	// Scopes are the OAuth scopes of the token. Only classic personal access tokens and OAuth
+	// app tokens report them, so ScopesReported is false for fine-grained tokens and GitHub
+	// App tokens, whose permissions are not listed in the response.
+	Scopes         []string     `json:"scopes"`
+	ScopesReported bool         `json:"scopes_reported"`
+	RateLimit      *github.Rate `json:"rate_limit,omitempty"`
+}
+
+// parseOAuthScopes reads the scopes from the X-OAuth-Scopes header of a response, e.g.
+// "repo, read:org". ok is false when the header is absent, which is not the same as a
+// token without any scopes, for which the header is present but empty.
+func parseOAuthScopes(header http.Header) (scopes []string, ok bool) {
+	values, ok := header[http.CanonicalHeaderKey("X-OAuth-Scopes")]
+	if !ok {
+		return []string{}, false
+	}
+	scopes = []string{}
+	for _, value := range values {
+		for _, scope := range strings.Split(value, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes, true
+}
+
+// HealthCheck creates a tool to verify the GitHub token and the connectivity to the GitHub API.
+func HealthCheck(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("health_check",
+			mcp.WithDescription(t("TOOL_HEALTH_CHECK_DESCRIPTION", "Check that the server can reach the GitHub API with its token. Returns the API URL, the OAuth scopes of the token (only reported for classic tokens) and the remaining rate limit. Use this to find out which scopes a failing operation may be missing")),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Reading the rate limit does not count against it, and like any authenticated
+			// request its response carries the scopes of the token.
+			limits, resp, err := client.RateLimit.Get(ctx)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+					return mcp.NewToolResultError(fmt.Sprintf("GitHub API at %s rejected the token, it may be invalid or expired: %s", client.BaseURL, err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to reach GitHub API at %s: %w", client.BaseURL, err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to check GitHub API health: %s", string(body))), nil
+			}
+
+			status := healthStatus{
+				APIURL:    client.BaseURL.String(),
+				RateLimit: limits.GetCore(),
+			}
+			status.Scopes, status.ScopesReported = parseOAuthScopes(resp.Header)
+
+			r, err := json.Marshal(status)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,164 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseOAuthScopes(t *testing.T) {
+	tests := []struct {
+		name           string
+		header         http.Header
+		expectedScopes []string
+		expectedOK     bool
+	}{
+		{
+			name:           "several scopes",
+			header:         http.Header{"X-Oauth-Scopes": []string{"repo, read:org,  gist"}},
+			expectedScopes: []string{"repo", "read:org", "gist"},
+			expectedOK:     true,
+		},
+		{
+			name:           "token without scopes",
+			header:         http.Header{"X-Oauth-Scopes": []string{""}},
+			expectedScopes: []string{},
+			expectedOK:     true,
+		},
+		{
+			name:           "header absent",
+			header:         http.Header{},
+			expectedScopes: []string{},
+			expectedOK:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scopes, ok := parseOAuthScopes(tc.header)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedScopes, scopes)
+		})
+	}
+}
+
+func Test_HealthCheck(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := HealthCheck(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "health_check", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Empty(t, tool.InputSchema.Required)
+
+	rateLimits := map[string]interface{}{
+		"resources": map[string]interface{}{
+			"core": map[string]interface{}{"limit": 5000, "remaining": 4990, "used": 10, "reset": 1700000000},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedStatus healthStatus
+		expectedErrMsg string
+	}{
+		{
+			name: "classic token",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetRateLimit,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("X-OAuth-Scopes", "repo, read:org")
+						mockResponse(t, http.StatusOK, rateLimits)(w, r)
+					}),
+				),
+			),
+			expectedStatus: healthStatus{
+				APIURL:         "https://api.github.com/",
+				Scopes:         []string{"repo", "read:org"},
+				ScopesReported: true,
+				RateLimit:      &github.Rate{Limit: 5000, Remaining: 4990, Used: 10},
+			},
+		},
+		{
+			name: "fine-grained token",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetRateLimit,
+					rateLimits,
+				),
+			),
+			expectedStatus: healthStatus{
+				APIURL:    "https://api.github.com/",
+				Scopes:    []string{},
+				RateLimit: &github.Rate{Limit: 5000, Remaining: 4990, Used: 10},
+			},
+		},
+		{
+			name: "bad credentials",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetRateLimit,
+					mockResponse(t, http.StatusUnauthorized, `{"message": "Bad credentials"}`),
+				),
+			),
+			expectError:    false,
+			expectedErrMsg: "GitHub API at https://api.github.com/ rejected the token, it may be invalid or expired",
+		},
+		{
+			name: "server error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetRateLimit,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to reach GitHub API at https://api.github.com/",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := HealthCheck(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returnedStatus healthStatus
+			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
+			require.NoError(t, err)
+			require.NotNil(t, returnedStatus.RateLimit)
+			assert.Equal(t, int64(1700000000), returnedStatus.RateLimit.Reset.Unix())
+			returnedStatus.RateLimit.Reset = github.Timestamp{}
+			assert.Equal(t, tc.expectedStatus, returnedStatus)
+		})
+	}
+}
@@ -1,11 +1,13 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -94,6 +96,22 @@ func getTextResult(t *testing.T, result *mcp.CallToolResult) mcp.TextContent {
 	return textContent
 }
 
+// listServerTools is a helper function that returns the tools registered with the server,
+// as reported to a client calling tools/list.
+func listServerTools(t *testing.T, s *server.MCPServer) []mcp.Tool {
+	t.Helper()
+	response := s.HandleMessage(context.Background(), []byte(`{"jsonrpc": "2.0", "id": 1, "method": "tools/list"}`))
+
+	b, err := json.Marshal(response)
+	require.NoError(t, err)
+
+	var listResponse struct {
+		Result mcp.ListToolsResult `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(b, &listResponse))
+	return listResponse.Result.Tools
+}
+
 func TestOptionalParamOK(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// idempotencyTTL is how long the result of a create call is remembered for its idempotency key.
+// It is long enough to cover an agent retrying a call, not to deduplicate deliberate repeats.
+const idempotencyTTL = 10 * time.Minute
+
+// WithIdempotencyKey returns a ToolOption that adds the "idempotency_key" parameter to a tool
+// that creates something. A repeated call with the same key returns what the first call created.
+func WithIdempotencyKey() mcp.ToolOption {
+	return mcp.WithString("idempotency_key",
+		mcp.Description("Unique key of this creation. If a call with the same key and repository succeeded in the last 10 minutes, its result is returned instead of creating a duplicate"),
+	)
+}
+
+// idempotencyCache maps the idempotency keys of create calls to their results for a limited time.
+// It is best effort: the results only live in the memory of this process, and two calls with the
+// same key that run at the same time can both create an object. Each server has its own cache;
+// like tokenScopes, it assumes the server's token doesn't change, so keys are not per identity.
+type idempotencyCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	results map[idempotencyCacheKey]idempotentResult
+}
+
+type idempotencyCacheKey struct {
+	tool  string
+	owner string
+	repo  string
+	key   string
+}
+
+type idempotentResult struct {
+	text      string
+	expiresAt time.Time
+}
+
+func newIdempotencyCache(ttl time.Duration) *idempotencyCache {
+	return &idempotencyCache{
+		ttl:     ttl,
+		now:     time.Now,
+		results: make(map[idempotencyCacheKey]idempotentResult),
+	}
+}
+
+// lookup returns the result of the earlier call of tool on owner/repo with the key, if there was
+// one that has not expired. Calls without a key never match.
+func (c *idempotencyCache) lookup(tool, owner, repo, key string) (string, bool) {
+	if key == "" {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result, ok := c.results[idempotencyCacheKey{tool, owner, repo, key}]
+	if !ok || !c.now().Before(result.expiresAt) {
+		return "", false
+	}
+	return result.text, true
+}
+
+// store records the result of a successful call of tool on owner/repo with the key, and forgets
+// the results that have expired. Calls without a key are not recorded.
+func (c *idempotencyCache) store(tool, owner, repo, key, text string) {
+	if key == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for k, result := range c.results {
+		if !now.Before(result.expiresAt) {
+			delete(c.results, k)
+		}
+	}
+	c.results[idempotencyCacheKey{tool, owner, repo, key}] = idempotentResult{
+		text:      text,
+		expiresAt: now.Add(c.ttl),
+	}
+}
@@ -0,0 +1,38 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IdempotencyCache(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	cache := newIdempotencyCache(10 * time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.store("create_issue", "owner", "repo", "key", `{"number":1}`)
+
+	result, ok := cache.lookup("create_issue", "owner", "repo", "key")
+	assert.True(t, ok)
+	assert.Equal(t, `{"number":1}`, result)
+
+	// Keys are scoped to the tool and the repository
+	_, ok = cache.lookup("create_pull_request", "owner", "repo", "key")
+	assert.False(t, ok)
+	_, ok = cache.lookup("create_issue", "owner", "other-repo", "key")
+	assert.False(t, ok)
+
+	// Calls without a key are never deduplicated
+	cache.store("create_issue", "owner", "repo", "", `{"number":2}`)
+	_, ok = cache.lookup("create_issue", "owner", "repo", "")
+	assert.False(t, ok)
+
+	// Results are forgotten once they expire
+	now = now.Add(10 * time.Minute)
+	_, ok = cache.lookup("create_issue", "owner", "repo", "key")
+	assert.False(t, ok)
+	cache.store("create_issue", "owner", "repo", "other-key", `{"number":3}`)
+	assert.Len(t, cache.results, 1)
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// toolSchema describes the parameters of a registered tool.
+type toolSchema struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	InputSchema any    `json:"input_schema"`
+}
+
+// ExportToolSchemas creates a tool that returns the JSON schema of the parameters of every
+// tool returned by listTools, so that clients can generate typed stubs for them.
+func ExportToolSchemas(listTools func() []mcp.Tool, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("export_tool_schemas",
+			mcp.WithDescription(t("TOOL_EXPORT_TOOL_SCHEMAS_DESCRIPTION", "Export the name, description and JSON input schema of every tool registered with this server")),
+		),
+		func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			tools := listTools()
+			schemas := make([]toolSchema, 0, len(tools))
+			for _, tool := range tools {
+				var inputSchema any = tool.InputSchema
+				if tool.RawInputSchema != nil {
+					inputSchema = tool.RawInputSchema
+				}
+				schemas = append(schemas, toolSchema{
+					Name:        tool.Name,
+					Description: tool.Description,
+					InputSchema: inputSchema,
+				})
+			}
+
+			r, err := json.Marshal(schemas)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal tool schemas: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// availableTool describes a tool enabled on this server.
+type availableTool struct {
+	Name                    string `json:"name"`
+	Description             string `json:"description"`
+	ReadOnly                bool   `json:"read_only"`
+	RequiresCapabilityToken bool   `json:"requires_capability_token,omitempty"`
+	// RequiredScope is the OAuth scope a classic token needs for the tool, if any.
+	RequiredScope string `json:"required_scope,omitempty"`
+}
+
+// ListAvailableTools creates a tool that lists the tools returned by listTools, so that an
+// agent can discover what a restricted deployment lets it do without trying each tool.
+func ListAvailableTools(listTools func() []availableTool, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_available_tools",
+			mcp.WithDescription(t("TOOL_LIST_AVAILABLE_TOOLS_DESCRIPTION", "List the tools enabled on this server with their descriptions, and whether each only reads or also modifies GitHub state. Write tools that need a capability token on a read-only server are marked as such, and tools needing an OAuth scope name it")),
+		),
+		func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			r, err := json.Marshal(listTools())
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal available tools: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
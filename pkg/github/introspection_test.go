@@ -0,0 +1,167 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExportToolSchemas(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := ExportToolSchemas(func() []mcp.Tool { return nil }, translations.NullTranslationHelper)
+
+	assert.Equal(t, "export_tool_schemas", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Empty(t, tool.InputSchema.Required)
+
+	getIssue, _ := GetIssue(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	getMe, _ := GetMe(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	rawTool := mcp.NewToolWithRawSchema("raw_tool", "A tool with a raw schema", json.RawMessage(`{"type":"object","properties":{"q":{"type":"string"}}}`))
+
+	_, handler := ExportToolSchemas(func() []mcp.Tool {
+		return []mcp.Tool{getIssue, getMe, rawTool}
+	}, translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var schemas []struct {
+		Name        string         `json:"name"`
+		Description string         `json:"description"`
+		InputSchema map[string]any `json:"input_schema"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &schemas))
+	require.Len(t, schemas, 3)
+
+	assert.Equal(t, "get_issue", schemas[0].Name)
+	assert.Equal(t, getIssue.Description, schemas[0].Description)
+	assert.Equal(t, "object", schemas[0].InputSchema["type"])
+	assert.Contains(t, schemas[0].InputSchema["properties"], "issue_number")
+	assert.ElementsMatch(t, []any{"owner", "repo", "issue_number"}, schemas[0].InputSchema["required"])
+
+	assert.Equal(t, "get_me", schemas[1].Name)
+	assert.Contains(t, schemas[1].InputSchema["properties"], "reason")
+
+	assert.Equal(t, "raw_tool", schemas[2].Name)
+	assert.Contains(t, schemas[2].InputSchema["properties"], "q")
+}
+
+func Test_NewServer_ExportToolSchemas(t *testing.T) {
+	s := NewServer(stubGetClientFn(github.NewClient(nil)), "test", ServerConfig{ReadOnly: true}, translations.NullTranslationHelper)
+
+	registered := listServerTools(t, s)
+
+	response := s.HandleMessage(context.Background(), []byte(`{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "export_tool_schemas"}}`))
+	b, err := json.Marshal(response)
+	require.NoError(t, err)
+
+	var callResponse struct {
+		Result struct {
+			Content []mcp.TextContent `json:"content"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(b, &callResponse))
+	require.Len(t, callResponse.Result.Content, 1)
+
+	var schemas []toolSchema
+	require.NoError(t, json.Unmarshal([]byte(callResponse.Result.Content[0].Text), &schemas))
+
+	// The export reflects exactly the tools the server registered
+	exported := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		exported = append(exported, schema.Name)
+	}
+	names := make([]string, 0, len(registered))
+	for _, tool := range registered {
+		names = append(names, tool.Name)
+	}
+	assert.ElementsMatch(t, names, exported)
+	assert.NotContains(t, exported, "create_issue")
+}
+
+func Test_ListAvailableTools(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := ListAvailableTools(func() []availableTool { return nil }, translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_available_tools", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Empty(t, tool.InputSchema.Required)
+
+	available := []availableTool{
+		{Name: "get_issue", Description: "Get an issue", ReadOnly: true},
+		{Name: "create_issue", Description: "Create an issue", RequiresCapabilityToken: true},
+	}
+	_, handler := ListAvailableTools(func() []availableTool { return available }, translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	assert.JSONEq(t, `[
+		{"name": "get_issue", "description": "Get an issue", "read_only": true},
+		{"name": "create_issue", "description": "Create an issue", "read_only": false, "requires_capability_token": true}
+	]`, textContent.Text)
+}
+
+func Test_NewServer_ListAvailableTools(t *testing.T) {
+	callListAvailableTools := func(t *testing.T, cfg ServerConfig) map[string]availableTool {
+		s := NewServer(stubGetClientFn(github.NewClient(nil)), "test", cfg, translations.NullTranslationHelper)
+
+		response := s.HandleMessage(context.Background(), []byte(`{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "list_available_tools"}}`))
+		b, err := json.Marshal(response)
+		require.NoError(t, err)
+
+		var callResponse struct {
+			Result struct {
+				Content []mcp.TextContent `json:"content"`
+			} `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(b, &callResponse))
+		require.Len(t, callResponse.Result.Content, 1)
+
+		var tools []availableTool
+		require.NoError(t, json.Unmarshal([]byte(callResponse.Result.Content[0].Text), &tools))
+
+		// The list reflects exactly the tools the server registered
+		byName := make(map[string]availableTool, len(tools))
+		for _, tool := range tools {
+			byName[tool.Name] = tool
+		}
+		registered := listServerTools(t, s)
+		require.Len(t, byName, len(registered))
+		for _, tool := range registered {
+			require.Contains(t, byName, tool.Name)
+			assert.Equal(t, tool.Description, byName[tool.Name].Description)
+		}
+		return byName
+	}
+
+	t.Run("read-write", func(t *testing.T) {
+		tools := callListAvailableTools(t, ServerConfig{})
+		assert.True(t, tools["get_issue"].ReadOnly)
+		assert.False(t, tools["create_issue"].ReadOnly)
+		assert.False(t, tools["create_issue"].RequiresCapabilityToken)
+		assert.True(t, tools["list_available_tools"].ReadOnly)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		tools := callListAvailableTools(t, ServerConfig{ReadOnly: true, ReadOnlyExceptions: []string{"add_issue_comment"}})
+		assert.Contains(t, tools, "get_issue")
+		assert.NotContains(t, tools, "create_issue")
+		assert.False(t, tools["add_issue_comment"].ReadOnly)
+		assert.False(t, tools["add_issue_comment"].RequiresCapabilityToken)
+	})
+
+	t.Run("read-only with capability secret", func(t *testing.T) {
+		tools := callListAvailableTools(t, ServerConfig{ReadOnly: true, CapabilitySecret: []byte("s3cret")})
+		assert.False(t, tools["create_issue"].ReadOnly)
+		assert.True(t, tools["create_issue"].RequiresCapabilityToken)
+	})
+}
@@ -0,0 +1,270 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// dependencyKeywordPattern matches an issue an issue body declares a dependency on, e.g.
+// "Depends on #12", "Blocked by owner/repo#12" or "Blocks https://github.com/owner/repo/issues/12".
+var dependencyKeywordPattern = regexp.MustCompile(`(?i)\b(depends\s+on|blocked\s+by|blocks):?\s+(?:https?://[^/\s]+/([\w.-]+)/([\w.-]+)/issues/(\d+)|(?:([\w.-]+)/([\w.-]+))?#(\d+))`)
+
+// parseDependencyReferences returns the issues a body says the issue is blocked by and the
+// issues it says the issue blocks, in order of appearance and without duplicates. References
+// without a repository are in owner/repo.
+func parseDependencyReferences(body, owner, repo string) (blockedBy, blocks []issueRef) {
+	seen := map[issueRef]bool{}
+	for _, m := range dependencyKeywordPattern.FindAllStringSubmatch(body, -1) {
+		ref := issueRef{owner: owner, repo: repo}
+		number := m[7]
+		switch {
+		case m[4] != "":
+			ref.owner, ref.repo, number = m[2], m[3], m[4]
+		case m[5] != "":
+			ref.owner, ref.repo = m[5], m[6]
+		}
+		ref.number, _ = strconv.Atoi(number)
+		if ref.number == 0 || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		if strings.EqualFold(m[1], "blocks") {
+			blocks = append(blocks, ref)
+		} else {
+			blockedBy = append(blockedBy, ref)
+		}
+	}
+	return blockedBy, blocks
+}
+
+// sameIssue reports whether a and b are the same issue. Owner and repository names are case
+// insensitive.
+func sameIssue(a, b issueRef) bool {
+	return a.number == b.number && strings.EqualFold(a.owner, b.owner) && strings.EqualFold(a.repo, b.repo)
+}
+
+// dependencyIssue is an issue on one side of a dependency, with its current state.
+type dependencyIssue struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+	Title  string `json:"title,omitempty"`
+	State  string `json:"state"`
+	URL    string `json:"url,omitempty"`
+}
+
+func newDependencyIssue(issue *github.Issue) dependencyIssue {
+	// repository_url has the form https://HOST/repos/OWNER/REPO
+	owner, repo := path.Base(path.Dir(issue.GetRepositoryURL())), path.Base(issue.GetRepositoryURL())
+	if r := issue.GetRepository(); r != nil {
+		owner, repo = r.GetOwner().GetLogin(), r.GetName()
+	}
+	return dependencyIssue{
+		Owner:  owner,
+		Repo:   repo,
+		Number: issue.GetNumber(),
+		Title:  issue.GetTitle(),
+		State:  issue.GetState(),
+		URL:    issue.GetHTMLURL(),
+	}
+}
+
+// issueDependencies lists the issues an issue is blocked by and the issues it blocks. Source is
+// "dependencies" when they come from the issue dependencies of GitHub, and "references" when
+// GitHub doesn't support them and they were inferred from keywords such as "Depends on #12" in
+// the body of the issue and of the issues referencing it.
+type issueDependencies struct {
+	Issue     int               `json:"issue"`
+	BlockedBy []dependencyIssue `json:"blocked_by"`
+	Blocks    []dependencyIssue `json:"blocks"`
+	Source    string            `json:"source"`
+}
+
+// listDependencies gets the issues an issue is blocked by or blocking, depending on relation.
+func listDependencies(ctx context.Context, client *github.Client, owner, repo string, number int, relation string) ([]dependencyIssue, *github.Response, error) {
+	// go-github has no method for this endpoint
+	u := fmt.Sprintf("repos/%s/%s/issues/%d/dependencies/%s?per_page=100", url.PathEscape(owner), url.PathEscape(repo), number, relation)
+	req, err := client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var issues []*github.Issue
+	resp, err := client.Do(ctx, req, &issues)
+	if err != nil {
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+
+	result := make([]dependencyIssue, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, newDependencyIssue(issue))
+	}
+	return result, resp, nil
+}
+
+// referencedDependencies infers the dependencies of issue from the keywords in its body and in
+// the bodies of the issues cross-referencing it.
+func referencedDependencies(ctx context.Context, client *github.Client, owner, repo string, issue *github.Issue) (*issueDependencies, error) {
+	self := issueRef{owner: owner, repo: repo, number: issue.GetNumber()}
+	result := &issueDependencies{
+		Issue:     issue.GetNumber(),
+		BlockedBy: []dependencyIssue{},
+		Blocks:    []dependencyIssue{},
+		Source:    "references",
+	}
+	seen := map[issueRef]bool{}
+	add := func(list *[]dependencyIssue, d dependencyIssue) {
+		ref := issueRef{owner: strings.ToLower(d.Owner), repo: strings.ToLower(d.Repo), number: d.Number}
+		if seen[ref] {
+			return
+		}
+		seen[ref] = true
+		*list = append(*list, d)
+	}
+
+	blockedBy, blocks := parseDependencyReferences(issue.GetBody(), owner, repo)
+	for _, refs := range []struct {
+		refs []issueRef
+		list *[]dependencyIssue
+	}{{blockedBy, &result.BlockedBy}, {blocks, &result.Blocks}} {
+		for _, ref := range refs.refs {
+			if sameIssue(ref, self) {
+				continue
+			}
+			referenced, resp, err := client.Issues.Get(ctx, ref.owner, ref.repo, ref.number)
+			if err != nil {
+				// A body can reference issues that do not exist or that the caller cannot see
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					add(refs.list, dependencyIssue{Owner: ref.owner, Repo: ref.repo, Number: ref.number, State: "not_found"})
+					continue
+				}
+				return nil, fmt.Errorf("failed to get issue %s: %w", ref, err)
+			}
+			_ = resp.Body.Close()
+			add(refs.list, newDependencyIssue(referenced))
+		}
+	}
+
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		events, resp, err := client.Issues.ListIssueTimeline(ctx, owner, repo, self.number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issue timeline: %w", err)
+		}
+		_ = resp.Body.Close()
+
+		for _, event := range events {
+			if event.GetEvent() != "cross-referenced" {
+				continue
+			}
+			source := event.GetSource().GetIssue()
+			if source == nil || source.IsPullRequest() {
+				continue
+			}
+			// An issue that depends on this one is blocked by it, and the other way around
+			d := newDependencyIssue(source)
+			sourceBlockedBy, sourceBlocks := parseDependencyReferences(source.GetBody(), d.Owner, d.Repo)
+			for _, ref := range sourceBlockedBy {
+				if sameIssue(ref, self) {
+					add(&result.Blocks, d)
+				}
+			}
+			for _, ref := range sourceBlocks {
+				if sameIssue(ref, self) {
+					add(&result.BlockedBy, d)
+				}
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return result, nil
+}
+
+// GetIssueDependencies creates a tool to get the issues an issue is blocked by and the issues it blocks.
+func GetIssueDependencies(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_issue_dependencies",
+			mcp.WithDescription(t("TOOL_GET_ISSUE_DEPENDENCIES_DESCRIPTION", "Get the issues a GitHub issue is blocked by and the issues it blocks, with their current state. Where GitHub doesn't support issue dependencies, they are inferred from keywords such as 'Depends on #12', 'Blocked by #12' or 'Blocks #12' in the issue and the issues referencing it")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var result *issueDependencies
+			blockedBy, resp, err := listDependencies(ctx, client, owner, repo, issueNumber, "blocked_by")
+			switch {
+			case err == nil:
+				blocks, _, err := listDependencies(ctx, client, owner, repo, issueNumber, "blocking")
+				if err != nil {
+					return nil, fmt.Errorf("failed to list issue dependencies: %w", err)
+				}
+				result = &issueDependencies{Issue: issueNumber, BlockedBy: blockedBy, Blocks: blocks, Source: "dependencies"}
+			case resp != nil && resp.StatusCode == http.StatusNotFound:
+				// Either the issue doesn't exist, or GitHub doesn't support issue dependencies
+				issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return mcp.NewToolResultError(fmt.Sprintf("issue #%d not found in %s/%s", issueNumber, owner, repo)), nil
+					}
+					return nil, fmt.Errorf("failed to get issue: %w", err)
+				}
+				_ = resp.Body.Close()
+				result, err = referencedDependencies(ctx, client, owner, repo, issue)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, fmt.Errorf("failed to list issue dependencies: %w", err)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
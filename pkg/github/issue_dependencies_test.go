@@ -0,0 +1,267 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// go-github-mock has no patterns for the issue dependency endpoints
+var (
+	getIssueBlockedBy = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocked_by",
+		Method:  "GET",
+	}
+	getIssueBlocking = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocking",
+		Method:  "GET",
+	}
+)
+
+func Test_ParseDependencyReferences(t *testing.T) {
+	tests := []struct {
+		name              string
+		body              string
+		expectedBlockedBy []issueRef
+		expectedBlocks    []issueRef
+	}{
+		{
+			name: "no references",
+			body: "Related to #12, see also #13",
+		},
+		{
+			name: "keywords and forms",
+			body: "Depends on #1 and blocked by: octo/other#2.\nBlocks https://github.com/octo/third/issues/3, depends on #1 again.",
+			expectedBlockedBy: []issueRef{
+				{owner: "owner", repo: "repo", number: 1},
+				{owner: "octo", repo: "other", number: 2},
+			},
+			expectedBlocks: []issueRef{
+				{owner: "octo", repo: "third", number: 3},
+			},
+		},
+		{
+			name: "case insensitive",
+			body: "DEPENDS  ON #7",
+			expectedBlockedBy: []issueRef{
+				{owner: "owner", repo: "repo", number: 7},
+			},
+		},
+		{
+			name: "keyword must be a whole word",
+			body: "unblocks #8",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			blockedBy, blocks := parseDependencyReferences(tc.body, "owner", "repo")
+			assert.Equal(t, tc.expectedBlockedBy, blockedBy)
+			assert.Equal(t, tc.expectedBlocks, blocks)
+		})
+	}
+}
+
+func Test_GetIssueDependencies(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetIssueDependencies(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_issue_dependencies", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	issue := func(owner, repo string, number int, title, state, body string) *github.Issue {
+		return &github.Issue{
+			Number:        github.Ptr(number),
+			Title:         github.Ptr(title),
+			State:         github.Ptr(state),
+			Body:          github.Ptr(body),
+			RepositoryURL: github.Ptr("https://api.github.com/repos/" + owner + "/" + repo),
+			HTMLURL:       github.Ptr(fmt.Sprintf("https://github.com/%s/%s/issues/%d", owner, repo, number)),
+		}
+	}
+	notFound := mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})
+
+	// Issues referenced by the body of #42 in the tests of the fallback
+	mockIssues := map[string]*github.Issue{
+		"/repos/owner/repo/issues/42": issue("owner", "repo", 42, "Release", "open", "Depends on #10.\nDepends on octo/lib#3.\nBlocks #50.\nDepends on #404."),
+		"/repos/owner/repo/issues/10": issue("owner", "repo", 10, "Migration", "closed", ""),
+		"/repos/octo/lib/issues/3":    issue("octo", "lib", 3, "Upstream fix", "open", ""),
+		"/repos/owner/repo/issues/50": issue("owner", "repo", 50, "Announcement", "open", ""),
+	}
+	getIssueHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issue, ok := mockIssues[r.URL.Path]
+		if !ok {
+			notFound(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, issue)(w, r)
+	})
+	mockTimeline := []*github.Timeline{
+		{Event: github.Ptr("labeled")},
+		{
+			// Blocked by #42, so #42 blocks it
+			Event:  github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: issue("owner", "repo", 60, "Docs", "open", "Blocked by owner/repo#42")},
+		},
+		{
+			// Blocks #42, so #42 is blocked by it
+			Event:  github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: issue("owner", "other", 5, "Infra", "open", "Blocks https://github.com/owner/repo/issues/42")},
+		},
+		{
+			// Mentions #42 without a dependency
+			Event:  github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: issue("owner", "repo", 61, "Notes", "open", "See #42")},
+		},
+		{
+			// Already referenced by the body of #42
+			Event:  github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: issue("owner", "repo", 50, "Announcement", "open", "Depends on #42")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult issueDependencies
+		expectedErrMsg string
+	}{
+		{
+			name: "issue dependencies",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					getIssueBlockedBy,
+					[]*github.Issue{issue("owner", "repo", 10, "Migration", "closed", "")},
+				),
+				mock.WithRequestMatch(
+					getIssueBlocking,
+					[]*github.Issue{
+						{
+							Number:     github.Ptr(50),
+							Title:      github.Ptr("Announcement"),
+							State:      github.Ptr("open"),
+							HTMLURL:    github.Ptr("https://github.com/owner/repo/issues/50"),
+							Repository: &github.Repository{Name: github.Ptr("repo"), Owner: &github.User{Login: github.Ptr("owner")}},
+						},
+					},
+				),
+			),
+			expectedResult: issueDependencies{
+				Issue: 42,
+				BlockedBy: []dependencyIssue{
+					{Owner: "owner", Repo: "repo", Number: 10, Title: "Migration", State: "closed", URL: "https://github.com/owner/repo/issues/10"},
+				},
+				Blocks: []dependencyIssue{
+					{Owner: "owner", Repo: "repo", Number: 50, Title: "Announcement", State: "open", URL: "https://github.com/owner/repo/issues/50"},
+				},
+				Source: "dependencies",
+			},
+		},
+		{
+			name: "inferred from references without issue dependencies",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getIssueBlockedBy,
+					notFound,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					getIssueHandler,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					mockTimeline,
+				),
+			),
+			expectedResult: issueDependencies{
+				Issue: 42,
+				BlockedBy: []dependencyIssue{
+					{Owner: "owner", Repo: "repo", Number: 10, Title: "Migration", State: "closed", URL: "https://github.com/owner/repo/issues/10"},
+					{Owner: "octo", Repo: "lib", Number: 3, Title: "Upstream fix", State: "open", URL: "https://github.com/octo/lib/issues/3"},
+					{Owner: "owner", Repo: "repo", Number: 404, State: "not_found"},
+					{Owner: "owner", Repo: "other", Number: 5, Title: "Infra", State: "open", URL: "https://github.com/owner/other/issues/5"},
+				},
+				Blocks: []dependencyIssue{
+					{Owner: "owner", Repo: "repo", Number: 50, Title: "Announcement", State: "open", URL: "https://github.com/owner/repo/issues/50"},
+					{Owner: "owner", Repo: "repo", Number: 60, Title: "Docs", State: "open", URL: "https://github.com/owner/repo/issues/60"},
+				},
+				Source: "references",
+			},
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getIssueBlockedBy,
+					notFound,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					notFound,
+				),
+			),
+			expectedErrMsg: "issue #42 not found in owner/repo",
+		},
+		{
+			name: "list dependencies fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getIssueBlockedBy,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Internal Server Error"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list issue dependencies",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetIssueDependencies(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			}))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned issueDependencies
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -14,6 +17,14 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// issueWithComments is an issue together with its comments, as returned by get_issue with
+// include_comments. CommentsTruncated is set when the issue has more comments than were fetched.
+type issueWithComments struct {
+	*github.Issue
+	CommentThread     []*github.IssueComment `json:"comment_thread"`
+	CommentsTruncated bool                   `json:"comments_truncated"`
+}
+
 // GetIssue creates a tool to get details of a specific issue in a GitHub repository.
 func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_issue",
@@ -30,6 +41,14 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				mcp.Required(),
 				mcp.Description("The number of the issue"),
 			),
+			mcp.WithBoolean("include_comments",
+				mcp.Description("Also return the comments of the issue, oldest first, in comment_thread"),
+			),
+			mcp.WithNumber("max_comments",
+				mcp.Description("Maximum number of comments to include with include_comments (default 30, max 100)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -44,6 +63,17 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includeComments, err := OptionalParam[bool](request, "include_comments")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxComments, err := OptionalIntParamWithDefault(request, "max_comments", 30)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxComments < 1 || maxComments > 100 {
+				return mcp.NewToolResultError(fmt.Sprintf("max_comments must be between 1 and 100, got %d", maxComments)), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -63,7 +93,24 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issue)
+			var result any = issue
+			if includeComments {
+				comments, commentsResp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, &github.IssueListCommentsOptions{
+					ListOptions: github.ListOptions{PerPage: maxComments},
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to get issue comments: %w", err)
+				}
+				_ = commentsResp.Body.Close()
+
+				result = issueWithComments{
+					Issue:             issue,
+					CommentThread:     comments,
+					CommentsTruncated: issue.GetComments() > len(comments),
+				}
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal issue: %w", err)
 			}
@@ -72,6 +119,119 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 		}
 }
 
+// Limits of batch_get_issues: how many issues can be requested at once and how many of them
+// are fetched concurrently.
+const (
+	maxBatchIssues     = 50
+	batchIssuesWorkers = 5
+)
+
+// batchIssueEntry is one of the issues requested from batch_get_issues, or the reason it could
+// not be returned.
+type batchIssueEntry struct {
+	Issue *github.Issue `json:"issue,omitempty"`
+	Error string        `json:"error,omitempty"`
+}
+
+// fetchBatchIssue gets an issue for batch_get_issues. Failures that only concern this issue,
+// such as a missing or deleted issue, are returned in the entry rather than as an error.
+func fetchBatchIssue(ctx context.Context, client *github.Client, owner, repo string, number int) batchIssueEntry {
+	issue, resp, err := client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		if resp != nil {
+			switch resp.StatusCode {
+			case http.StatusNotFound:
+				return batchIssueEntry{Error: "not found"}
+			case http.StatusGone:
+				return batchIssueEntry{Error: "deleted"}
+			}
+		}
+		return batchIssueEntry{Error: err.Error()}
+	}
+	_ = resp.Body.Close()
+	return batchIssueEntry{Issue: issue}
+}
+
+// BatchGetIssues creates a tool to get several issues of a repository at once.
+func BatchGetIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("batch_get_issues",
+			mcp.WithDescription(t("TOOL_BATCH_GET_ISSUES_DESCRIPTION", "Get several issues of a GitHub repository in one call, keyed by issue number. Issues that can't be read get an error instead. Up to 50 issues")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository"),
+			),
+			mcp.WithArray("issue_numbers",
+				mcp.Required(),
+				mcp.Description("Numbers of the issues to get"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "number",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			numbers, err := OptionalIntArrayParam(request, "issue_numbers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Each issue is fetched once, however often it is requested
+			var unique []int
+			for _, number := range numbers {
+				if number < 1 {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid issue number %d", number)), nil
+				}
+				if !slices.Contains(unique, number) {
+					unique = append(unique, number)
+				}
+			}
+			if len(unique) == 0 {
+				return mcp.NewToolResultError("missing required parameter: issue_numbers"), nil
+			}
+			if len(unique) > maxBatchIssues {
+				return mcp.NewToolResultError(fmt.Sprintf("too many issue numbers: %d, at most %d issues can be requested at once", len(unique), maxBatchIssues)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			entries, errs := fanOut(ctx, unique, batchIssuesWorkers, func(ctx context.Context, number int) (batchIssueEntry, error) {
+				return fetchBatchIssue(ctx, client, owner, repo, number), nil
+			})
+
+			result := make(map[string]batchIssueEntry, len(unique))
+			for i, number := range unique {
+				if errs[i] != nil {
+					// The request was cancelled before the issue was fetched
+					entries[i] = batchIssueEntry{Error: errs[i].Error()}
+				}
+				result[strconv.Itoa(number)] = entries[i]
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
 // AddIssueComment creates a tool to add a comment to an issue.
 func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("add_issue_comment",
@@ -142,30 +302,98 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
+var (
+	issueSearchStates   = []string{"open", "closed"}
+	issueSearchIsValues = []string{"open", "closed", "merged"}
+
+	// issueReactionSorts sort issues by their number of reactions, of any kind or of one kind.
+	// GitHub only supports them when searching, so list_issues searches the repository for them.
+	issueReactionSorts = []string{"reactions", "reactions-+1", "reactions--1", "reactions-smile", "reactions-thinking_face", "reactions-heart", "reactions-tada"}
+	issueSearchSorts   = slices.Concat([]string{"comments"}, issueReactionSorts, []string{"interactions", "created", "updated"})
+	issueListSorts     = slices.Concat([]string{"created", "updated", "comments"}, issueReactionSorts)
+)
+
+// issueSearchQualifiers are the structured filters of search_issues, composed into the search query.
+type issueSearchQualifiers struct {
+	repo     string
+	author   string
+	assignee string
+	labels   []string
+	state    string
+	is       string
+}
+
+// searchQualifier formats a "key:value" search qualifier, quoting values that contain whitespace.
+func searchQualifier(key, value string) string {
+	if strings.ContainsAny(value, " \t\n") {
+		value = `"` + strings.ReplaceAll(value, `"`, "") + `"`
+	}
+	return key + ":" + value
+}
+
+// buildIssueSearchQuery appends the qualifiers to the free-text query.
+func buildIssueSearchQuery(query string, q issueSearchQualifiers) string {
+	var terms []string
+	if query = strings.TrimSpace(query); query != "" {
+		terms = append(terms, query)
+	}
+	if q.repo != "" {
+		terms = append(terms, searchQualifier("repo", q.repo))
+	}
+	if q.author != "" {
+		terms = append(terms, searchQualifier("author", q.author))
+	}
+	if q.assignee != "" {
+		terms = append(terms, searchQualifier("assignee", q.assignee))
+	}
+	for _, label := range q.labels {
+		terms = append(terms, searchQualifier("label", label))
+	}
+	if q.state != "" {
+		terms = append(terms, searchQualifier("state", q.state))
+	}
+	if q.is != "" {
+		terms = append(terms, searchQualifier("is", q.is))
+	}
+	return strings.Join(terms, " ")
+}
+
 // SearchIssues creates a tool to search for issues and pull requests.
 func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_issues",
 			mcp.WithDescription(t("TOOL_SEARCH_ISSUES_DESCRIPTION", "Search for issues and pull requests across GitHub repositories")),
 			mcp.WithString("q",
-				mcp.Required(),
-				mcp.Description("Search query using GitHub issues search syntax"),
+				mcp.Description("Search query using GitHub issues search syntax. Combined with the qualifier parameters, at least one of which is required without it"),
 			),
-			mcp.WithString("sort",
-				mcp.Description("Sort field (comments, reactions, created, etc.)"),
-				mcp.Enum(
-					"comments",
-					"reactions",
-					"reactions-+1",
-					"reactions--1",
-					"reactions-smile",
-					"reactions-thinking_face",
-					"reactions-heart",
-					"reactions-tada",
-					"interactions",
-					"created",
-					"updated",
+			mcp.WithString("repo",
+				mcp.Description("Only match issues in this repository, as 'owner/name'"),
+			),
+			mcp.WithString("author",
+				mcp.Description("Only match issues created by this user"),
+			),
+			mcp.WithString("assignee",
+				mcp.Description("Only match issues assigned to this user"),
+			),
+			mcp.WithArray("label",
+				mcp.Description("Only match issues with all of these labels"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
 				),
 			),
+			mcp.WithString("state",
+				mcp.Description("Only match issues in this state"),
+				mcp.Enum(issueSearchStates...),
+			),
+			mcp.WithString("is",
+				mcp.Description("Only match open, closed or merged issues and pull requests"),
+				mcp.Enum(issueSearchIsValues...),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field (comments, reactions, created, etc.). 'reactions' sorts by the number of reactions, 'reactions-+1' and the like by the number of one kind of reaction"),
+				mcp.Enum(issueSearchSorts...),
+			),
 			mcp.WithString("order",
 				mcp.Description("Sort order ('asc' or 'desc')"),
 				mcp.Enum("asc", "desc"),
@@ -173,14 +401,46 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			query, err := requiredParam[string](request, "q")
+			query, err := OptionalParam[string](request, "q")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			var qualifiers issueSearchQualifiers
+			if qualifiers.repo, err = OptionalParam[string](request, "repo"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.author, err = OptionalParam[string](request, "author"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.assignee, err = OptionalParam[string](request, "assignee"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.labels, err = OptionalStringArrayParam(request, "label"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.state, err = OptionalParam[string](request, "state"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.is, err = OptionalParam[string](request, "is"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.state != "" && !slices.Contains(issueSearchStates, qualifiers.state) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state %q, must be one of: %s", qualifiers.state, strings.Join(issueSearchStates, ", "))), nil
+			}
+			if qualifiers.is != "" && !slices.Contains(issueSearchIsValues, qualifiers.is) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid is %q, must be one of: %s", qualifiers.is, strings.Join(issueSearchIsValues, ", "))), nil
+			}
+			query = buildIssueSearchQuery(query, qualifiers)
+			if query == "" {
+				return mcp.NewToolResultError("missing search query: provide q or at least one qualifier"), nil
+			}
 			sort, err := OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if sort != "" && !slices.Contains(issueSearchSorts, sort) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid sort %q, must be one of: %s", sort, strings.Join(issueSearchSorts, ", "))), nil
+			}
 			order, err := OptionalParam[string](request, "order")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -227,7 +487,7 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 }
 
 // CreateIssue creates a tool to create a new issue in a GitHub repository.
-func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func CreateIssue(getClient GetClientFn, created *idempotencyCache, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("create_issue",
 			mcp.WithDescription(t("TOOL_CREATE_ISSUE_DESCRIPTION", "Create a new issue in a GitHub repository")),
 			mcp.WithString("owner",
@@ -262,8 +522,9 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				),
 			),
 			mcp.WithNumber("milestone",
-				mcp.Description("Milestone number"),
+				mcp.Description("Number of the milestone to assign the issue to"),
 			),
+			WithIdempotencyKey(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -298,14 +559,26 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			}
 
 			// Get optional milestone
-			milestone, err := OptionalIntParam(request, "milestone")
+			milestone, hasMilestone, err := OptionalParamOK[float64](request, "milestone")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			var milestoneNum *int
-			if milestone != 0 {
-				milestoneNum = &milestone
+			if hasMilestone {
+				if milestone < 1 || milestone != float64(int(milestone)) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid milestone %v, must be a positive milestone number", milestone)), nil
+				}
+				n := int(milestone)
+				milestoneNum = &n
+			}
+
+			idempotencyKey, err := OptionalParam[string](request, "idempotency_key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if r, ok := created.lookup("create_issue", owner, repo, idempotencyKey); ok {
+				return mcp.NewToolResultText(r), nil
 			}
 
 			// Create the issue request
@@ -339,12 +612,44 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
+			created.store("create_issue", owner, repo, idempotencyKey, string(r))
 
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
 
 // ListIssues creates a tool to list and filter repository issues
+// listIssuesByReactions lists the issues of a repository sorted by reactions, which only the
+// search API supports, so the filters of list_issues are translated to search qualifiers.
+func listIssuesByReactions(ctx context.Context, client *github.Client, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	qualifiers := issueSearchQualifiers{
+		repo:   owner + "/" + repo,
+		labels: opts.Labels,
+		state:  opts.State,
+	}
+	switch opts.State {
+	case "":
+		// Like the list endpoint, only list open issues by default
+		qualifiers.state = "open"
+	case "all":
+		qualifiers.state = ""
+	}
+	query := buildIssueSearchQuery("", qualifiers)
+	if !opts.Since.IsZero() {
+		query += " " + searchQualifier("updated", ">="+opts.Since.UTC().Format(time.RFC3339))
+	}
+
+	result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{
+		Sort:        opts.Sort,
+		Order:       opts.Direction,
+		ListOptions: opts.ListOptions,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.Issues, resp, nil
+}
+
 func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_issues",
 			mcp.WithDescription(t("TOOL_LIST_ISSUES_DESCRIPTION", "List issues in a GitHub repository with filtering options")),
@@ -369,8 +674,8 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				),
 			),
 			mcp.WithString("sort",
-				mcp.Description("Sort by ('created', 'updated', 'comments')"),
-				mcp.Enum("created", "updated", "comments"),
+				mcp.Description("Sort by ('created', 'updated', 'comments'), or by number of reactions ('reactions', or 'reactions-+1' and the like for one kind of reaction)"),
+				mcp.Enum(issueListSorts...),
 			),
 			mcp.WithString("direction",
 				mcp.Description("Sort direction ('asc', 'desc')"),
@@ -380,6 +685,7 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Description("Filter by date (ISO 8601 timestamp)"),
 			),
 			WithPagination(),
+			WithResponseFormat(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -409,22 +715,26 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if opts.Sort != "" && !slices.Contains(issueListSorts, opts.Sort) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid sort %q, must be one of: %s", opts.Sort, strings.Join(issueListSorts, ", "))), nil
+			}
 
 			opts.Direction, err = OptionalParam[string](request, "direction")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			since, err := OptionalParam[string](request, "since")
+			format, err := OptionalResponseFormat(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if since != "" {
-				timestamp, err := parseISOTimestamp(since)
-				if err != nil {
-					return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil
-				}
-				opts.Since = timestamp
+
+			since, err := OptionalTimeParam(request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if since != nil {
+				opts.Since = *since
 			}
 
 			if page, ok := request.Params.Arguments["page"].(float64); ok {
@@ -439,7 +749,13 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+			var issues []*github.Issue
+			var resp *github.Response
+			if slices.Contains(issueReactionSorts, opts.Sort) {
+				issues, resp, err = listIssuesByReactions(ctx, client, owner, repo, opts)
+			} else {
+				issues, resp, err = client.Issues.ListByRepo(ctx, owner, repo, opts)
+			}
 			if err != nil {
 				return nil, fmt.Errorf("failed to list issues: %w", err)
 			}
@@ -453,13 +769,70 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issues)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal issues: %w", err)
-			}
+			return formattedResult(format, issues, func() string { return issuesMarkdown(issues) })
+		}
+}
 
-			return mcp.NewToolResultText(string(r)), nil
+// unchangedIssue is returned by update_issue when the update was skipped because it would not change anything.
+type unchangedIssue struct {
+	*github.Issue
+	NoChanges bool `json:"no_changes"`
+}
+
+// issueMatchesRequest reports whether every field set in the request already has the requested value
+// on the issue. Labels and assignees are compared as sets, since their order is not significant.
+func issueMatchesRequest(issue *github.Issue, req *github.IssueRequest) bool {
+	if req.Title != nil && *req.Title != issue.GetTitle() {
+		return false
+	}
+	if req.Body != nil && *req.Body != issue.GetBody() {
+		return false
+	}
+	if req.State != nil && *req.State != issue.GetState() {
+		return false
+	}
+	if req.Milestone != nil && *req.Milestone != issue.GetMilestone().GetNumber() {
+		return false
+	}
+	if req.Labels != nil {
+		names := make([]string, 0, len(issue.Labels))
+		for _, label := range issue.Labels {
+			names = append(names, label.GetName())
+		}
+		if !sameStringSet(*req.Labels, names) {
+			return false
+		}
+	}
+	if req.Assignees != nil {
+		logins := make([]string, 0, len(issue.Assignees))
+		for _, assignee := range issue.Assignees {
+			logins = append(logins, assignee.GetLogin())
+		}
+		if !sameStringSet(*req.Assignees, logins) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameStringSet reports whether a and b contain the same strings, ignoring order and duplicates.
+func sameStringSet(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, s := range a {
+		set[s] = false
+	}
+	for _, s := range b {
+		if _, ok := set[s]; !ok {
+			return false
+		}
+		set[s] = true
+	}
+	for _, seen := range set {
+		if !seen {
+			return false
 		}
+	}
+	return true
 }
 
 // UpdateIssue creates a tool to update an existing issue in a GitHub repository.
@@ -507,6 +880,9 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			mcp.WithNumber("milestone",
 				mcp.Description("New milestone number"),
 			),
+			mcp.WithBoolean("skip_if_unchanged",
+				mcp.Description("Fetch the issue first and skip the update if every supplied field already has the requested value"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -577,10 +953,32 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				issueRequest.Milestone = &milestoneNum
 			}
 
+			skipIfUnchanged, err := OptionalParam[bool](request, "skip_if_unchanged")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+
+			if skipIfUnchanged {
+				currentIssue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get issue: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				if issueMatchesRequest(currentIssue, issueRequest) {
+					r, err := json.Marshal(unchangedIssue{Issue: currentIssue, NoChanges: true})
+					if err != nil {
+						return nil, fmt.Errorf("failed to marshal response: %w", err)
+					}
+					return mcp.NewToolResultText(string(r)), nil
+				}
+			}
+
 			updatedIssue, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, issueRequest)
 			if err != nil {
 				return nil, fmt.Errorf("failed to update issue: %w", err)
@@ -685,7 +1083,8 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 
 // parseISOTimestamp parses an ISO 8601 timestamp string into a time.Time object.
 // Returns the parsed time or an error if parsing fails.
-// Example formats supported: "2023-01-15T14:30:00Z", "2023-01-15"
+// Example formats supported: "2023-01-15T14:30:00Z", "2023-01-15T14:30:00", "2023-01-15"
+// Timestamps without a time zone are taken to be in UTC.
 func parseISOTimestamp(timestamp string) (time.Time, error) {
 	if timestamp == "" {
 		return time.Time{}, fmt.Errorf("empty timestamp")
@@ -697,6 +1096,12 @@ func parseISOTimestamp(timestamp string) (time.Time, error) {
 		return t, nil
 	}
 
+	// Try date and time without a time zone (YYYY-MM-DDThh:mm:ss)
+	t, err = time.Parse("2006-01-02T15:04:05", timestamp)
+	if err == nil {
+		return t, nil
+	}
+
 	// Try simple date format (YYYY-MM-DD)
 	t, err = time.Parse("2006-01-02", timestamp)
 	if err == nil {
@@ -704,5 +1109,266 @@ func parseISOTimestamp(timestamp string) (time.Time, error) {
 	}
 
 	// Return error with supported formats
-	return time.Time{}, fmt.Errorf("invalid ISO 8601 timestamp: %s (supported formats: YYYY-MM-DDThh:mm:ssZ or YYYY-MM-DD)", timestamp)
+	return time.Time{}, fmt.Errorf("invalid ISO 8601 timestamp: %s (supported formats: YYYY-MM-DDThh:mm:ssZ, YYYY-MM-DDThh:mm:ss or YYYY-MM-DD)", timestamp)
+}
+
+// issueLockReasons are the reasons GitHub accepts for locking an issue conversation.
+var issueLockReasons = []string{"off-topic", "too heated", "resolved", "spam"}
+
+// LockIssue creates a tool to lock the conversation of an issue or pull request.
+func LockIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("lock_issue",
+			mcp.WithDescription(t("TOOL_LOCK_ISSUE_DESCRIPTION", "Lock the conversation of an issue or pull request so only collaborators can comment")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue or pull request number"),
+			),
+			mcp.WithString("lock_reason",
+				mcp.Description("Reason for locking the conversation"),
+				mcp.Enum(issueLockReasons...),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			lockReason, err := OptionalParam[string](request, "lock_reason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if lockReason != "" && !slices.Contains(issueLockReasons, lockReason) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid lock_reason %q, must be one of: %s", lockReason, strings.Join(issueLockReasons, ", "))), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Issues.Lock(ctx, owner, repo, issueNumber, &github.LockIssueOptions{LockReason: lockReason})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to lock issue: locking conversations in %s/%s requires write access to the repository", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to lock issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to lock issue: %s", string(body))), nil
+			}
+
+			if lockReason != "" {
+				return mcp.NewToolResultText(fmt.Sprintf("Locked %s/%s#%d as %s", owner, repo, issueNumber, lockReason)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Locked %s/%s#%d", owner, repo, issueNumber)), nil
+		}
+}
+
+// UnlockIssue creates a tool to unlock the conversation of an issue or pull request.
+func UnlockIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unlock_issue",
+			mcp.WithDescription(t("TOOL_UNLOCK_ISSUE_DESCRIPTION", "Unlock the conversation of an issue or pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue or pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Issues.Unlock(ctx, owner, repo, issueNumber)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to unlock issue: unlocking conversations in %s/%s requires write access to the repository", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to unlock issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to unlock issue: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Unlocked %s/%s#%d", owner, repo, issueNumber)), nil
+		}
+}
+
+// transferIssueIDsQuery resolves the node IDs the transferIssue mutation takes.
+const transferIssueIDsQuery = `query($owner: String!, $repo: String!, $number: Int!, $targetOwner: String!, $targetRepo: String!) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) { id }
+  }
+  target: repository(owner: $targetOwner, name: $targetRepo) { id }
+}`
+
+const transferIssueMutation = `mutation($issueId: ID!, $repositoryId: ID!) {
+  transferIssue(input: {issueId: $issueId, repositoryId: $repositoryId}) {
+    issue { number url }
+  }
+}`
+
+// TransferIssue creates a tool to move an issue to another repository.
+func TransferIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("transfer_issue",
+			mcp.WithDescription(t("TOOL_TRANSFER_ISSUE_DESCRIPTION", "Transfer an issue to another repository. Returns the URL of the issue in the target repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithString("target_repo",
+				mcp.Required(),
+				mcp.Description("Repository to transfer the issue to, as 'owner/name', or 'name' for a repository of the same owner"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			target, err := requiredParam[string](request, "target_repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			targetOwner, targetRepo := owner, target
+			if o, r, ok := strings.Cut(target, "/"); ok {
+				targetOwner, targetRepo = o, r
+			}
+			if targetOwner == "" || targetRepo == "" || strings.Contains(targetRepo, "/") {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid target_repo %q, must be 'owner/name' or 'name'", target)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The mutation takes node IDs, so look up the issue and the target repository first.
+			result, resp, err := queryGraphQL(ctx, client, transferIssueIDsQuery, map[string]interface{}{
+				"owner":       owner,
+				"repo":        repo,
+				"number":      issueNumber,
+				"targetOwner": targetOwner,
+				"targetRepo":  targetRepo,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to transfer issue: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to transfer issue: %s", err.Error())), nil
+			}
+
+			var ids struct {
+				Repository *struct {
+					Issue *struct {
+						ID string `json:"id"`
+					} `json:"issue"`
+				} `json:"repository"`
+				Target *struct {
+					ID string `json:"id"`
+				} `json:"target"`
+			}
+			if err := json.Unmarshal(result.Data, &ids); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal issue and repository IDs: %w", err)
+			}
+			if ids.Repository == nil || ids.Repository.Issue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("issue not found: %s/%s#%d", owner, repo, issueNumber)), nil
+			}
+			if ids.Target == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("target repository not found: %s/%s", targetOwner, targetRepo)), nil
+			}
+
+			result, resp, err = queryGraphQL(ctx, client, transferIssueMutation, map[string]interface{}{
+				"issueId":      ids.Repository.Issue.ID,
+				"repositoryId": ids.Target.ID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to transfer issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to transfer issue: %s", err.Error())), nil
+			}
+
+			var data struct {
+				TransferIssue struct {
+					Issue struct {
+						Number int    `json:"number"`
+						URL    string `json:"url"`
+					} `json:"issue"`
+				} `json:"transferIssue"`
+			}
+			if err := json.Unmarshal(result.Data, &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal transferred issue: %w", err)
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Transferred %s/%s#%d to %s", owner, repo, issueNumber, data.TransferIssue.Issue.URL)), nil
+		}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +26,8 @@ func Test_GetIssue(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "include_comments")
+	assert.Contains(t, tool.InputSchema.Properties, "max_comments")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
 
 	// Setup mock issue for success case
@@ -111,6 +114,254 @@ func Test_GetIssue(t *testing.T) {
 	}
 }
 
+func Test_GetIssue_IncludeComments(t *testing.T) {
+	mockIssue := &github.Issue{
+		Number:   github.Ptr(42),
+		Title:    github.Ptr("Test Issue"),
+		Comments: github.Ptr(3),
+	}
+	mockComments := []*github.IssueComment{
+		{ID: github.Ptr(int64(1)), Body: github.Ptr("First"), User: &github.User{Login: github.Ptr("user1")}},
+		{ID: github.Ptr(int64(2)), Body: github.Ptr("Second"), User: &github.User{Login: github.Ptr("user2")}},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectThread      bool
+		expectedComments  []string
+		expectedTruncated bool
+		expectToolErr     bool
+		expectedErrMsg    string
+	}{
+		{
+			name: "comments included when requested",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockIssue,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					expectQueryParams(t, map[string]string{
+						"per_page": "2",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockComments),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"issue_number":     float64(42),
+				"include_comments": true,
+				"max_comments":     float64(2),
+			},
+			expectThread:      true,
+			expectedComments:  []string{"First", "Second"},
+			expectedTruncated: true,
+		},
+		{
+			// Listing comments is not mocked, so fetching them would fail the call.
+			name: "comments absent without the flag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockIssue,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectThread: false,
+		},
+		{
+			name:         "max_comments out of range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"issue_number":     float64(42),
+				"include_comments": true,
+				"max_comments":     float64(500),
+			},
+			expectToolErr:  true,
+			expectedErrMsg: "max_comments must be between 1 and 100, got 500",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.JSONEq(t, `42`, string(returned["number"]))
+			assert.JSONEq(t, `3`, string(returned["comments"]))
+
+			if !tc.expectThread {
+				assert.NotContains(t, returned, "comment_thread")
+				assert.NotContains(t, returned, "comments_truncated")
+				return
+			}
+
+			var thread []github.IssueComment
+			require.NoError(t, json.Unmarshal(returned["comment_thread"], &thread))
+			bodies := make([]string, 0, len(thread))
+			for _, c := range thread {
+				bodies = append(bodies, c.GetBody())
+			}
+			assert.Equal(t, tc.expectedComments, bodies)
+
+			var truncated bool
+			require.NoError(t, json.Unmarshal(returned["comments_truncated"], &truncated))
+			assert.Equal(t, tc.expectedTruncated, truncated)
+		})
+	}
+}
+
+func Test_BatchGetIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := BatchGetIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "batch_get_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_numbers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_numbers"})
+
+	// serveIssues serves the issues of a repository by number, issue 3 having been deleted
+	serveIssues := func(issues map[string]*github.Issue) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			number := strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/issues/")
+			if number == "3" {
+				mockResponse(t, http.StatusGone, map[string]string{"message": "This issue was deleted"})(w, r)
+				return
+			}
+			issue, ok := issues[number]
+			if !ok {
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, issue)(w, r)
+		}
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectToolErr   bool
+		expectedEntries map[string]batchIssueEntry
+		expectedText    string
+	}{
+		{
+			name: "get several issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					serveIssues(map[string]*github.Issue{
+						"1": {Number: github.Ptr(1), Title: github.Ptr("First")},
+						"2": {Number: github.Ptr(2), Title: github.Ptr("Second")},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{float64(1), float64(2), float64(3), float64(4), float64(1)},
+			},
+			expectedEntries: map[string]batchIssueEntry{
+				"1": {Issue: &github.Issue{Number: github.Ptr(1), Title: github.Ptr("First")}},
+				"2": {Issue: &github.Issue{Number: github.Ptr(2), Title: github.Ptr("Second")}},
+				"3": {Error: "deleted"},
+				"4": {Error: "not found"},
+			},
+		},
+		{
+			name:         "no issue numbers",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{},
+			},
+			expectToolErr: true,
+			expectedText:  "missing required parameter: issue_numbers",
+		},
+		{
+			name:         "invalid issue number",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{float64(1), float64(0)},
+			},
+			expectToolErr: true,
+			expectedText:  "invalid issue number 0",
+		},
+		{
+			name:         "too many issue numbers",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"issue_numbers": func() []interface{} {
+					numbers := make([]interface{}, 0, maxBatchIssues+1)
+					for i := 1; i <= maxBatchIssues+1; i++ {
+						numbers = append(numbers, float64(i))
+					}
+					return numbers
+				}(),
+			},
+			expectToolErr: true,
+			expectedText:  "too many issue numbers: 51, at most 50 issues can be requested at once",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := BatchGetIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var entries map[string]batchIssueEntry
+			err = json.Unmarshal([]byte(textContent.Text), &entries)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEntries, entries)
+		})
+	}
+}
+
 func Test_AddIssueComment(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -242,11 +493,17 @@ func Test_SearchIssues(t *testing.T) {
 	assert.Equal(t, "search_issues", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "q")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "author")
+	assert.Contains(t, tool.InputSchema.Properties, "assignee")
+	assert.Contains(t, tool.InputSchema.Properties, "label")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "is")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "order")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
+	assert.Empty(t, tool.InputSchema.Required)
 
 	// Setup mock search results
 	mockSearchResult := &github.IssuesSearchResult{
@@ -263,6 +520,7 @@ func Test_SearchIssues(t *testing.T) {
 				User: &github.User{
 					Login: github.Ptr("user1"),
 				},
+				Reactions: &github.Reactions{TotalCount: github.Ptr(12), PlusOne: github.Ptr(10), Heart: github.Ptr(2)},
 			},
 			{
 				Number:   github.Ptr(43),
@@ -274,6 +532,7 @@ func Test_SearchIssues(t *testing.T) {
 				User: &github.User{
 					Login: github.Ptr("user2"),
 				},
+				Reactions: &github.Reactions{TotalCount: github.Ptr(4), PlusOne: github.Ptr(4)},
 			},
 		},
 	}
@@ -315,6 +574,78 @@ func Test_SearchIssues(t *testing.T) {
 			expectError:    false,
 			expectedResult: mockSearchResult,
 		},
+		{
+			name: "issues search composed from qualifiers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        `login error repo:owner/repo author:octocat label:bug label:"help wanted" is:open`,
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q":      "login error",
+				"repo":   "owner/repo",
+				"author": "octocat",
+				"label":  []interface{}{"bug", "help wanted"},
+				"is":     "open",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
+		{
+			name: "issues search sorted by reactions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        "repo:owner/repo state:open",
+							"sort":     "reactions-+1",
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repo":  "owner/repo",
+				"state": "open",
+				"sort":  "reactions-+1",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
+		{
+			name:         "issues search with invalid sort",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"q":    "crash",
+				"sort": "reactions-rocket",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid sort "reactions-rocket", must be one of: comments, reactions, reactions-+1, reactions--1, reactions-smile, reactions-thinking_face, reactions-heart, reactions-tada, interactions, created, updated`,
+		},
+		{
+			name:         "issues search without query or qualifiers",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"sort": "created",
+			},
+			expectError:    false,
+			expectedErrMsg: "missing search query: provide q or at least one qualifier",
+		},
 		{
 			name: "issues search with minimal parameters",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -372,6 +703,12 @@ func Test_SearchIssues(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
 			var returnedResult github.IssuesSearchResult
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
@@ -385,15 +722,57 @@ func Test_SearchIssues(t *testing.T) {
 				assert.Equal(t, *tc.expectedResult.Issues[i].State, *issue.State)
 				assert.Equal(t, *tc.expectedResult.Issues[i].HTMLURL, *issue.HTMLURL)
 				assert.Equal(t, *tc.expectedResult.Issues[i].User.Login, *issue.User.Login)
+				assert.Equal(t, tc.expectedResult.Issues[i].GetReactions().GetTotalCount(), issue.GetReactions().GetTotalCount())
 			}
 		})
 	}
 }
 
+func Test_BuildIssueSearchQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		qualifiers issueSearchQualifiers
+		expected   string
+	}{
+		{
+			name:     "free text only",
+			query:    "  memory leak ",
+			expected: "memory leak",
+		},
+		{
+			name:       "qualifiers only",
+			qualifiers: issueSearchQualifiers{repo: "owner/repo", assignee: "octocat", state: "closed"},
+			expected:   "repo:owner/repo assignee:octocat state:closed",
+		},
+		{
+			name:       "free text and qualifiers",
+			query:      "crash",
+			qualifiers: issueSearchQualifiers{author: "hubot", is: "merged"},
+			expected:   "crash author:hubot is:merged",
+		},
+		{
+			name:       "labels with spaces and quotes are quoted",
+			qualifiers: issueSearchQualifiers{labels: []string{"good first issue", `say "hi"`, "bug"}},
+			expected:   `label:"good first issue" label:"say hi" label:bug`,
+		},
+		{
+			name:     "nothing",
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, buildIssueSearchQuery(tc.query, tc.qualifiers))
+		})
+	}
+}
+
 func Test_CreateIssue(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := CreateIssue(stubGetClientFn(mockClient), newIdempotencyCache(idempotencyTTL), translations.NullTranslationHelper)
 
 	assert.Equal(t, "create_issue", tool.Name)
 	assert.NotEmpty(t, tool.Description)
@@ -404,6 +783,7 @@ func Test_CreateIssue(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "assignees")
 	assert.Contains(t, tool.InputSchema.Properties, "labels")
 	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.Contains(t, tool.InputSchema.Properties, "idempotency_key")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
 
 	// Setup mock issue for success case
@@ -481,6 +861,30 @@ func Test_CreateIssue(t *testing.T) {
 				State:   github.Ptr("open"),
 			},
 		},
+		{
+			name:         "non-positive milestone is rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"title":     "Test Issue",
+				"milestone": float64(0),
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid milestone 0, must be a positive milestone number",
+		},
+		{
+			name:         "fractional milestone is rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"title":     "Test Issue",
+				"milestone": float64(1.5),
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid milestone 1.5, must be a positive milestone number",
+		},
 		{
 			name: "issue creation fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -506,7 +910,7 @@ func Test_CreateIssue(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreateIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := CreateIssue(stubGetClientFn(client), newIdempotencyCache(idempotencyTTL), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -560,10 +964,62 @@ func Test_CreateIssue(t *testing.T) {
 					assert.Equal(t, *tc.expectedIssue.Labels[i].Name, *label.Name)
 				}
 			}
+
+			// Check milestone if expected
+			if tc.expectedIssue.Milestone != nil {
+				require.NotNil(t, returnedIssue.Milestone)
+				assert.Equal(t, tc.expectedIssue.Milestone.GetNumber(), returnedIssue.Milestone.GetNumber())
+			}
 		})
 	}
 }
 
+func Test_CreateIssue_IdempotencyKey(t *testing.T) {
+	mockIssue := &github.Issue{
+		Number:  github.Ptr(124),
+		Title:   github.Ptr("Flaky test"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/124"),
+	}
+
+	calls := 0
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposIssuesByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				mockResponse(t, http.StatusCreated, mockIssue)(w, r)
+			}),
+		),
+	))
+	_, handler := CreateIssue(stubGetClientFn(client), newIdempotencyCache(idempotencyTTL), translations.NullTranslationHelper)
+
+	request := createMCPRequest(map[string]interface{}{
+		"owner":           "owner",
+		"repo":            "repo",
+		"title":           "Flaky test",
+		"idempotency_key": "create-issue-flaky-test",
+	})
+
+	first, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	second, err := handler(context.Background(), request)
+	require.NoError(t, err)
+
+	// The retry returns the issue of the first call instead of creating another one
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, getTextResult(t, first).Text, getTextResult(t, second).Text)
+
+	// The same key in another repository is another creation
+	_, err = handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":           "owner",
+		"repo":            "other-repo",
+		"title":           "Flaky test",
+		"idempotency_key": "create-issue-flaky-test",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
 func Test_ListIssues(t *testing.T) {
 	// Verify tool definition
 	mockClient := github.NewClient(nil)
@@ -659,30 +1115,92 @@ func Test_ListIssues(t *testing.T) {
 			expectedIssues: mockIssues,
 		},
 		{
-			name: "invalid since parameter",
+			name: "list issues sorted by reactions searches the repository",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposIssuesByOwnerByRepo,
-					mockIssues,
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(t, map[string]string{
+						"q":        "repo:owner/repo label:bug state:open",
+						"sort":     "reactions",
+						"order":    "desc",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.IssuesSearchResult{Total: github.Ptr(2), Issues: mockIssues}),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"since": "invalid-date",
+				"owner":     "owner",
+				"repo":      "repo",
+				"labels":    []any{"bug"},
+				"sort":      "reactions",
+				"direction": "desc",
+				"page":      float64(2),
+				"perPage":   float64(10),
 			},
-			expectError:    true,
-			expectedErrMsg: "invalid ISO 8601 timestamp",
+			expectError:    false,
+			expectedIssues: mockIssues,
 		},
 		{
-			name: "list issues fails with error",
+			name: "list all issues updated since a date sorted by heart reactions",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposIssuesByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Repository not found"}`))
-					}),
+					mock.GetSearchIssues,
+					expectQueryParams(t, map[string]string{
+						"q":    "repo:owner/repo updated:>=2023-01-01T00:00:00Z",
+						"sort": "reactions-heart",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.IssuesSearchResult{Total: github.Ptr(2), Issues: mockIssues}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"state": "all",
+				"sort":  "reactions-heart",
+				"since": "2023-01-01T00:00:00Z",
+			},
+			expectError:    false,
+			expectedIssues: mockIssues,
+		},
+		{
+			name:         "invalid sort",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "interactions",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid sort "interactions", must be one of: created, updated, comments, reactions, reactions-+1, reactions--1, reactions-smile, reactions-thinking_face, reactions-heart, reactions-tada`,
+		},
+		{
+			name: "invalid since parameter",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepo,
+					mockIssues,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "invalid-date",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid ISO 8601 timestamp",
+		},
+		{
+			name: "list issues fails with error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Repository not found"}`))
+					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -756,6 +1274,7 @@ func Test_UpdateIssue(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "labels")
 	assert.Contains(t, tool.InputSchema.Properties, "assignees")
 	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.Contains(t, tool.InputSchema.Properties, "skip_if_unchanged")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
 
 	// Setup mock issue for success case
@@ -771,12 +1290,13 @@ func Test_UpdateIssue(t *testing.T) {
 	}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedIssue  *github.Issue
-		expectedErrMsg string
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedIssue     *github.Issue
+		expectedNoChanges bool
+		expectedErrMsg    string
 	}{
 		{
 			name: "update issue with all fields",
@@ -836,6 +1356,76 @@ func Test_UpdateIssue(t *testing.T) {
 				State:   github.Ptr("open"),
 			},
 		},
+		{
+			name: "skip update when all fields already match",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockIssue,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						t.Error("unexpected PATCH for an unchanged issue")
+						w.WriteHeader(http.StatusInternalServerError)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"issue_number":      float64(123),
+				"title":             "Updated Issue Title",
+				"state":             "closed",
+				"labels":            []any{"priority", "bug"},
+				"assignees":         []any{"assignee2", "assignee1"},
+				"milestone":         float64(5),
+				"skip_if_unchanged": true,
+			},
+			expectError:       false,
+			expectedIssue:     mockIssue,
+			expectedNoChanges: true,
+		},
+		{
+			name: "update when a field differs despite skip_if_unchanged",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockIssue,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"title":  "Updated Issue Title",
+						"labels": []any{"bug"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:  github.Ptr(123),
+							Title:   github.Ptr("Updated Issue Title"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+							State:   github.Ptr("closed"),
+							Labels:  []*github.Label{{Name: github.Ptr("bug")}},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"issue_number":      float64(123),
+				"title":             "Updated Issue Title",
+				"labels":            []any{"bug"},
+				"skip_if_unchanged": true,
+			},
+			expectError: false,
+			expectedIssue: &github.Issue{
+				Number:  github.Ptr(123),
+				Title:   github.Ptr("Updated Issue Title"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+				State:   github.Ptr("closed"),
+				Labels:  []*github.Label{{Name: github.Ptr("bug")}},
+			},
+		},
 		{
 			name: "update issue fails with not found",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -918,6 +1508,13 @@ func Test_UpdateIssue(t *testing.T) {
 			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
 			assert.Equal(t, *tc.expectedIssue.HTMLURL, *returnedIssue.HTMLURL)
 
+			var flags struct {
+				NoChanges bool `json:"no_changes"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &flags)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNoChanges, flags.NoChanges)
+
 			if tc.expectedIssue.Body != nil {
 				assert.Equal(t, *tc.expectedIssue.Body, *returnedIssue.Body)
 			}
@@ -960,6 +1557,12 @@ func Test_ParseISOTimestamp(t *testing.T) {
 			expectedErr:  false,
 			expectedTime: time.Date(2023, 1, 15, 14, 30, 0, 0, time.UTC),
 		},
+		{
+			name:         "valid date and time without time zone",
+			input:        "2023-01-15T14:30:00",
+			expectedErr:  false,
+			expectedTime: time.Date(2023, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
 		{
 			name:         "valid date only format",
 			input:        "2023-01-15",
@@ -1130,3 +1733,446 @@ func Test_GetIssueComments(t *testing.T) {
 		})
 	}
 }
+
+func Test_LockIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := LockIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "lock_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "lock_reason")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "lock with reason",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLockByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"lock_reason": "too heated",
+					}).andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"lock_reason":  "too heated",
+			},
+			expectError:  false,
+			expectedText: "Locked owner/repo#42 as too heated",
+		},
+		{
+			name: "lock without reason",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLockByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:  false,
+			expectedText: "Locked owner/repo#42",
+		},
+		{
+			name:         "invalid lock reason",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"lock_reason":  "boring",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid lock_reason "boring", must be one of: off-topic, too heated, resolved, spam`,
+		},
+		{
+			name: "token lacks permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLockByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:    false,
+			expectedErrMsg: "locking conversations in owner/repo requires write access to the repository",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLockByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to lock issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := LockIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_UnlockIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UnlockIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "unlock_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful unlock",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposIssuesLockByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:  false,
+			expectedText: "Unlocked owner/repo#42",
+		},
+		{
+			name: "token lacks permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposIssuesLockByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:    false,
+			expectedErrMsg: "unlocking conversations in owner/repo requires write access to the repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UnlockIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_TransferIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := TransferIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "transfer_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "target_repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "target_repo"})
+
+	mockIDs := map[string]interface{}{
+		"data": map[string]interface{}{
+			"repository": map[string]interface{}{"issue": map[string]interface{}{"id": "I_42"}},
+			"target":     map[string]interface{}{"id": "R_target"},
+		},
+	}
+	mockTransferred := map[string]interface{}{
+		"data": map[string]interface{}{
+			"transferIssue": map[string]interface{}{
+				"issue": map[string]interface{}{"number": 7, "url": "https://github.com/other/target/issues/7"},
+			},
+		},
+	}
+
+	// graphQLHandler answers the ID lookup and the transfer mutation, checking the variables of each.
+	graphQLHandler := func(lookupVariables map[string]interface{}, lookup, mutation interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Query     string                 `json:"query"`
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if strings.HasPrefix(body.Query, "mutation") {
+				assert.Equal(t, map[string]interface{}{"issueId": "I_42", "repositoryId": "R_target"}, body.Variables)
+				mockResponse(t, http.StatusOK, mutation)(w, r)
+				return
+			}
+			assert.Equal(t, lookupVariables, body.Variables)
+			mockResponse(t, http.StatusOK, lookup)(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "transfer to a repository of another owner",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(map[string]interface{}{
+						"owner":       "owner",
+						"repo":        "repo",
+						"number":      float64(42),
+						"targetOwner": "other",
+						"targetRepo":  "target",
+					}, mockIDs, mockTransferred),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "other/target",
+			},
+			expectError:  false,
+			expectedText: "Transferred owner/repo#42 to https://github.com/other/target/issues/7",
+		},
+		{
+			name: "transfer to a repository of the same owner",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(map[string]interface{}{
+						"owner":       "owner",
+						"repo":        "repo",
+						"number":      float64(42),
+						"targetOwner": "owner",
+						"targetRepo":  "target",
+					}, mockIDs, mockTransferred),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "target",
+			},
+			expectError:  false,
+			expectedText: "Transferred owner/repo#42 to https://github.com/other/target/issues/7",
+		},
+		{
+			name:         "invalid target repository",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "a/b/c",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid target_repo "a/b/c"`,
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockResponse(t, http.StatusOK, map[string]interface{}{
+						"data": map[string]interface{}{
+							"repository": map[string]interface{}{"issue": nil},
+							"target":     map[string]interface{}{"id": "R_target"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+				"target_repo":  "target",
+			},
+			expectError:    false,
+			expectedErrMsg: "issue not found: owner/repo#999",
+		},
+		{
+			name: "target repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockResponse(t, http.StatusOK, map[string]interface{}{
+						"data": map[string]interface{}{
+							"repository": map[string]interface{}{"issue": map[string]interface{}{"id": "I_42"}},
+							"target":     nil,
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "target repository not found: owner/missing",
+		},
+		{
+			name: "transfer rejected",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(map[string]interface{}{
+						"owner":       "owner",
+						"repo":        "repo",
+						"number":      float64(42),
+						"targetOwner": "other",
+						"targetRepo":  "target",
+					}, mockIDs, map[string]interface{}{
+						"data":   map[string]interface{}{"transferIssue": nil},
+						"errors": []map[string]interface{}{{"message": "Issues can only be transferred between repositories of the same owner"}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "other/target",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to transfer issue: graphql: Issues can only be transferred between repositories of the same owner",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := TransferIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// Outcomes of a tool call, as logged by withLogging.
+const (
+	outcomeSuccess     = "success"
+	outcomeError       = "error"
+	outcomeRateLimited = "rate_limited"
+)
+
+// callOutcome classifies the result of a tool call. Failures reported as tool results count
+// as errors, like the ones returned by the handler.
+func callOutcome(result *mcp.CallToolResult, err error) string {
+	var rateLimitErr *github.RateLimitError
+	var abuseRateLimitErr *github.AbuseRateLimitError
+	switch {
+	case errors.As(err, &rateLimitErr), errors.As(err, &abuseRateLimitErr):
+		return outcomeRateLimited
+	case err != nil, result != nil && result.IsError:
+		return outcomeError
+	default:
+		return outcomeSuccess
+	}
+}
+
+// sensitiveArguments lists, by tool, the arguments holding secrets that must never be logged.
+var sensitiveArguments = map[string][]string{
+	"create_actions_secret": {"value"},
+}
+
+// maxLoggedArgumentSize is the largest string argument logged as is. Larger ones, such as the
+// base64 content of an uploaded release asset, are logged as their size only.
+const maxLoggedArgumentSize = 1024
+
+// sanitizedArguments returns the arguments of a call of tool as JSON, without credentials, the
+// values of its sensitive arguments or the content of large arguments.
+func sanitizedArguments(tool string, arguments map[string]interface{}) string {
+	redacted := append([]string{capabilityTokenParam}, sensitiveArguments[tool]...)
+	sanitized := make(map[string]interface{}, len(arguments))
+	for k, v := range arguments {
+		if v != nil && slices.Contains(redacted, k) {
+			v = redactedSecret
+		} else if s, ok := v.(string); ok && len(s) > maxLoggedArgumentSize {
+			v = fmt.Sprintf("(%d bytes omitted)", len(s))
+		}
+		sanitized[k] = v
+	}
+	b, err := json.Marshal(sanitized)
+	if err != nil {
+		return "<unserializable>"
+	}
+	return redactSecrets(string(b))
+}
+
+// withLogging wraps a tool handler to log each call with its sanitized arguments, duration
+// and outcome.
+func withLogging(logger log.FieldLogger, name string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		start := time.Now()
+		result, err := handler(ctx, request)
+
+		outcome := callOutcome(result, err)
+		entry := logger.WithFields(log.Fields{
+			"tool":      name,
+			"arguments": sanitizedArguments(name, request.Params.Arguments),
+			"duration":  time.Since(start),
+			"outcome":   outcome,
+		})
+		if err != nil {
+			entry = entry.WithField("error", redactSecrets(err.Error()))
+		}
+		if outcome == outcomeSuccess {
+			entry.Info("tool call")
+		} else {
+			entry.Warn("tool call")
+		}
+
+		return result, err
+	}
+}
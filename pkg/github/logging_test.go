@@ -0,0 +1,205 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func Test_WithLogging(t *testing.T) {
+	mockUser := &github.User{Login: github.Ptr("octocat")}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectedOutcome   string
+		expectedLevel     log.Level
+		expectedArguments string
+		expectedErrMsg    string
+	}{
+		{
+			name: "successful call",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersByUsername,
+					mockUser,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectedOutcome:   outcomeSuccess,
+			expectedLevel:     log.InfoLevel,
+			expectedArguments: `{"username":"octocat"}`,
+		},
+		{
+			name:         "failure reported as a tool result",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"username":           "",
+				capabilityTokenParam: "1700000000.c2lnbmF0dXJl",
+			},
+			expectedOutcome:   outcomeError,
+			expectedLevel:     log.WarnLevel,
+			expectedArguments: `{"capability_token":"********","username":""}`,
+		},
+		{
+			name: "rate limited",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("X-RateLimit-Limit", "5000")
+						w.Header().Set("X-RateLimit-Remaining", "0")
+						w.Header().Set("X-RateLimit-Reset", "1700000000")
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "API rate limit exceeded for ` + testClassicToken + `"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectedOutcome:   outcomeRateLimited,
+			expectedLevel:     log.WarnLevel,
+			expectedArguments: `{"username":"octocat"}`,
+			expectedErrMsg:    "API rate limit exceeded for " + redactedSecret,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger, hook := test.NewNullLogger()
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			_, _ = withLogging(logger, "get_user", handler)(context.Background(), createMCPRequest(tc.requestArgs))
+
+			require.Len(t, hook.AllEntries(), 1)
+			entry := hook.LastEntry()
+			assert.Equal(t, tc.expectedLevel, entry.Level)
+			assert.Equal(t, "tool call", entry.Message)
+			assert.Equal(t, "get_user", entry.Data["tool"])
+			assert.Equal(t, tc.expectedOutcome, entry.Data["outcome"])
+			assert.Equal(t, tc.expectedArguments, entry.Data["arguments"])
+			assert.Contains(t, entry.Data, "duration")
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, entry.Data["error"], tc.expectedErrMsg)
+				assert.NotContains(t, entry.Data["error"], testClassicToken)
+			} else {
+				assert.NotContains(t, entry.Data, "error")
+			}
+		})
+	}
+}
+
+func Test_WithLogging_SensitiveArguments(t *testing.T) {
+	const secretValue = "s3cr3t-t0k3n"
+	publicKey, _, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposActionsSecretsPublicKeyByOwnerByRepo,
+			&github.PublicKey{
+				KeyID: github.Ptr("568250167242549743"),
+				Key:   github.Ptr(base64.StdEncoding.EncodeToString(publicKey[:])),
+			},
+		),
+		mock.WithRequestMatchHandler(
+			mock.PutReposActionsSecretsByOwnerByRepoBySecretName,
+			mockResponse(t, http.StatusCreated, nil),
+		),
+	))
+	_, handler := CreateActionsSecret(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	logger, hook := test.NewNullLogger()
+	_, err = withLogging(logger, "create_actions_secret", handler)(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"secret_name": "DEPLOY_TOKEN",
+		"value":       secretValue,
+	}))
+	require.NoError(t, err)
+
+	require.Len(t, hook.AllEntries(), 1)
+	entry := hook.LastEntry()
+	assert.Equal(t, outcomeSuccess, entry.Data["outcome"])
+	assert.Equal(t, `{"owner":"owner","repo":"repo","secret_name":"DEPLOY_TOKEN","value":"********"}`, entry.Data["arguments"])
+	for _, value := range entry.Data {
+		assert.NotContains(t, fmt.Sprint(value), secretValue)
+	}
+}
+
+func Test_SanitizedArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		tool      string
+		arguments map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "plain arguments",
+			tool:      "get_user",
+			arguments: map[string]interface{}{"username": "octocat"},
+			expected:  `{"username":"octocat"}`,
+		},
+		{
+			name:      "sensitive argument of another tool",
+			tool:      "create_issue",
+			arguments: map[string]interface{}{"title": "t", "value": "kept"},
+			expected:  `{"title":"t","value":"kept"}`,
+		},
+		{
+			name: "large argument",
+			tool: "upload_release_asset",
+			arguments: map[string]interface{}{
+				"name":    "app.zip",
+				"content": strings.Repeat("QUJD", 1000),
+			},
+			expected: `{"content":"(4000 bytes omitted)","name":"app.zip"}`,
+		},
+		{
+			name:      "argument at the size limit",
+			tool:      "create_issue",
+			arguments: map[string]interface{}{"body": strings.Repeat("a", maxLoggedArgumentSize)},
+			expected:  `{"body":"` + strings.Repeat("a", maxLoggedArgumentSize) + `"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sanitizedArguments(tc.tool, tc.arguments))
+		})
+	}
+}
+
+func Test_NewServer_Logger(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetUser,
+			&github.User{Login: github.Ptr("octocat")},
+		),
+	))
+	s := NewServer(stubGetClientFn(client), "test", ServerConfig{Logger: logger}, translations.NullTranslationHelper)
+
+	s.HandleMessage(context.Background(), []byte(`{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "get_me"}}`))
+
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, "get_me", hook.LastEntry().Data["tool"])
+	assert.Equal(t, outcomeSuccess, hook.LastEntry().Data["outcome"])
+}
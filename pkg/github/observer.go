@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ToolObserver receives measurements of the server's tool calls, e.g. to export them as
+// metrics. Implementations must be safe for concurrent use.
+type ToolObserver interface {
+	// ObserveCall is called after each tool call. err is non-nil if the call failed, whether
+	// the handler returned an error or an error result.
+	ObserveCall(name string, duration time.Duration, err error)
+
+	// ObserveRateLimit is called with the rate limit status of each GitHub API response that
+	// reports one.
+	ObserveRateLimit(rate github.Rate)
+}
+
+// NopToolObserver is a ToolObserver that ignores all measurements. Embed it to implement
+// only some of the ToolObserver methods.
+type NopToolObserver struct{}
+
+var _ ToolObserver = NopToolObserver{}
+
+func (NopToolObserver) ObserveCall(string, time.Duration, error) {}
+
+func (NopToolObserver) ObserveRateLimit(github.Rate) {}
+
+// withObserver wraps a tool handler to report each call to the observer.
+func withObserver(observer ToolObserver, name string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		start := time.Now()
+		result, err := handler(ctx, request)
+
+		callErr := err
+		if callErr == nil && result != nil && result.IsError {
+			callErr = errors.New(resultText(result))
+		}
+		observer.ObserveCall(name, time.Since(start), callErr)
+
+		return result, err
+	}
+}
+
+// resultText returns the text of the result's first text content.
+func resultText(result *mcp.CallToolResult) string {
+	for _, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			return text.Text
+		}
+	}
+	return ""
+}
+
+// observeRateLimits wraps getClient so that the clients it returns report the rate limit
+// status of their responses to the observer.
+func observeRateLimits(getClient GetClientFn, observer ToolObserver) GetClientFn {
+	return func(ctx context.Context) (*github.Client, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withTransport(client, func(transport http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				resp, err := transport.RoundTrip(req)
+				if resp != nil {
+					if rate, ok := parseRate(resp.Header); ok {
+						observer.ObserveRateLimit(rate)
+					}
+				}
+				return resp, err
+			})
+		}), nil
+	}
+}
+
+// parseRate reads the rate limit status from the X-RateLimit headers of a response.
+func parseRate(header http.Header) (github.Rate, bool) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return github.Rate{}, false
+	}
+	rate := github.Rate{
+		Remaining: remaining,
+		Resource:  header.Get("X-RateLimit-Resource"),
+	}
+	rate.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	rate.Used, _ = strconv.Atoi(header.Get("X-RateLimit-Used"))
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		rate.Reset = github.Timestamp{Time: time.Unix(reset, 0)}
+	}
+	return rate, true
+}
@@ -0,0 +1,144 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type observedCall struct {
+	name string
+	err  error
+}
+
+// recordingObserver records the calls and rate limits it observes.
+type recordingObserver struct {
+	mu    sync.Mutex
+	calls []observedCall
+	rates []github.Rate
+}
+
+func (o *recordingObserver) ObserveCall(name string, _ time.Duration, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.calls = append(o.calls, observedCall{name: name, err: err})
+}
+
+func (o *recordingObserver) ObserveRateLimit(rate github.Rate) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.rates = append(o.rates, rate)
+}
+
+func Test_WithObserver(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name: "successful call",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersByUsername,
+					&github.User{Login: github.Ptr("octocat")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+		},
+		{
+			name:           "failure reported as a tool result",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]interface{}{},
+			expectedErrMsg: "missing required parameter: username",
+		},
+		{
+			name: "failure returned by the handler",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersByUsername,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Server Error"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectedErrMsg: "failed to get user",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			observer := &recordingObserver{}
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			_, _ = withObserver(observer, "get_user", handler)(context.Background(), createMCPRequest(tc.requestArgs))
+
+			require.Len(t, observer.calls, 1)
+			assert.Equal(t, "get_user", observer.calls[0].name)
+			if tc.expectedErrMsg == "" {
+				assert.NoError(t, observer.calls[0].err)
+				return
+			}
+			require.Error(t, observer.calls[0].err)
+			assert.Contains(t, observer.calls[0].err.Error(), tc.expectedErrMsg)
+		})
+	}
+}
+
+func Test_ParseRate(t *testing.T) {
+	header := http.Header{}
+	_, ok := parseRate(header)
+	assert.False(t, ok)
+
+	header.Set("X-RateLimit-Limit", "5000")
+	header.Set("X-RateLimit-Remaining", "4321")
+	header.Set("X-RateLimit-Used", "679")
+	header.Set("X-RateLimit-Reset", "1700000000")
+	header.Set("X-RateLimit-Resource", "core")
+	rate, ok := parseRate(header)
+	require.True(t, ok)
+	assert.Equal(t, github.Rate{
+		Limit:     5000,
+		Remaining: 4321,
+		Used:      679,
+		Reset:     github.Timestamp{Time: time.Unix(1700000000, 0)},
+		Resource:  "core",
+	}, rate)
+}
+
+func Test_NewServer_Observer(t *testing.T) {
+	observer := &recordingObserver{}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetUser,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-RateLimit-Limit", "5000")
+				w.Header().Set("X-RateLimit-Remaining", "4999")
+				mockResponse(t, http.StatusOK, &github.User{Login: github.Ptr("octocat")})(w, r)
+			}),
+		),
+	))
+	s := NewServer(stubGetClientFn(client), "test", ServerConfig{Observer: observer}, translations.NullTranslationHelper)
+
+	s.HandleMessage(context.Background(), []byte(`{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": {"name": "get_me"}}`))
+
+	require.Len(t, observer.calls, 1)
+	assert.Equal(t, "get_me", observer.calls[0].name)
+	assert.NoError(t, observer.calls[0].err)
+	require.Len(t, observer.rates, 1)
+	assert.Equal(t, 5000, observer.rates[0].Limit)
+	assert.Equal(t, 4999, observer.rates[0].Remaining)
+}
@@ -0,0 +1,91 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// installedApp is the subset of a GitHub App installation returned by ListOrgInstalledApps.
+type installedApp struct {
+	ID                  int64                           `json:"id"`
+	AppID               int64                           `json:"app_id"`
+	AppSlug             string                          `json:"app_slug"`
+	RepositorySelection string                          `json:"repository_selection,omitempty"`
+	Permissions         *github.InstallationPermissions `json:"permissions,omitempty"`
+	Events              []string                        `json:"events,omitempty"`
+}
+
+// ListOrgInstalledApps creates a tool to list the GitHub Apps installed on an organization.
+func ListOrgInstalledApps(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_installed_apps",
+			mcp.WithDescription(t("TOOL_LIST_ORG_INSTALLED_APPS_DESCRIPTION", "List the GitHub Apps installed on an organization, with the permissions granted to each. Requires organization owner access")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			installations, resp, err := client.Organizations.ListInstallations(ctx, org, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list installed apps: listing the apps installed on %s requires organization owner access", org)), nil
+				}
+				return nil, fmt.Errorf("failed to list installed apps: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list installed apps: %s", string(body))), nil
+			}
+
+			apps := make([]installedApp, 0, len(installations.Installations))
+			for _, installation := range installations.Installations {
+				apps = append(apps, installedApp{
+					ID:                  installation.GetID(),
+					AppID:               installation.GetAppID(),
+					AppSlug:             installation.GetAppSlug(),
+					RepositorySelection: installation.GetRepositorySelection(),
+					Permissions:         installation.Permissions,
+					Events:              installation.Events,
+				})
+			}
+
+			r, err := json.Marshal(apps)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListOrgInstalledApps(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgInstalledApps(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_org_installed_apps", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	// Setup mock installations for success case
+	mockInstallations := &github.OrganizationInstallations{
+		TotalCount: github.Ptr(2),
+		Installations: []*github.Installation{
+			{
+				ID:                  github.Ptr(int64(1)),
+				AppID:               github.Ptr(int64(100)),
+				AppSlug:             github.Ptr("dependabot"),
+				RepositorySelection: github.Ptr("all"),
+				Permissions: &github.InstallationPermissions{
+					Contents:     github.Ptr("write"),
+					PullRequests: github.Ptr("write"),
+				},
+			},
+			{
+				ID:                  github.Ptr(int64(2)),
+				AppID:               github.Ptr(int64(200)),
+				AppSlug:             github.Ptr("ci-bot"),
+				RepositorySelection: github.Ptr("selected"),
+				Permissions: &github.InstallationPermissions{
+					Checks: github.Ptr("write"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedApps   []installedApp
+		expectedErrMsg string
+	}{
+		{
+			name: "successful installed apps listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsInstallationsByOrg,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockInstallations),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":     "octo-org",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError: false,
+			expectedApps: []installedApp{
+				{ID: 1, AppID: 100, AppSlug: "dependabot", RepositorySelection: "all", Permissions: mockInstallations.Installations[0].Permissions},
+				{ID: 2, AppID: 200, AppSlug: "ci-bot", RepositorySelection: "selected", Permissions: mockInstallations.Installations[1].Permissions},
+			},
+		},
+		{
+			name: "caller is not an organization owner",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsInstallationsByOrg,
+					mockResponse(t, http.StatusForbidden, `{"message": "Must be an organization owner"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+			},
+			expectError:    false,
+			expectedErrMsg: "requires organization owner access",
+		},
+		{
+			name: "organization not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsInstallationsByOrg,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "missing-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list installed apps",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListOrgInstalledApps(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedApps []installedApp
+			err = json.Unmarshal([]byte(textContent.Text), &returnedApps)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedApps, returnedApps)
+		})
+	}
+}
@@ -0,0 +1,640 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// listProjectsV2Query fetches a page of the projects of an organization or user. The %s verb
+// is the root field for the owner type, organization or user, which differ in GraphQL.
+const listProjectsV2Query = `query($owner: String!, $first: Int!, $after: String) {
+  %s(login: $owner) {
+    projectsV2(first: $first, after: $after) {
+      totalCount
+      pageInfo { hasNextPage endCursor }
+      nodes { id number title url closed }
+    }
+  }
+}`
+
+// projectOwnerTypes maps the owner_type parameter to the GraphQL root field of that owner.
+var projectOwnerTypes = map[string]string{
+	"org":  "organization",
+	"user": "user",
+}
+
+// projectV2 is a GitHub project (projects v2) of an organization or user.
+type projectV2 struct {
+	ID     string `json:"id"`
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+	Closed bool   `json:"closed"`
+}
+
+// projectsV2Page is a page of projects with the cursor to fetch the next one.
+type projectsV2Page struct {
+	TotalCount  int         `json:"total_count"`
+	HasNextPage bool        `json:"has_next_page"`
+	EndCursor   string      `json:"end_cursor,omitempty"`
+	Projects    []projectV2 `json:"projects"`
+}
+
+// ListProjectsV2 creates a tool to list the projects of an organization or user.
+func ListProjectsV2(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_projects_v2",
+			mcp.WithDescription(t("TOOL_LIST_PROJECTS_V2_DESCRIPTION", "List the projects (projects v2) of an organization or user, with their node ID, number, title and URL. Use the number with list_project_v2_items and the ID with add_project_v2_item")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Login of the organization or user owning the projects"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Whether the owner is an organization or a user"),
+				mcp.Enum("org", "user"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := requiredParam[string](request, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rootField, ok := projectOwnerTypes[ownerType]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid owner_type %q, must be one of: org, user", ownerType)), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			variables := map[string]interface{}{
+				"owner": owner,
+				"first": pagination.perPage,
+				"after": nil,
+			}
+			if pagination.after != "" {
+				variables["after"] = pagination.after
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := queryGraphQL(ctx, client, fmt.Sprintf(listProjectsV2Query, rootField), variables)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list projects: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list projects: %s", err.Error())), nil
+			}
+
+			var data map[string]*struct {
+				ProjectsV2 struct {
+					TotalCount int `json:"totalCount"`
+					PageInfo   struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []projectV2 `json:"nodes"`
+				} `json:"projectsV2"`
+			}
+			if err := json.Unmarshal(result.Data, &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal projects: %w", err)
+			}
+			if data[rootField] == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("%s not found: %s", rootField, owner)), nil
+			}
+
+			projects := data[rootField].ProjectsV2
+			page := projectsV2Page{
+				TotalCount:  projects.TotalCount,
+				HasNextPage: projects.PageInfo.HasNextPage,
+				EndCursor:   projects.PageInfo.EndCursor,
+				Projects:    projects.Nodes,
+			}
+			if page.Projects == nil {
+				page.Projects = []projectV2{}
+			}
+
+			r, err := json.Marshal(page)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// listProjectV2ItemsQuery fetches a page of the items of an organization or user project.
+// Issues, pull requests and draft issues expose the same fields, so each is selected the same way.
+const listProjectV2ItemsQuery = `query($owner: String!, $number: Int!, $first: Int!, $after: String) {
+  repositoryOwner(login: $owner) {
+    ... on ProjectV2Owner {
+      projectV2(number: $number) {
+        items(first: $first, after: $after) {
+          totalCount
+          pageInfo { hasNextPage endCursor }
+          nodes {
+            id
+            type
+            fieldValueByName(name: "Status") {
+              ... on ProjectV2ItemFieldSingleSelectValue { name }
+            }
+            content {
+              ... on Issue { title number url assignees(first: 10) { nodes { login } } }
+              ... on PullRequest { title number url assignees(first: 10) { nodes { login } } }
+              ... on DraftIssue { title assignees(first: 10) { nodes { login } } }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// projectV2Item is an item of a GitHub project, flattened from its GraphQL representation.
+type projectV2Item struct {
+	ID        string   `json:"id"`
+	Type      string   `json:"type"`
+	Title     string   `json:"title"`
+	Number    int      `json:"number,omitempty"`
+	URL       string   `json:"url,omitempty"`
+	Status    string   `json:"status,omitempty"`
+	Assignees []string `json:"assignees"`
+}
+
+// projectV2ItemsPage is a page of project items with the cursor to fetch the next one.
+type projectV2ItemsPage struct {
+	TotalCount  int             `json:"total_count"`
+	HasNextPage bool            `json:"has_next_page"`
+	EndCursor   string          `json:"end_cursor,omitempty"`
+	Items       []projectV2Item `json:"items"`
+}
+
+// ListProjectV2Items creates a tool to list the items of an organization or user project.
+func ListProjectV2Items(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_v2_items",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_V2_ITEMS_DESCRIPTION", "List the items of an organization or user project (projects v2), with their content type, title, status and assignees")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Login of the organization or user owning the project"),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("Project number, as shown in the project URL"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := RequiredInt(request, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			variables := map[string]interface{}{
+				"owner":  owner,
+				"number": number,
+				"first":  pagination.perPage,
+				"after":  nil,
+			}
+			if pagination.after != "" {
+				variables["after"] = pagination.after
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := queryGraphQL(ctx, client, listProjectV2ItemsQuery, variables)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list project items: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list project items: %s", err.Error())), nil
+			}
+
+			var data struct {
+				RepositoryOwner *struct {
+					ProjectV2 *struct {
+						Items struct {
+							TotalCount int `json:"totalCount"`
+							PageInfo   struct {
+								HasNextPage bool   `json:"hasNextPage"`
+								EndCursor   string `json:"endCursor"`
+							} `json:"pageInfo"`
+							Nodes []struct {
+								ID               string `json:"id"`
+								Type             string `json:"type"`
+								FieldValueByName *struct {
+									Name string `json:"name"`
+								} `json:"fieldValueByName"`
+								Content *struct {
+									Title     string `json:"title"`
+									Number    int    `json:"number"`
+									URL       string `json:"url"`
+									Assignees struct {
+										Nodes []struct {
+											Login string `json:"login"`
+										} `json:"nodes"`
+									} `json:"assignees"`
+								} `json:"content"`
+							} `json:"nodes"`
+						} `json:"items"`
+					} `json:"projectV2"`
+				} `json:"repositoryOwner"`
+			}
+			if err := json.Unmarshal(result.Data, &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal project items: %w", err)
+			}
+			if data.RepositoryOwner == nil || data.RepositoryOwner.ProjectV2 == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project not found: %s #%d", owner, number)), nil
+			}
+
+			items := data.RepositoryOwner.ProjectV2.Items
+			page := projectV2ItemsPage{
+				TotalCount:  items.TotalCount,
+				HasNextPage: items.PageInfo.HasNextPage,
+				EndCursor:   items.PageInfo.EndCursor,
+				Items:       make([]projectV2Item, 0, len(items.Nodes)),
+			}
+			for _, node := range items.Nodes {
+				item := projectV2Item{
+					ID:        node.ID,
+					Type:      strings.ToLower(node.Type),
+					Assignees: []string{},
+				}
+				if node.FieldValueByName != nil {
+					item.Status = node.FieldValueByName.Name
+				}
+				// Content is null for items the caller cannot see (type REDACTED)
+				if node.Content != nil {
+					item.Title = node.Content.Title
+					item.Number = node.Content.Number
+					item.URL = node.Content.URL
+					for _, assignee := range node.Content.Assignees.Nodes {
+						item.Assignees = append(item.Assignees, assignee.Login)
+					}
+				}
+				page.Items = append(page.Items, item)
+			}
+
+			r, err := json.Marshal(page)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// issueNodeIDQuery resolves the number of an issue or pull request to its node ID.
+const issueNodeIDQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issueOrPullRequest(number: $number) {
+      ... on Issue { id }
+      ... on PullRequest { id }
+    }
+  }
+}`
+
+// errIssueNotFound is returned by issueNodeID when the repository or the issue does not exist.
+var errIssueNotFound = errors.New("issue or pull request not found")
+
+// issueNodeID returns the node ID of an issue or pull request, as needed by GraphQL mutations.
+func issueNodeID(ctx context.Context, client *github.Client, owner, repo string, number int) (string, error) {
+	result, resp, err := queryGraphQL(ctx, client, issueNodeIDQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	})
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+
+	var data struct {
+		Repository *struct {
+			IssueOrPullRequest *struct {
+				ID string `json:"id"`
+			} `json:"issueOrPullRequest"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(result.Data, &data); err != nil {
+		return "", fmt.Errorf("failed to unmarshal issue: %w", err)
+	}
+	// A missing repository or issue is reported as a NOT_FOUND error with a null field.
+	if data.Repository == nil || data.Repository.IssueOrPullRequest == nil {
+		return "", fmt.Errorf("%w: %s/%s#%d", errIssueNotFound, owner, repo, number)
+	}
+	return data.Repository.IssueOrPullRequest.ID, nil
+}
+
+// addProjectV2ItemMutation adds an issue or pull request to a project. Adding content that is
+// already in the project returns the existing item.
+const addProjectV2ItemMutation = `mutation($projectId: ID!, $contentId: ID!) {
+  addProjectV2ItemById(input: {projectId: $projectId, contentId: $contentId}) {
+    item { id }
+  }
+}`
+
+// AddProjectV2Item creates a tool to add an issue or pull request to an organization or user project.
+func AddProjectV2Item(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_project_v2_item",
+			mcp.WithDescription(t("TOOL_ADD_PROJECT_V2_ITEM_DESCRIPTION", "Add an issue or pull request to an organization or user project (projects v2), returning the ID of the project item. Identify the content either by content_id or by owner, repo and issue_number")),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the project, as returned by list_projects_v2"),
+			),
+			mcp.WithString("content_id",
+				mcp.Description("Node ID of the issue or pull request to add"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Owner of the repository of the issue or pull request, instead of content_id"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository of the issue or pull request, instead of content_id"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Description("Number of the issue or pull request, instead of content_id"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			contentID, err := OptionalParam[string](request, "content_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := OptionalIntParam(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			byNumber := owner != "" || repo != "" || issueNumber != 0
+			switch {
+			case contentID != "" && byNumber:
+				return mcp.NewToolResultError("provide either content_id or owner, repo and issue_number, not both"), nil
+			case contentID == "" && (owner == "" || repo == "" || issueNumber == 0):
+				return mcp.NewToolResultError("provide content_id, or owner, repo and issue_number"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if contentID == "" {
+				contentID, err = issueNodeID(ctx, client, owner, repo, issueNumber)
+				if errors.Is(err, errIssueNotFound) {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if err != nil {
+					return nil, fmt.Errorf("failed to get issue: %w", err)
+				}
+			}
+
+			result, resp, err := queryGraphQL(ctx, client, addProjectV2ItemMutation, map[string]interface{}{
+				"projectId": projectID,
+				"contentId": contentID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add project item: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add project item: %s", err.Error())), nil
+			}
+
+			var data struct {
+				AddProjectV2ItemByID *struct {
+					Item struct {
+						ID string `json:"id"`
+					} `json:"item"`
+				} `json:"addProjectV2ItemById"`
+			}
+			if err := json.Unmarshal(result.Data, &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal project item: %w", err)
+			}
+			if data.AddProjectV2ItemByID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project not found: %s", projectID)), nil
+			}
+
+			r, err := json.Marshal(map[string]string{"item_id": data.AddProjectV2ItemByID.Item.ID})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// projectV2FieldQuery fetches the type of a project field and, for single select fields, its options.
+const projectV2FieldQuery = `query($fieldId: ID!) {
+  node(id: $fieldId) {
+    ... on ProjectV2Field { name dataType }
+    ... on ProjectV2SingleSelectField { name dataType options { id name } }
+    ... on ProjectV2IterationField { name dataType }
+  }
+}`
+
+// updateProjectV2ItemFieldValueMutation sets the value of a field of a project item.
+const updateProjectV2ItemFieldValueMutation = `mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $value: ProjectV2FieldValue!) {
+  updateProjectV2ItemFieldValue(input: {projectId: $projectId, itemId: $itemId, fieldId: $fieldId, value: $value}) {
+    projectV2Item { id }
+  }
+}`
+
+// projectV2Field is a field of a project, with the options of single select fields.
+type projectV2Field struct {
+	Name     string                 `json:"name"`
+	DataType string                 `json:"dataType"`
+	Options  []projectV2FieldOption `json:"options"`
+}
+
+type projectV2FieldOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// projectV2FieldValue converts value to the ProjectV2FieldValue input for the type of field.
+// Single select values can be given as the name of an option or its ID.
+func projectV2FieldValue(field projectV2Field, value string) (map[string]interface{}, error) {
+	switch field.DataType {
+	case "TEXT":
+		return map[string]interface{}{"text": value}, nil
+	case "NUMBER":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %s is a number field, got %q", field.Name, value)
+		}
+		return map[string]interface{}{"number": n}, nil
+	case "DATE":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return nil, fmt.Errorf("field %s is a date field, expected YYYY-MM-DD, got %q", field.Name, value)
+		}
+		return map[string]interface{}{"date": value}, nil
+	case "SINGLE_SELECT":
+		names := make([]string, 0, len(field.Options))
+		for _, o := range field.Options {
+			if o.ID == value || strings.EqualFold(o.Name, value) {
+				return map[string]interface{}{"singleSelectOptionId": o.ID}, nil
+			}
+			names = append(names, o.Name)
+		}
+		return nil, fmt.Errorf("field %s has no option %q, must be one of: %s", field.Name, value, strings.Join(names, ", "))
+	default:
+		return nil, fmt.Errorf("field %s of type %s cannot be set, only text, number, date and single select fields can", field.Name, strings.ToLower(field.DataType))
+	}
+}
+
+// UpdateProjectV2ItemFieldValue creates a tool to set a field of an item of an organization or user project.
+func UpdateProjectV2ItemFieldValue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_v2_item_field_value",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_V2_ITEM_FIELD_VALUE_DESCRIPTION", "Set a text, number, date or single select field of a project item (projects v2), e.g. to move it to another Status column")),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the project, as returned by list_projects_v2"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the project item, as returned by list_project_v2_items or add_project_v2_item"),
+			),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the field to set"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("New value: text, a number, a date as YYYY-MM-DD, or the name or ID of a single select option, depending on the field"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectID, err := requiredParam[string](request, "project_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := requiredParam[string](request, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldID, err := requiredParam[string](request, "field_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			value, err := requiredParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The shape of the value depends on the type of the field, so look the field up first.
+			fieldResult, resp, err := queryGraphQL(ctx, client, projectV2FieldQuery, map[string]interface{}{
+				"fieldId": fieldID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get project field: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			if err := fieldResult.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get project field: %s", err.Error())), nil
+			}
+			var fieldData struct {
+				Node *projectV2Field `json:"node"`
+			}
+			if err := json.Unmarshal(fieldResult.Data, &fieldData); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal project field: %w", err)
+			}
+			// Nodes that are not project fields match none of the fragments and come back empty.
+			if fieldData.Node == nil || fieldData.Node.DataType == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field not found: %s", fieldID)), nil
+			}
+
+			fieldValue, err := projectV2FieldValue(*fieldData.Node, value)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result, resp, err := queryGraphQL(ctx, client, updateProjectV2ItemFieldValueMutation, map[string]interface{}{
+				"projectId": projectID,
+				"itemId":    itemID,
+				"fieldId":   fieldID,
+				"value":     fieldValue,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to update project item: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update project item: %s", err.Error())), nil
+			}
+
+			var data struct {
+				UpdateProjectV2ItemFieldValue *struct {
+					ProjectV2Item struct {
+						ID string `json:"id"`
+					} `json:"projectV2Item"`
+				} `json:"updateProjectV2ItemFieldValue"`
+			}
+			if err := json.Unmarshal(result.Data, &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal project item: %w", err)
+			}
+			if data.UpdateProjectV2ItemFieldValue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project item not found: %s", itemID)), nil
+			}
+
+			r, err := json.Marshal(map[string]string{
+				"item_id": data.UpdateProjectV2ItemFieldValue.ProjectV2Item.ID,
+				"field":   fieldData.Node.Name,
+				"value":   value,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
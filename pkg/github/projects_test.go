@@ -0,0 +1,830 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListProjectsV2(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListProjectsV2(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_projects_v2", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "owner_type")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "owner_type"})
+
+	mockProjects := func(root string) map[string]interface{} {
+		return map[string]interface{}{
+			"data": map[string]interface{}{
+				root: map[string]interface{}{
+					"projectsV2": map[string]interface{}{
+						"totalCount": 2,
+						"pageInfo":   map[string]interface{}{"hasNextPage": false, "endCursor": "Y3Vyc29yOjI="},
+						"nodes": []map[string]interface{}{
+							{"id": "PVT_1", "number": 1, "title": "Roadmap", "url": "https://github.com/orgs/octo-org/projects/1", "closed": false},
+							{"id": "PVT_2", "number": 2, "title": "Old board", "url": "https://github.com/orgs/octo-org/projects/2", "closed": true},
+						},
+					},
+				},
+			},
+		}
+	}
+	expectedPage := projectsV2Page{
+		TotalCount: 2,
+		EndCursor:  "Y3Vyc29yOjI=",
+		Projects: []projectV2{
+			{ID: "PVT_1", Number: 1, Title: "Roadmap", URL: "https://github.com/orgs/octo-org/projects/1"},
+			{ID: "PVT_2", Number: 2, Title: "Old board", URL: "https://github.com/orgs/octo-org/projects/2", Closed: true},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedPage   projectsV2Page
+		expectedErrMsg string
+	}{
+		{
+			name: "organization projects",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					expectRequestBody(t, map[string]interface{}{
+						"query": fmt.Sprintf(listProjectsV2Query, "organization"),
+						"variables": map[string]interface{}{
+							"owner": "octo-org",
+							"first": float64(30),
+							"after": nil,
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockProjects("organization")),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"owner_type": "org",
+			},
+			expectError:  false,
+			expectedPage: expectedPage,
+		},
+		{
+			name: "user projects",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					expectRequestBody(t, map[string]interface{}{
+						"query": fmt.Sprintf(listProjectsV2Query, "user"),
+						"variables": map[string]interface{}{
+							"owner": "octocat",
+							"first": float64(10),
+							"after": "Y3Vyc29yOjA=",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockProjects("user")),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octocat",
+				"owner_type": "user",
+				"perPage":    float64(10),
+				"after":      "Y3Vyc29yOjA=",
+			},
+			expectError:  false,
+			expectedPage: expectedPage,
+		},
+		{
+			name: "owner not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data": map[string]interface{}{"organization": nil},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "missing",
+				"owner_type": "org",
+			},
+			expectError:    false,
+			expectedErrMsg: "organization not found: missing",
+		},
+		{
+			name: "graphql errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data":   map[string]interface{}{"user": nil},
+						"errors": []map[string]interface{}{{"type": "NOT_FOUND", "message": "Could not resolve to a User with the login of 'missing'."}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "missing",
+				"owner_type": "user",
+			},
+			expectError:    false,
+			expectedErrMsg: "Could not resolve to a User with the login of 'missing'.",
+		},
+		{
+			name:         "invalid owner type",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"owner_type": "enterprise",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid owner_type "enterprise", must be one of: org, user`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListProjectsV2(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedPage projectsV2Page
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPage)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPage, returnedPage)
+		})
+	}
+}
+
+func Test_ListProjectV2Items(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListProjectV2Items(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_project_v2_items", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "project_number")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "project_number"})
+
+	mockItems := map[string]interface{}{
+		"data": map[string]interface{}{
+			"repositoryOwner": map[string]interface{}{
+				"projectV2": map[string]interface{}{
+					"items": map[string]interface{}{
+						"totalCount": 3,
+						"pageInfo":   map[string]interface{}{"hasNextPage": true, "endCursor": "Y3Vyc29yOjM="},
+						"nodes": []map[string]interface{}{
+							{
+								"id":               "PVTI_1",
+								"type":             "ISSUE",
+								"fieldValueByName": map[string]interface{}{"name": "In Progress"},
+								"content": map[string]interface{}{
+									"title":     "Fix login",
+									"number":    12,
+									"url":       "https://github.com/octo-org/app/issues/12",
+									"assignees": map[string]interface{}{"nodes": []map[string]interface{}{{"login": "octocat"}}},
+								},
+							},
+							{
+								"id":               "PVTI_2",
+								"type":             "PULL_REQUEST",
+								"fieldValueByName": nil,
+								"content": map[string]interface{}{
+									"title":     "Add SSO",
+									"number":    13,
+									"url":       "https://github.com/octo-org/app/pull/13",
+									"assignees": map[string]interface{}{"nodes": []map[string]interface{}{}},
+								},
+							},
+							{
+								"id":               "PVTI_3",
+								"type":             "DRAFT_ISSUE",
+								"fieldValueByName": map[string]interface{}{"name": "Todo"},
+								"content": map[string]interface{}{
+									"title":     "Write docs",
+									"assignees": map[string]interface{}{"nodes": []map[string]interface{}{{"login": "hubot"}}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedPage   projectV2ItemsPage
+		expectedErrMsg string
+	}{
+		{
+			name: "successful items listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					expectRequestBody(t, map[string]interface{}{
+						"query": listProjectV2ItemsQuery,
+						"variables": map[string]interface{}{
+							"owner":  "octo-org",
+							"number": float64(5),
+							"first":  float64(3),
+							"after":  "Y3Vyc29yOjA=",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockItems),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"project_number": float64(5),
+				"perPage":        float64(3),
+				"after":          "Y3Vyc29yOjA=",
+			},
+			expectError: false,
+			expectedPage: projectV2ItemsPage{
+				TotalCount:  3,
+				HasNextPage: true,
+				EndCursor:   "Y3Vyc29yOjM=",
+				Items: []projectV2Item{
+					{ID: "PVTI_1", Type: "issue", Title: "Fix login", Number: 12, URL: "https://github.com/octo-org/app/issues/12", Status: "In Progress", Assignees: []string{"octocat"}},
+					{ID: "PVTI_2", Type: "pull_request", Title: "Add SSO", Number: 13, URL: "https://github.com/octo-org/app/pull/13", Assignees: []string{}},
+					{ID: "PVTI_3", Type: "draft_issue", Title: "Write docs", Status: "Todo", Assignees: []string{"hubot"}},
+				},
+			},
+		},
+		{
+			name: "project not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data": map[string]interface{}{
+							"repositoryOwner": map[string]interface{}{"projectV2": nil},
+						},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"project_number": float64(99),
+			},
+			expectError:    false,
+			expectedErrMsg: "project not found: octo-org #99",
+		},
+		{
+			name: "graphql errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data":   map[string]interface{}{"repositoryOwner": nil},
+						"errors": []map[string]interface{}{{"type": "NOT_FOUND", "message": "Could not resolve to a ProjectV2 with the number 99."}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"project_number": float64(99),
+			},
+			expectError:    false,
+			expectedErrMsg: "Could not resolve to a ProjectV2 with the number 99.",
+		},
+		{
+			name:         "perPage out of range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"project_number": float64(5),
+				"perPage":        float64(500),
+			},
+			expectError:    false,
+			expectedErrMsg: "perPage must be between 1 and 100",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListProjectV2Items(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedPage projectV2ItemsPage
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPage)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPage, returnedPage)
+		})
+	}
+}
+
+func Test_AddProjectV2Item(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddProjectV2Item(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_project_v2_item", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "project_id")
+	assert.Contains(t, tool.InputSchema.Properties, "content_id")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"project_id"})
+
+	addedItem := map[string]interface{}{
+		"data": map[string]interface{}{
+			"addProjectV2ItemById": map[string]interface{}{
+				"item": map[string]interface{}{"id": "PVTI_1"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedItemID string
+		expectedErrMsg string
+	}{
+		{
+			name: "add by content id",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					expectRequestBody(t, map[string]interface{}{
+						"query": addProjectV2ItemMutation,
+						"variables": map[string]interface{}{
+							"projectId": "PVT_1",
+							"contentId": "I_1",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, addedItem),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"project_id": "PVT_1",
+				"content_id": "I_1",
+			},
+			expectError:    false,
+			expectedItemID: "PVTI_1",
+		},
+		{
+			name: "add by issue number",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						var body struct {
+							Query     string                 `json:"query"`
+							Variables map[string]interface{} `json:"variables"`
+						}
+						require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+						switch body.Query {
+						case issueNodeIDQuery:
+							assert.Equal(t, map[string]interface{}{"owner": "octo-org", "repo": "app", "number": float64(12)}, body.Variables)
+							mockResponse(t, http.StatusOK, map[string]interface{}{
+								"data": map[string]interface{}{
+									"repository": map[string]interface{}{
+										"issueOrPullRequest": map[string]interface{}{"id": "PR_12"},
+									},
+								},
+							})(w, r)
+						case addProjectV2ItemMutation:
+							assert.Equal(t, map[string]interface{}{"projectId": "PVT_1", "contentId": "PR_12"}, body.Variables)
+							mockResponse(t, http.StatusOK, addedItem)(w, r)
+						default:
+							t.Errorf("unexpected query: %s", body.Query)
+						}
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"project_id":   "PVT_1",
+				"owner":        "octo-org",
+				"repo":         "app",
+				"issue_number": float64(12),
+			},
+			expectError:    false,
+			expectedItemID: "PVTI_1",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data": map[string]interface{}{
+							"repository": map[string]interface{}{"issueOrPullRequest": nil},
+						},
+						"errors": []map[string]interface{}{{"type": "NOT_FOUND", "message": "Could not resolve to an issue or pull request with the number of 99."}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"project_id":   "PVT_1",
+				"owner":        "octo-org",
+				"repo":         "app",
+				"issue_number": float64(99),
+			},
+			expectError:    false,
+			expectedErrMsg: "issue or pull request not found: octo-org/app#99",
+		},
+		{
+			name: "mutation errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data":   map[string]interface{}{"addProjectV2ItemById": nil},
+						"errors": []map[string]interface{}{{"type": "NOT_FOUND", "message": "Could not resolve to a node with the global id of 'PVT_x'"}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"project_id": "PVT_x",
+				"content_id": "I_1",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to add project item: graphql: Could not resolve to a node with the global id of 'PVT_x'",
+		},
+		{
+			name:         "content id and issue number",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"project_id":   "PVT_1",
+				"content_id":   "I_1",
+				"issue_number": float64(12),
+			},
+			expectError:    false,
+			expectedErrMsg: "provide either content_id or owner, repo and issue_number, not both",
+		},
+		{
+			name:         "incomplete issue reference",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"project_id":   "PVT_1",
+				"owner":        "octo-org",
+				"issue_number": float64(12),
+			},
+			expectError:    false,
+			expectedErrMsg: "provide content_id, or owner, repo and issue_number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddProjectV2Item(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned map[string]string
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedItemID, returned["item_id"])
+		})
+	}
+}
+
+func Test_ProjectV2FieldValue(t *testing.T) {
+	status := projectV2Field{
+		Name:     "Status",
+		DataType: "SINGLE_SELECT",
+		Options: []projectV2FieldOption{
+			{ID: "opt_todo", Name: "Todo"},
+			{ID: "opt_done", Name: "Done"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		field          projectV2Field
+		value          string
+		expected       map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:     "text",
+			field:    projectV2Field{Name: "Notes", DataType: "TEXT"},
+			value:    "Blocked on review",
+			expected: map[string]interface{}{"text": "Blocked on review"},
+		},
+		{
+			name:     "number",
+			field:    projectV2Field{Name: "Estimate", DataType: "NUMBER"},
+			value:    "2.5",
+			expected: map[string]interface{}{"number": 2.5},
+		},
+		{
+			name:           "invalid number",
+			field:          projectV2Field{Name: "Estimate", DataType: "NUMBER"},
+			value:          "large",
+			expectedErrMsg: `field Estimate is a number field, got "large"`,
+		},
+		{
+			name:     "date",
+			field:    projectV2Field{Name: "Due", DataType: "DATE"},
+			value:    "2025-06-30",
+			expected: map[string]interface{}{"date": "2025-06-30"},
+		},
+		{
+			name:           "invalid date",
+			field:          projectV2Field{Name: "Due", DataType: "DATE"},
+			value:          "30/06/2025",
+			expectedErrMsg: `field Due is a date field, expected YYYY-MM-DD, got "30/06/2025"`,
+		},
+		{
+			name:     "single select by name",
+			field:    status,
+			value:    "done",
+			expected: map[string]interface{}{"singleSelectOptionId": "opt_done"},
+		},
+		{
+			name:     "single select by id",
+			field:    status,
+			value:    "opt_todo",
+			expected: map[string]interface{}{"singleSelectOptionId": "opt_todo"},
+		},
+		{
+			name:           "unknown option",
+			field:          status,
+			value:          "In Review",
+			expectedErrMsg: `field Status has no option "In Review", must be one of: Todo, Done`,
+		},
+		{
+			name:           "unsupported type",
+			field:          projectV2Field{Name: "Assignees", DataType: "ASSIGNEES"},
+			value:          "octocat",
+			expectedErrMsg: "field Assignees of type assignees cannot be set, only text, number, date and single select fields can",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := projectV2FieldValue(tc.field, tc.value)
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedErrMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func Test_UpdateProjectV2ItemFieldValue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateProjectV2ItemFieldValue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_project_v2_item_field_value", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "project_id")
+	assert.Contains(t, tool.InputSchema.Properties, "item_id")
+	assert.Contains(t, tool.InputSchema.Properties, "field_id")
+	assert.Contains(t, tool.InputSchema.Properties, "value")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"project_id", "item_id", "field_id", "value"})
+
+	statusField := map[string]interface{}{
+		"data": map[string]interface{}{
+			"node": map[string]interface{}{
+				"name":     "Status",
+				"dataType": "SINGLE_SELECT",
+				"options": []map[string]interface{}{
+					{"id": "opt_todo", "name": "Todo"},
+					{"id": "opt_done", "name": "Done"},
+				},
+			},
+		},
+	}
+	// graphQLHandler answers the field lookup with field and the mutation with mutationResponse,
+	// checking the value the mutation is sent.
+	graphQLHandler := func(field, mutationResponse interface{}, expectedValue map[string]interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Query     string                 `json:"query"`
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			switch body.Query {
+			case projectV2FieldQuery:
+				assert.Equal(t, map[string]interface{}{"fieldId": "PVTSSF_1"}, body.Variables)
+				mockResponse(t, http.StatusOK, field)(w, r)
+			case updateProjectV2ItemFieldValueMutation:
+				assert.Equal(t, map[string]interface{}{
+					"projectId": "PVT_1",
+					"itemId":    "PVTI_1",
+					"fieldId":   "PVTSSF_1",
+					"value":     expectedValue,
+				}, body.Variables)
+				mockResponse(t, http.StatusOK, mutationResponse)(w, r)
+			default:
+				t.Errorf("unexpected query: %s", body.Query)
+			}
+		}
+	}
+	updatedItem := map[string]interface{}{
+		"data": map[string]interface{}{
+			"updateProjectV2ItemFieldValue": map[string]interface{}{
+				"projectV2Item": map[string]interface{}{"id": "PVTI_1"},
+			},
+		},
+	}
+	requestArgs := func(value string) map[string]interface{} {
+		return map[string]interface{}{
+			"project_id": "PVT_1",
+			"item_id":    "PVTI_1",
+			"field_id":   "PVTSSF_1",
+			"value":      value,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult map[string]string
+		expectedErrMsg string
+	}{
+		{
+			name: "move to another status",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(statusField, updatedItem, map[string]interface{}{"singleSelectOptionId": "opt_done"}),
+				),
+			),
+			requestArgs:    requestArgs("Done"),
+			expectError:    false,
+			expectedResult: map[string]string{"item_id": "PVTI_1", "field": "Status", "value": "Done"},
+		},
+		{
+			name: "unknown option",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(statusField, nil, nil),
+				),
+			),
+			requestArgs:    requestArgs("Blocked"),
+			expectError:    false,
+			expectedErrMsg: `field Status has no option "Blocked", must be one of: Todo, Done`,
+		},
+		{
+			name: "field not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(map[string]interface{}{"data": map[string]interface{}{"node": map[string]interface{}{}}}, nil, nil),
+				),
+			),
+			requestArgs:    requestArgs("Done"),
+			expectError:    false,
+			expectedErrMsg: "project field not found: PVTSSF_1",
+		},
+		{
+			name: "mutation errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(statusField, map[string]interface{}{
+						"data":   map[string]interface{}{"updateProjectV2ItemFieldValue": nil},
+						"errors": []map[string]interface{}{{"message": "The item does not exist in the project"}},
+					}, map[string]interface{}{"singleSelectOptionId": "opt_todo"}),
+				),
+			),
+			requestArgs:    requestArgs("Todo"),
+			expectError:    false,
+			expectedErrMsg: "failed to update project item: graphql: The item does not exist in the project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateProjectV2ItemFieldValue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned map[string]string
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -3,9 +3,16 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -13,6 +20,105 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// pullRequestIncludes are the related lists get_pull_request can fetch along with a pull request.
+var pullRequestIncludes = []string{"files", "reviews", "comments", "commits"}
+
+// maxPullRequestIncludeItems caps each included list to a single page of results.
+const maxPullRequestIncludeItems = 100
+
+// pullRequestWithIncludes is a pull request with the related lists requested through include.
+// They are kept under their own key as the pull request already has comments and commits counts.
+type pullRequestWithIncludes struct {
+	*github.PullRequest
+	Included map[string]any `json:"included"`
+}
+
+// pullRequestIncluded holds the related lists fetched for a pull request. Only the lists
+// named in include are set; the others stay nil.
+type pullRequestIncluded struct {
+	include  []string
+	files    []*github.CommitFile
+	reviews  []*github.PullRequestReview
+	comments []*github.PullRequestComment
+	commits  []pullRequestCommit
+}
+
+// byName returns the requested lists keyed by their include name, with empty lists as [].
+func (i pullRequestIncluded) byName() map[string]any {
+	result := make(map[string]any, len(i.include))
+	for _, name := range i.include {
+		switch name {
+		case "files":
+			result[name] = nonNil(i.files)
+		case "reviews":
+			result[name] = nonNil(i.reviews)
+		case "comments":
+			result[name] = nonNil(i.comments)
+		case "commits":
+			result[name] = nonNil(i.commits)
+		}
+	}
+	return result
+}
+
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+// fetchPullRequestIncludes fetches the lists named in include concurrently, failing if any of them fails.
+func fetchPullRequestIncludes(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, include []string) (pullRequestIncluded, error) {
+	included := pullRequestIncluded{}
+	opts := &github.ListOptions{PerPage: maxPullRequestIncludeItems}
+
+	fetches := map[string]func() (*github.Response, error){
+		"files": func() (resp *github.Response, err error) {
+			included.files, resp, err = client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+			return resp, err
+		},
+		"reviews": func() (resp *github.Response, err error) {
+			included.reviews, resp, err = client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, opts)
+			return resp, err
+		},
+		"comments": func() (resp *github.Response, err error) {
+			included.comments, resp, err = client.PullRequests.ListComments(ctx, owner, repo, pullNumber, &github.PullRequestListCommentsOptions{ListOptions: *opts})
+			return resp, err
+		},
+		"commits": func() (*github.Response, error) {
+			commits, resp, err := client.PullRequests.ListCommits(ctx, owner, repo, pullNumber, opts)
+			for _, c := range commits {
+				included.commits = append(included.commits, newPullRequestCommit(c))
+			}
+			return resp, err
+		},
+	}
+
+	for _, name := range include {
+		if !slices.Contains(included.include, name) {
+			included.include = append(included.include, name)
+		}
+	}
+
+	// Each fetch sets its own field of included, so they can all run at once
+	_, errs := fanOut(ctx, included.include, len(included.include), func(_ context.Context, name string) (struct{}, error) {
+		resp, err := fetches[name]()
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err != nil {
+			return struct{}{}, fmt.Errorf("failed to get pull request %s: %w", name, err)
+		}
+		return struct{}{}, nil
+	})
+
+	if err := errors.Join(errs...); err != nil {
+		return pullRequestIncluded{}, err
+	}
+	return included, nil
+}
+
 // GetPullRequest creates a tool to get details of a specific pull request.
 func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request",
@@ -29,6 +135,16 @@ func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc)
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithArray("include",
+				mcp.Description("Related data to fetch along with the pull request: files, reviews, comments (review comments) and commits. Each list holds at most 100 items"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+						"enum": pullRequestIncludes,
+					},
+				),
+			),
+			WithResponseFormat(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -43,6 +159,19 @@ func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			include, err := OptionalStringArrayParam(request, "include")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			for _, name := range include {
+				if !slices.Contains(pullRequestIncludes, name) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid include %q, must be one of: %s", name, strings.Join(pullRequestIncludes, ", "))), nil
+				}
+			}
+			format, err := OptionalResponseFormat(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -62,12 +191,18 @@ func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(pr)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			if len(include) == 0 {
+				return formattedResult(format, pr, func() string { return pullRequestMarkdown(pr) })
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			included, err := fetchPullRequestIncludes(ctx, client, owner, repo, pullNumber, include)
+			if err != nil {
+				return nil, err
+			}
+			result := pullRequestWithIncludes{PullRequest: pr, Included: included.byName()}
+			return formattedResult(format, result, func() string {
+				return pullRequestMarkdown(pr) + pullRequestIncludesMarkdown(included)
+			})
 		}
 }
 
@@ -216,6 +351,7 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description("Sort direction ('asc', 'desc')"),
 			),
 			WithPagination(),
+			WithResponseFormat(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -250,6 +386,10 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			format, err := OptionalResponseFormat(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			opts := &github.PullRequestListOptions{
 				State:     state,
@@ -281,12 +421,7 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(prs)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return formattedResult(format, prs, func() string { return pullRequestsMarkdown(prs) })
 		}
 }
 
@@ -315,6 +450,9 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			mcp.WithString("merge_method",
 				mcp.Description("Merge method ('merge', 'squash', 'rebase')"),
 			),
+			mcp.WithBoolean("enable_auto_merge",
+				mcp.Description("When the pull request can't be merged yet, e.g. because checks are pending, enable auto-merge so that GitHub merges it once its requirements are met"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -341,6 +479,13 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			enableAutoMerge, err := OptionalParam[bool](request, "enable_auto_merge")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if mergeMethod != "" && !slices.Contains(pullRequestMergeMethods, mergeMethod) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid merge_method %q, must be one of: %s", mergeMethod, strings.Join(pullRequestMergeMethods, ", "))), nil
+			}
 
 			options := &github.PullRequestOptions{
 				CommitTitle: commitTitle,
@@ -353,6 +498,10 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			}
 			result, resp, err := client.PullRequests.Merge(ctx, owner, repo, pullNumber, commitMessage, options)
 			if err != nil {
+				// GitHub answers 405 for pull requests that are not mergeable yet
+				if enableAutoMerge && resp != nil && resp.StatusCode == http.StatusMethodNotAllowed {
+					return enableAutoMergeResult(ctx, client, owner, repo, pullNumber, mergeMethod, commitTitle, commitMessage)
+				}
 				return nil, fmt.Errorf("failed to merge pull request: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -365,7 +514,16 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to merge pull request: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			var merged any = result
+			if enableAutoMerge {
+				merged = mergePullRequestResult{
+					Merged:  result.GetMerged(),
+					SHA:     result.GetSHA(),
+					Message: result.GetMessage(),
+				}
+			}
+
+			r, err := json.Marshal(merged)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -374,10 +532,115 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
-// GetPullRequestFiles creates a tool to get the list of files changed in a pull request.
-func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_pull_request_files",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_FILES_DESCRIPTION", "Get the list of files changed in a pull request")),
+// pullRequestMergeMethods are the ways a pull request can be merged.
+var pullRequestMergeMethods = []string{"merge", "squash", "rebase"}
+
+// mergePullRequestResult is the result of merge_pull_request with enable_auto_merge: either
+// the pull request was merged right away, or auto-merge was enabled and GitHub merges it
+// once its requirements are met.
+type mergePullRequestResult struct {
+	Merged           bool              `json:"merged"`
+	SHA              string            `json:"sha,omitempty"`
+	Message          string            `json:"message,omitempty"`
+	AutoMergeEnabled bool              `json:"auto_merge_enabled"`
+	MergeMethod      string            `json:"merge_method,omitempty"`
+	EnabledAt        *github.Timestamp `json:"enabled_at,omitempty"`
+}
+
+// enablePullRequestAutoMergeMutation enables auto-merge on a pull request. REST has no
+// endpoint for it. A nil merge method uses the default of the repository.
+const enablePullRequestAutoMergeMutation = `mutation($pullRequestId: ID!, $mergeMethod: PullRequestMergeMethod, $commitHeadline: String, $commitBody: String) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $pullRequestId, mergeMethod: $mergeMethod, commitHeadline: $commitHeadline, commitBody: $commitBody}) {
+    pullRequest { autoMergeRequest { mergeMethod enabledAt } }
+  }
+}`
+
+// enableAutoMergeResult enables auto-merge on a pull request that can't be merged yet.
+func enableAutoMergeResult(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, mergeMethod, commitTitle, commitMessage string) (*mcp.CallToolResult, error) {
+	pullRequestID, err := issueNodeID(ctx, client, owner, repo, pullNumber)
+	if errors.Is(err, errIssueNotFound) {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	variables := map[string]interface{}{
+		"pullRequestId":  pullRequestID,
+		"mergeMethod":    nil,
+		"commitHeadline": nil,
+		"commitBody":     nil,
+	}
+	if mergeMethod != "" {
+		variables["mergeMethod"] = strings.ToUpper(mergeMethod)
+	}
+	if commitTitle != "" {
+		variables["commitHeadline"] = commitTitle
+	}
+	if commitMessage != "" {
+		variables["commitBody"] = commitMessage
+	}
+
+	result, resp, err := queryGraphQL(ctx, client, enablePullRequestAutoMergeMutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable auto-merge: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// e.g. auto-merge is not allowed in the repository, or the pull request has conflicts
+	if err := result.err(); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("pull request can't be merged yet, and enabling auto-merge failed: %s", err.Error())), nil
+	}
+
+	var data struct {
+		EnablePullRequestAutoMerge *struct {
+			PullRequest *struct {
+				AutoMergeRequest *struct {
+					MergeMethod string            `json:"mergeMethod"`
+					EnabledAt   *github.Timestamp `json:"enabledAt"`
+				} `json:"autoMergeRequest"`
+			} `json:"pullRequest"`
+		} `json:"enablePullRequestAutoMerge"`
+	}
+	if err := json.Unmarshal(result.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal auto-merge request: %w", err)
+	}
+	if data.EnablePullRequestAutoMerge == nil || data.EnablePullRequestAutoMerge.PullRequest == nil ||
+		data.EnablePullRequestAutoMerge.PullRequest.AutoMergeRequest == nil {
+		return mcp.NewToolResultError("pull request can't be merged yet, and auto-merge was not enabled"), nil
+	}
+
+	autoMerge := data.EnablePullRequestAutoMerge.PullRequest.AutoMergeRequest
+	r, err := json.Marshal(mergePullRequestResult{
+		AutoMergeEnabled: true,
+		MergeMethod:      strings.ToLower(autoMerge.MergeMethod),
+		EnabledAt:        autoMerge.EnabledAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// disablePullRequestAutoMergeMutation disables auto-merge on a pull request.
+const disablePullRequestAutoMergeMutation = `mutation($pullRequestId: ID!) {
+  disablePullRequestAutoMerge(input: {pullRequestId: $pullRequestId}) {
+    pullRequest { number url autoMergeRequest { enabledAt } }
+  }
+}`
+
+// autoMergeDisabled is the state of a pull request after disabling auto-merge on it.
+type autoMergeDisabled struct {
+	Number           int    `json:"number"`
+	URL              string `json:"url"`
+	AutoMergeEnabled bool   `json:"auto_merge_enabled"`
+}
+
+// DisablePullRequestAutoMerge creates a tool to disable auto-merge on a pull request.
+func DisablePullRequestAutoMerge(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("disable_pull_request_auto_merge",
+			mcp.WithDescription(t("TOOL_DISABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Disable auto-merge on a pull request, so that it is no longer merged once its requirements are met")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -409,22 +672,49 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			opts := &github.ListOptions{}
-			files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+			pullRequestID, err := issueNodeID(ctx, client, owner, repo, pullNumber)
+			if errors.Is(err, errIssueNotFound) {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			if err != nil {
-				return nil, fmt.Errorf("failed to get pull request files: %w", err)
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
+			}
+
+			result, resp, err := queryGraphQL(ctx, client, disablePullRequestAutoMergeMutation, map[string]interface{}{
+				"pullRequestId": pullRequestID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to disable auto-merge: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request files: %s", string(body))), nil
+			// e.g. the number is that of an issue, or auto-merge is not enabled
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to disable auto-merge: %s", err.Error())), nil
 			}
 
-			r, err := json.Marshal(files)
+			var data struct {
+				DisablePullRequestAutoMerge *struct {
+					PullRequest *struct {
+						Number           int             `json:"number"`
+						URL              string          `json:"url"`
+						AutoMergeRequest json.RawMessage `json:"autoMergeRequest"`
+					} `json:"pullRequest"`
+				} `json:"disablePullRequestAutoMerge"`
+			}
+			if err := json.Unmarshal(result.Data, &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal pull request: %w", err)
+			}
+			if data.DisablePullRequestAutoMerge == nil || data.DisablePullRequestAutoMerge.PullRequest == nil {
+				return mcp.NewToolResultError("failed to disable auto-merge: no pull request was returned"), nil
+			}
+
+			pr := data.DisablePullRequestAutoMerge.PullRequest
+			r, err := json.Marshal(autoMergeDisabled{
+				Number:           pr.Number,
+				URL:              pr.URL,
+				AutoMergeEnabled: len(pr.AutoMergeRequest) > 0 && string(pr.AutoMergeRequest) != "null",
+			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -433,10 +723,144 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 		}
 }
 
-// GetPullRequestStatus creates a tool to get the combined status of all status checks for a pull request.
-func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_pull_request_status",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the combined status of all status checks for a pull request")),
+// markPullRequestReadyMutation and convertPullRequestToDraftMutation move a pull request out
+// of and into draft, which REST can't do, and return its new state.
+const (
+	markPullRequestReadyMutation = `mutation($pullRequestId: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $pullRequestId}) {
+    pullRequest { number url isDraft }
+  }
+}`
+	convertPullRequestToDraftMutation = `mutation($pullRequestId: ID!) {
+  convertPullRequestToDraft(input: {pullRequestId: $pullRequestId}) {
+    pullRequest { number url isDraft }
+  }
+}`
+)
+
+// pullRequestDraftState is the draft state of a pull request after marking it ready for review
+// or converting it to a draft.
+type pullRequestDraftState struct {
+	Number int    `json:"number"`
+	URL    string `json:"url"`
+	Draft  bool   `json:"draft"`
+}
+
+// MarkPullRequestReadyForReview creates a tool to mark a draft pull request as ready for review.
+func MarkPullRequestReadyForReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mark_pull_request_ready_for_review",
+			mcp.WithDescription(t("TOOL_MARK_PULL_REQUEST_READY_FOR_REVIEW_DESCRIPTION", "Mark a draft pull request as ready for review, which notifies its reviewers and allows merging it")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		pullRequestDraftHandler(getClient, markPullRequestReadyMutation, "markPullRequestReadyForReview")
+}
+
+// ConvertPullRequestToDraft creates a tool to convert a pull request back to a draft.
+func ConvertPullRequestToDraft(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_pull_request_to_draft",
+			mcp.WithDescription(t("TOOL_CONVERT_PULL_REQUEST_TO_DRAFT_DESCRIPTION", "Convert a pull request to a draft, e.g. while its checks are failing, so that it can't be merged until it is marked ready for review again")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		pullRequestDraftHandler(getClient, convertPullRequestToDraftMutation, "convertPullRequestToDraft")
+}
+
+// pullRequestDraftHandler returns the handler shared by the tools moving a pull request out of
+// and into draft. It runs mutation on the requested pull request and reads the new state from
+// the mutation's payload, which is named field in the response.
+func pullRequestDraftHandler(getClient GetClientFn, mutation, field string) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		owner, err := requiredParam[string](request, "owner")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		repo, err := requiredParam[string](request, "repo")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		pullNumber, err := RequiredInt(request, "pullNumber")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+		pullRequestID, err := issueNodeID(ctx, client, owner, repo, pullNumber)
+		if errors.Is(err, errIssueNotFound) {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull request: %w", err)
+		}
+
+		result, resp, err := queryGraphQL(ctx, client, mutation, map[string]interface{}{
+			"pullRequestId": pullRequestID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update pull request: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		// e.g. the number is that of an issue
+		if err := result.err(); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request: %s", err.Error())), nil
+		}
+
+		var data map[string]*struct {
+			PullRequest *struct {
+				Number  int    `json:"number"`
+				URL     string `json:"url"`
+				IsDraft bool   `json:"isDraft"`
+			} `json:"pullRequest"`
+		}
+		if err := json.Unmarshal(result.Data, &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal pull request: %w", err)
+		}
+		payload := data[field]
+		if payload == nil || payload.PullRequest == nil {
+			return mcp.NewToolResultError("failed to update pull request: no pull request was returned"), nil
+		}
+
+		r, err := json.Marshal(pullRequestDraftState{
+			Number: payload.PullRequest.Number,
+			URL:    payload.PullRequest.URL,
+			Draft:  payload.PullRequest.IsDraft,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal response: %w", err)
+		}
+
+		return mcp.NewToolResultText(string(r)), nil
+	}
+}
+
+// GetPullRequestFiles creates a tool to get the list of files changed in a pull request.
+func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_files",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_FILES_DESCRIPTION", "Get the list of files changed in a pull request")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -463,29 +887,15 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			// First get the PR to find the head SHA
+
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get pull request: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request: %s", string(body))), nil
-			}
-
-			// Get combined status for the head SHA
-			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, *pr.Head.SHA, nil)
+			opts := &github.ListOptions{}
+			files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get combined status: %w", err)
+				return nil, fmt.Errorf("failed to get pull request files: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -494,10 +904,10 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get combined status: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request files: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(status)
+			r, err := json.Marshal(files)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -506,10 +916,37 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 		}
 }
 
-// UpdatePullRequestBranch creates a tool to update a pull request branch with the latest changes from the base branch.
-func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("update_pull_request_branch",
-			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_BRANCH_DESCRIPTION", "Update a pull request branch with the latest changes from the base branch")),
+// pullRequestCommit is the subset of a pull request commit returned by list_pull_request_commits.
+type pullRequestCommit struct {
+	SHA     string                  `json:"sha"`
+	Message string                  `json:"message"`
+	Author  pullRequestCommitAuthor `json:"author"`
+}
+
+type pullRequestCommitAuthor struct {
+	Name  string           `json:"name"`
+	Email string           `json:"email"`
+	Login string           `json:"login,omitempty"`
+	Date  github.Timestamp `json:"date"`
+}
+
+func newPullRequestCommit(c *github.RepositoryCommit) pullRequestCommit {
+	return pullRequestCommit{
+		SHA:     c.GetSHA(),
+		Message: c.GetCommit().GetMessage(),
+		Author: pullRequestCommitAuthor{
+			Name:  c.GetCommit().GetAuthor().GetName(),
+			Email: c.GetCommit().GetAuthor().GetEmail(),
+			Login: c.GetAuthor().GetLogin(),
+			Date:  c.GetCommit().GetAuthor().GetDate(),
+		},
+	}
+}
+
+// ListPullRequestCommits creates a tool to list the commits of a pull request.
+func ListPullRequestCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_commits",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_COMMITS_DESCRIPTION", "List the commits of a pull request, oldest first, with their SHA, message and author")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -522,9 +959,7 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
-			mcp.WithString("expectedHeadSha",
-				mcp.Description("The expected SHA of the pull request's HEAD ref"),
-			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -539,36 +974,37 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			expectedHeadSHA, err := OptionalParam[string](request, "expectedHeadSha")
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			opts := &github.PullRequestBranchUpdateOptions{}
-			if expectedHeadSHA != "" {
-				opts.ExpectedHeadSHA = github.Ptr(expectedHeadSHA)
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			result, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, pullNumber, opts)
+			commits, resp, err := client.PullRequests.ListCommits(ctx, owner, repo, pullNumber, opts)
 			if err != nil {
-				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
-				// and it's not a real error.
-				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Pull request branch update is in progress"), nil
-				}
-				return nil, fmt.Errorf("failed to update pull request branch: %w", err)
+				return nil, fmt.Errorf("failed to list pull request commits: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusAccepted {
+			if resp.StatusCode != http.StatusOK {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request branch: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull request commits: %s", string(body))), nil
+			}
+
+			result := make([]pullRequestCommit, 0, len(commits))
+			for _, c := range commits {
+				result = append(result, newPullRequestCommit(c))
 			}
 
 			r, err := json.Marshal(result)
@@ -580,10 +1016,20 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 		}
 }
 
-// GetPullRequestComments creates a tool to get the review comments on a pull request.
-func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_pull_request_comments",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_COMMENTS_DESCRIPTION", "Get the review comments on a pull request")),
+// commitPullRequest is the subset of a pull request returned by get_referenced_pull_requests_for_commit.
+type commitPullRequest struct {
+	Number   int               `json:"number"`
+	Title    string            `json:"title"`
+	State    string            `json:"state"`
+	Merged   bool              `json:"merged"`
+	MergedAt *github.Timestamp `json:"merged_at,omitempty"`
+	URL      string            `json:"url"`
+}
+
+// GetReferencedPullRequestsForCommit creates a tool to list the pull requests that contain a commit.
+func GetReferencedPullRequestsForCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_referenced_pull_requests_for_commit",
+			mcp.WithDescription(t("TOOL_GET_REFERENCED_PULL_REQUESTS_FOR_COMMIT_DESCRIPTION", "List the pull requests that contain a commit, e.g. to find the pull request that introduced it. For a commit on the default branch only the pull request that merged it is returned")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -592,10 +1038,11 @@ func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHel
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithNumber("pullNumber",
+			mcp.WithString("sha",
 				mcp.Required(),
-				mcp.Description("Pull request number"),
+				mcp.Description("Commit SHA"),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -606,24 +1053,31 @@ func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHel
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			pullNumber, err := RequiredInt(request, "pullNumber")
+			sha, err := requiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts := &github.PullRequestListCommentsOptions{
-				ListOptions: github.ListOptions{
-					PerPage: 100,
-				},
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			comments, resp, err := client.PullRequests.ListComments(ctx, owner, repo, pullNumber, opts)
+			// go-github sends the groot preview media type this endpoint needs.
+			pulls, resp, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get pull request comments: %w", err)
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("commit not found: %s in %s/%s", sha, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list pull requests for commit: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -632,10 +1086,22 @@ func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHel
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request comments: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests for commit: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(comments)
+			result := make([]commitPullRequest, 0, len(pulls))
+			for _, pr := range pulls {
+				result = append(result, commitPullRequest{
+					Number:   pr.GetNumber(),
+					Title:    pr.GetTitle(),
+					State:    pr.GetState(),
+					Merged:   pr.MergedAt != nil,
+					MergedAt: pr.MergedAt,
+					URL:      pr.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -644,10 +1110,10 @@ func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHel
 		}
 }
 
-// GetPullRequestReviews creates a tool to get the reviews on a pull request.
-func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_pull_request_reviews",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_REVIEWS_DESCRIPTION", "Get the reviews on a pull request")),
+// GetPullRequestStatus creates a tool to get the combined status of all status checks for a pull request.
+func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_status",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the combined status of all status checks for a pull request")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -674,14 +1140,14 @@ func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelp
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
+			// First get the PR to find the head SHA
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, nil)
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get pull request reviews: %w", err)
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -690,22 +1156,421 @@ func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelp
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request reviews: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(reviews)
+			// Get combined status for the head SHA
+			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, *pr.Head.SHA, nil)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return nil, fmt.Errorf("failed to get combined status: %w", err)
 			}
+			defer func() { _ = resp.Body.Close() }()
 
-			return mcp.NewToolResultText(string(r)), nil
-		}
-}
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get combined status: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(status)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+const (
+	// maxMergeablePollAttempts and maxMergeablePollInterval bound how long check_pull_request_mergeable polls.
+	maxMergeablePollAttempts = 20
+	maxMergeablePollInterval = 10000
+)
+
+// pullRequestMergeability is the result of check_pull_request_mergeable.
+type pullRequestMergeability struct {
+	// Mergeable is null if GitHub had not computed mergeability after the last attempt.
+	Mergeable      *bool  `json:"mergeable"`
+	MergeableState string `json:"mergeable_state"`
+	State          string `json:"state"`
+	Merged         bool   `json:"merged"`
+	Attempts       int    `json:"attempts"`
+}
+
+// CheckPullRequestMergeable creates a tool to wait for GitHub to compute whether a pull request can be merged.
+func CheckPullRequestMergeable(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_pull_request_mergeable",
+			mcp.WithDescription(t("TOOL_CHECK_PULL_REQUEST_MERGEABLE_DESCRIPTION", "Check whether a pull request can be merged. GitHub computes mergeability in the background, so this polls the pull request until it is known, and returns mergeable and mergeable_state (clean, dirty, blocked, behind, unstable, ...). mergeable is null if it was still unknown after the last attempt")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("max_attempts",
+				mcp.Description(fmt.Sprintf("Number of times to fetch the pull request before giving up (default 5, max %d)", maxMergeablePollAttempts)),
+			),
+			mcp.WithNumber("interval_ms",
+				mcp.Description(fmt.Sprintf("Milliseconds to wait between attempts (default 1000, max %d)", maxMergeablePollInterval)),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxAttempts, err := OptionalIntParamWithDefault(request, "max_attempts", 5)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			intervalMS, err := OptionalIntParamWithDefault(request, "interval_ms", 1000)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxAttempts < 1 || maxAttempts > maxMergeablePollAttempts {
+				return mcp.NewToolResultError(fmt.Sprintf("max_attempts must be between 1 and %d", maxMergeablePollAttempts)), nil
+			}
+			if intervalMS < 1 || intervalMS > maxMergeablePollInterval {
+				return mcp.NewToolResultError(fmt.Sprintf("interval_ms must be between 1 and %d", maxMergeablePollInterval)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var result pullRequestMergeability
+			for result.Attempts < maxAttempts {
+				if result.Attempts > 0 {
+					if err := sleep(ctx, time.Duration(intervalMS)*time.Millisecond); err != nil {
+						return nil, fmt.Errorf("failed to check pull request mergeability: %w", err)
+					}
+				}
+				result.Attempts++
+
+				pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get pull request: %w", err)
+				}
+				_ = resp.Body.Close()
+
+				result.Mergeable = pr.Mergeable
+				result.MergeableState = pr.GetMergeableState()
+				result.State = pr.GetState()
+				result.Merged = pr.GetMerged()
+				// Mergeability is never computed for closed pull requests
+				if result.Mergeable != nil || result.State == "closed" {
+					break
+				}
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdatePullRequestBranch creates a tool to update a pull request branch with the latest changes from the base branch.
+func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_pull_request_branch",
+			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_BRANCH_DESCRIPTION", "Update a pull request branch with the latest changes from the base branch")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("expectedHeadSha",
+				mcp.Description("The expected SHA of the pull request's HEAD ref"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedHeadSHA, err := OptionalParam[string](request, "expectedHeadSha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.PullRequestBranchUpdateOptions{}
+			if expectedHeadSHA != "" {
+				opts.ExpectedHeadSHA = github.Ptr(expectedHeadSHA)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, pullNumber, opts)
+			if err != nil {
+				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
+				// and it's not a real error.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText("Pull request branch update is in progress"), nil
+				}
+				return nil, fmt.Errorf("failed to update pull request branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusAccepted {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request branch: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetPullRequestComments creates a tool to get the review comments on a pull request.
+func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_comments",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_COMMENTS_DESCRIPTION", "Get the review comments on a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.PullRequestListCommentsOptions{
+				ListOptions: github.ListOptions{
+					PerPage: 100,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comments, resp, err := client.PullRequests.ListComments(ctx, owner, repo, pullNumber, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request comments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request comments: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(comments)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetPullRequestReviews creates a tool to get the reviews on a pull request.
+func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_reviews",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_REVIEWS_DESCRIPTION", "Get the reviews on a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request reviews: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request reviews: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(reviews)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// requestedReviewers are the users, by login, and teams, by slug, whose review of a pull
+// request has been requested and who have not reviewed it since.
+type requestedReviewers struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+}
+
+// ListRequestedReviewers creates a tool to list the reviewers requested on a pull request.
+func ListRequestedReviewers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_requested_reviewers",
+			mcp.WithDescription(t("TOOL_LIST_REQUESTED_REVIEWERS_DESCRIPTION", "List the users and teams whose review of a pull request is requested and still pending. Reviewers drop off the list once they submit a review")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			reviewers, resp, err := client.PullRequests.ListReviewers(ctx, owner, repo, pullNumber, nil)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("pull request %d not found in %s/%s", pullNumber, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list requested reviewers: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list requested reviewers: %s", string(body))), nil
+			}
+
+			result := requestedReviewers{
+				Users: make([]string, 0, len(reviewers.Users)),
+				Teams: make([]string, 0, len(reviewers.Teams)),
+			}
+			for _, u := range reviewers.Users {
+				result.Users = append(result.Users, u.GetLogin())
+			}
+			for _, team := range reviewers.Teams {
+				result.Teams = append(result.Teams, team.GetSlug())
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// pullRequestReviewEvents are the actions a review can be submitted with.
+var pullRequestReviewEvents = []string{"APPROVE", "REQUEST_CHANGES", "COMMENT"}
 
 // CreatePullRequestReview creates a tool to submit a review on a pull request.
 func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("create_pull_request_review",
-			mcp.WithDescription(t("TOOL_CREATE_PULL_REQUEST_REVIEW_DESCRIPTION", "Create a review on a pull request")),
+			mcp.WithDescription(t("TOOL_CREATE_PULL_REQUEST_REVIEW_DESCRIPTION", "Create a review on a pull request. Without an event the review is left pending: add inline comments to it with add_pull_request_review_comment, then submit it with submit_pull_request_review")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -722,8 +1587,8 @@ func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHe
 				mcp.Description("Review comment text"),
 			),
 			mcp.WithString("event",
-				mcp.Required(),
-				mcp.Description("Review action ('APPROVE', 'REQUEST_CHANGES', 'COMMENT')"),
+				mcp.Description("Review action ('APPROVE', 'REQUEST_CHANGES', 'COMMENT'). Omit to create a pending review"),
+				mcp.Enum(pullRequestReviewEvents...),
 			),
 			mcp.WithString("commitId",
 				mcp.Description("SHA of commit to review"),
@@ -782,14 +1647,19 @@ func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			event, err := requiredParam[string](request, "event")
+			event, err := OptionalParam[string](request, "event")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Create review request
-			reviewRequest := &github.PullRequestReviewRequest{
-				Event: github.Ptr(event),
+			if event != "" && !slices.Contains(pullRequestReviewEvents, event) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid event %q, must be one of: %s", event, strings.Join(pullRequestReviewEvents, ", "))), nil
+			}
+
+			// Create review request, leaving out the event for a pending review
+			reviewRequest := &github.PullRequestReviewRequest{}
+			if event != "" {
+				reviewRequest.Event = github.Ptr(event)
 			}
 
 			// Add body if provided
@@ -898,8 +1768,297 @@ func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHe
 		}
 }
 
+// addPullRequestReviewThreadMutation adds an inline comment, as a new thread, to a pending review.
+// REST has no endpoint adding comments to an existing review, so this goes through GraphQL.
+const addPullRequestReviewThreadMutation = `mutation($reviewId: ID!, $path: String!, $body: String!, $line: Int!, $side: DiffSide, $startLine: Int, $startSide: DiffSide) {
+  addPullRequestReviewThread(input: {pullRequestReviewId: $reviewId, path: $path, body: $body, line: $line, side: $side, startLine: $startLine, startSide: $startSide}) {
+    thread { id comments(first: 1) { nodes { databaseId url } } }
+  }
+}`
+
+// pendingReviewComment is an inline comment added to a pending review.
+type pendingReviewComment struct {
+	ThreadID  string `json:"thread_id"`
+	CommentID int64  `json:"comment_id"`
+	URL       string `json:"url"`
+}
+
+// AddPullRequestReviewComment creates a tool to add an inline comment to a pending pull request review.
+func AddPullRequestReviewComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_pull_request_review_comment",
+			mcp.WithDescription(t("TOOL_ADD_PULL_REQUEST_REVIEW_COMMENT_DESCRIPTION", "Add an inline comment to a pending pull request review, created by create_pull_request_review without an event. The comment stays hidden until the review is submitted with submit_pull_request_review")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("review_id",
+				mcp.Required(),
+				mcp.Description("ID of the pending review"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file to comment on"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Comment text"),
+			),
+			mcp.WithNumber("line",
+				mcp.Required(),
+				mcp.Description("Line of the file to comment on. For multi-line comments, the last line of the range"),
+			),
+			mcp.WithString("side",
+				mcp.Description("Side of the diff the line is on: LEFT for deletions, RIGHT (default) for additions and context"),
+				mcp.Enum("LEFT", "RIGHT"),
+			),
+			mcp.WithNumber("start_line",
+				mcp.Description("First line of the range for multi-line comments"),
+			),
+			mcp.WithString("start_side",
+				mcp.Description("Side of the diff the start line is on, for multi-line comments"),
+				mcp.Enum("LEFT", "RIGHT"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewID, err := RequiredInt(request, "review_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := requiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			line, err := RequiredInt(request, "line")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			side, err := OptionalParam[string](request, "side")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startLine, err := OptionalIntParam(request, "start_line")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startSide, err := OptionalParam[string](request, "start_side")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if startSide != "" && startLine == 0 {
+				return mcp.NewToolResultError("start_side requires start_line"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The mutation takes the node ID of the review, and only pending reviews take new comments.
+			review, resp, err := client.PullRequests.GetReview(ctx, owner, repo, pullNumber, int64(reviewID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("review not found: %d on %s/%s#%d", reviewID, owner, repo, pullNumber)), nil
+				}
+				return nil, fmt.Errorf("failed to get pull request review: %w", err)
+			}
+			_ = resp.Body.Close()
+			if review.GetState() != "PENDING" {
+				return mcp.NewToolResultError(fmt.Sprintf("review %d is %s, comments can only be added to a pending review", reviewID, strings.ToLower(review.GetState()))), nil
+			}
+
+			variables := map[string]interface{}{
+				"reviewId":  review.GetNodeID(),
+				"path":      path,
+				"body":      body,
+				"line":      line,
+				"side":      nil,
+				"startLine": nil,
+				"startSide": nil,
+			}
+			if side != "" {
+				variables["side"] = side
+			}
+			if startLine != 0 {
+				variables["startLine"] = startLine
+			}
+			if startSide != "" {
+				variables["startSide"] = startSide
+			}
+
+			result, resp, err := queryGraphQL(ctx, client, addPullRequestReviewThreadMutation, variables)
+			if err != nil {
+				return nil, fmt.Errorf("failed to add pull request review comment: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// e.g. the line is not part of the diff
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add pull request review comment: %s", err.Error())), nil
+			}
+
+			var data struct {
+				AddPullRequestReviewThread *struct {
+					Thread *struct {
+						ID       string `json:"id"`
+						Comments struct {
+							Nodes []struct {
+								DatabaseID int64  `json:"databaseId"`
+								URL        string `json:"url"`
+							} `json:"nodes"`
+						} `json:"comments"`
+					} `json:"thread"`
+				} `json:"addPullRequestReviewThread"`
+			}
+			if err := json.Unmarshal(result.Data, &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal review thread: %w", err)
+			}
+			if data.AddPullRequestReviewThread == nil || data.AddPullRequestReviewThread.Thread == nil {
+				return mcp.NewToolResultError("failed to add pull request review comment: no review thread was created"), nil
+			}
+
+			thread := data.AddPullRequestReviewThread.Thread
+			comment := pendingReviewComment{ThreadID: thread.ID}
+			if len(thread.Comments.Nodes) > 0 {
+				comment.CommentID = thread.Comments.Nodes[0].DatabaseID
+				comment.URL = thread.Comments.Nodes[0].URL
+			}
+
+			r, err := json.Marshal(comment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// SubmitPullRequestReview creates a tool to submit a pending pull request review.
+func SubmitPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("submit_pull_request_review",
+			mcp.WithDescription(t("TOOL_SUBMIT_PULL_REQUEST_REVIEW_DESCRIPTION", "Submit a pending pull request review, publishing it and its comments with the given action")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("review_id",
+				mcp.Required(),
+				mcp.Description("ID of the pending review"),
+			),
+			mcp.WithString("event",
+				mcp.Required(),
+				mcp.Description("Review action ('APPROVE', 'REQUEST_CHANGES', 'COMMENT')"),
+				mcp.Enum(pullRequestReviewEvents...),
+			),
+			mcp.WithString("body",
+				mcp.Description("Review summary text, required for REQUEST_CHANGES and COMMENT"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewID, err := RequiredInt(request, "review_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			event, err := requiredParam[string](request, "event")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !slices.Contains(pullRequestReviewEvents, event) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid event %q, must be one of: %s", event, strings.Join(pullRequestReviewEvents, ", "))), nil
+			}
+
+			reviewRequest := &github.PullRequestReviewRequest{
+				Event: github.Ptr(event),
+			}
+			if body != "" {
+				reviewRequest.Body = github.Ptr(body)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, resp, err := client.PullRequests.SubmitReview(ctx, owner, repo, pullNumber, int64(reviewID), reviewRequest)
+			if err != nil {
+				if resp != nil {
+					switch resp.StatusCode {
+					case http.StatusNotFound:
+						return mcp.NewToolResultError(fmt.Sprintf("review not found: %d on %s/%s#%d", reviewID, owner, repo, pullNumber)), nil
+					case http.StatusUnprocessableEntity:
+						// e.g. the review was already submitted, or a body is missing
+						return mcp.NewToolResultError(fmt.Sprintf("failed to submit pull request review: %s", err.Error())), nil
+					}
+				}
+				return nil, fmt.Errorf("failed to submit pull request review: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to submit pull request review: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(review)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
 // CreatePullRequest creates a tool to create a new pull request.
-func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func CreatePullRequest(getClient GetClientFn, created *idempotencyCache, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("create_pull_request",
 			mcp.WithDescription(t("TOOL_CREATE_PULL_REQUEST_DESCRIPTION", "Create a new pull request in a GitHub repository")),
 			mcp.WithString("owner",
@@ -931,6 +2090,7 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			mcp.WithBoolean("maintainer_can_modify",
 				mcp.Description("Allow maintainer edits"),
 			),
+			WithIdempotencyKey(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -969,6 +2129,14 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			idempotencyKey, err := OptionalParam[string](request, "idempotency_key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if r, ok := created.lookup("create_pull_request", owner, repo, idempotencyKey); ok {
+				return mcp.NewToolResultText(r), nil
+			}
+
 			newPR := &github.NewPullRequest{
 				Title: github.Ptr(title),
 				Head:  github.Ptr(head),
@@ -1004,6 +2172,186 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
+			created.store("create_pull_request", owner, repo, idempotencyKey, string(r))
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// closingKeywordPattern matches an issue a pull request body closes with a closing keyword,
+// as "#N", "owner/repo#N" or an issue URL.
+var closingKeywordPattern = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+(?:https?://[^/\s]+/([\w.-]+)/([\w.-]+)/issues/(\d+)|(?:([\w.-]+)/([\w.-]+))?#(\d+))`)
+
+// issueRef identifies an issue, possibly in another repository.
+type issueRef struct {
+	owner  string
+	repo   string
+	number int
+}
+
+func (r issueRef) String() string {
+	return fmt.Sprintf("%s/%s#%d", r.owner, r.repo, r.number)
+}
+
+// parseClosingReferences returns the issues the body closes through closing keywords, in order of
+// appearance and without duplicates. References without a repository are in owner/repo.
+func parseClosingReferences(body, owner, repo string) []issueRef {
+	var refs []issueRef
+	seen := map[issueRef]bool{}
+	for _, m := range closingKeywordPattern.FindAllStringSubmatch(body, -1) {
+		ref := issueRef{owner: owner, repo: repo}
+		number := m[6]
+		switch {
+		case m[3] != "":
+			ref.owner, ref.repo, number = m[1], m[2], m[3]
+		case m[4] != "":
+			ref.owner, ref.repo = m[4], m[5]
+		}
+		ref.number, _ = strconv.Atoi(number)
+		if ref.number == 0 || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// referencedIssue is an issue linked to a pull request, with its current state.
+type referencedIssue struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+	Title  string `json:"title,omitempty"`
+	State  string `json:"state"`
+	URL    string `json:"url,omitempty"`
+	// Closes is set for issues the pull request closes with a closing keyword in its body.
+	Closes bool `json:"closes"`
+	// CrossReferenced is set for issues that mention the pull request.
+	CrossReferenced bool `json:"cross_referenced"`
+}
+
+// ListReferencedIssues creates a tool to list the issues a pull request is linked to.
+func ListReferencedIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_referenced_issues",
+			mcp.WithDescription(t("TOOL_LIST_REFERENCED_ISSUES_DESCRIPTION", "List the issues linked to a pull request, from closing keywords in its body (e.g. 'Fixes #12') and from issues cross-referencing it, with their current state")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			var issues []referencedIssue
+			index := map[issueRef]int{}
+
+			for _, ref := range parseClosingReferences(pr.GetBody(), owner, repo) {
+				issue, resp, err := client.Issues.Get(ctx, ref.owner, ref.repo, ref.number)
+				if err != nil {
+					// A body can reference issues that do not exist or that the caller cannot see
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						index[ref] = len(issues)
+						issues = append(issues, referencedIssue{Owner: ref.owner, Repo: ref.repo, Number: ref.number, State: "not_found", Closes: true})
+						continue
+					}
+					return nil, fmt.Errorf("failed to get issue %s: %w", ref, err)
+				}
+				_ = resp.Body.Close()
+
+				index[ref] = len(issues)
+				issues = append(issues, referencedIssue{
+					Owner:  ref.owner,
+					Repo:   ref.repo,
+					Number: ref.number,
+					Title:  issue.GetTitle(),
+					State:  issue.GetState(),
+					URL:    issue.GetHTMLURL(),
+					Closes: true,
+				})
+			}
+
+			opts := &github.ListOptions{PerPage: 100}
+			for {
+				events, resp, err := client.Issues.ListIssueTimeline(ctx, owner, repo, pullNumber, opts)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list pull request timeline: %w", err)
+				}
+				_ = resp.Body.Close()
+
+				for _, event := range events {
+					if event.GetEvent() != "cross-referenced" {
+						continue
+					}
+					issue := event.GetSource().GetIssue()
+					if issue == nil || issue.IsPullRequest() {
+						continue
+					}
+					ref := issueRef{number: issue.GetNumber()}
+					if r := issue.GetRepository(); r != nil {
+						ref.owner, ref.repo = r.GetOwner().GetLogin(), r.GetName()
+					} else {
+						// repository_url has the form https://HOST/repos/OWNER/REPO
+						ref.owner, ref.repo = path.Base(path.Dir(issue.GetRepositoryURL())), path.Base(issue.GetRepositoryURL())
+					}
+					if i, ok := index[ref]; ok {
+						issues[i].CrossReferenced = true
+						continue
+					}
+					index[ref] = len(issues)
+					issues = append(issues, referencedIssue{
+						Owner:           ref.owner,
+						Repo:            ref.repo,
+						Number:          ref.number,
+						Title:           issue.GetTitle(),
+						State:           issue.GetState(),
+						URL:             issue.GetHTMLURL(),
+						CrossReferenced: true,
+					})
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			if issues == nil {
+				issues = []referencedIssue{}
+			}
+			r, err := json.Marshal(issues)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
 
 			return mcp.NewToolResultText(string(r)), nil
 		}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,6 +26,7 @@ func Test_GetPullRequest(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "include")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
 	// Setup mock PR for success case
@@ -126,6 +129,248 @@ func Test_GetPullRequest(t *testing.T) {
 	}
 }
 
+func Test_GetPullRequest_Include(t *testing.T) {
+	mockPR := &github.PullRequest{
+		Number:   github.Ptr(42),
+		Title:    github.Ptr("Test PR"),
+		State:    github.Ptr("open"),
+		Comments: github.Ptr(1),
+		Commits:  github.Ptr(1),
+	}
+	mockFiles := []*github.CommitFile{
+		{Filename: github.Ptr("main.go"), Status: github.Ptr("modified"), Additions: github.Ptr(3), Deletions: github.Ptr(1)},
+	}
+	mockReviews := []*github.PullRequestReview{
+		{ID: github.Ptr(int64(1)), State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("reviewer")}},
+	}
+	mockComments := []*github.PullRequestComment{
+		{ID: github.Ptr(int64(2)), Path: github.Ptr("main.go"), Line: github.Ptr(7), Body: github.Ptr("Nit"), User: &github.User{Login: github.Ptr("reviewer")}},
+	}
+	mockCommits := []*github.RepositoryCommit{
+		{SHA: github.Ptr("abcdef1234567"), Commit: &github.Commit{Message: github.Ptr("Add feature\n\nDetails")}, Author: &github.User{Login: github.Ptr("octocat")}},
+	}
+
+	// Only the endpoints of the requested lists are mocked, so fetching any other list fails the call.
+	endpoints := map[string]mock.MockBackendOption{
+		"files": mock.WithRequestMatchHandler(
+			mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+			expectQueryParams(t, map[string]string{"per_page": "100"}).andThen(mockResponse(t, http.StatusOK, mockFiles)),
+		),
+		"reviews": mock.WithRequestMatchHandler(
+			mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+			expectQueryParams(t, map[string]string{"per_page": "100"}).andThen(mockResponse(t, http.StatusOK, mockReviews)),
+		),
+		"comments": mock.WithRequestMatchHandler(
+			mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+			expectQueryParams(t, map[string]string{"per_page": "100"}).andThen(mockResponse(t, http.StatusOK, mockComments)),
+		),
+		"commits": mock.WithRequestMatchHandler(
+			mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+			expectQueryParams(t, map[string]string{"per_page": "100"}).andThen(mockResponse(t, http.StatusOK, mockCommits)),
+		),
+	}
+	mockedClient := func(include ...string) *http.Client {
+		options := []mock.MockBackendOption{
+			mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, mockPR),
+		}
+		for _, name := range include {
+			options = append(options, endpoints[name])
+		}
+		return mock.NewMockedHTTPClient(options...)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		include        []interface{}
+		expectedKeys   []string
+		expectError    bool
+		expectToolErr  bool
+		expectedErrMsg string
+	}{
+		{
+			name:         "no include",
+			mockedClient: mockedClient(),
+			include:      nil,
+			expectedKeys: nil,
+		},
+		{
+			name:         "files",
+			mockedClient: mockedClient("files"),
+			include:      []interface{}{"files"},
+			expectedKeys: []string{"files"},
+		},
+		{
+			name:         "reviews",
+			mockedClient: mockedClient("reviews"),
+			include:      []interface{}{"reviews"},
+			expectedKeys: []string{"reviews"},
+		},
+		{
+			name:         "comments",
+			mockedClient: mockedClient("comments"),
+			include:      []interface{}{"comments"},
+			expectedKeys: []string{"comments"},
+		},
+		{
+			name:         "commits",
+			mockedClient: mockedClient("commits"),
+			include:      []interface{}{"commits"},
+			expectedKeys: []string{"commits"},
+		},
+		{
+			name:         "files and reviews",
+			mockedClient: mockedClient("files", "reviews"),
+			include:      []interface{}{"files", "reviews"},
+			expectedKeys: []string{"files", "reviews"},
+		},
+		{
+			name:         "comments and commits",
+			mockedClient: mockedClient("comments", "commits"),
+			include:      []interface{}{"comments", "commits"},
+			expectedKeys: []string{"comments", "commits"},
+		},
+		{
+			name:         "everything, with a duplicate",
+			mockedClient: mockedClient("files", "reviews", "comments", "commits"),
+			include:      []interface{}{"files", "reviews", "comments", "commits", "files"},
+			expectedKeys: []string{"files", "reviews", "comments", "commits"},
+		},
+		{
+			name:           "invalid include",
+			mockedClient:   mockedClient(),
+			include:        []interface{}{"checks"},
+			expectToolErr:  true,
+			expectedErrMsg: `invalid include "checks", must be one of: files, reviews, comments, commits`,
+		},
+		{
+			name:           "failing list fails the call",
+			mockedClient:   mockedClient("files"),
+			include:        []interface{}{"files", "reviews"},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request reviews",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}
+			if tc.include != nil {
+				args["include"] = tc.include
+			}
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(args))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.JSONEq(t, `42`, string(returned["number"]))
+			assert.JSONEq(t, `1`, string(returned["comments"]))
+			assert.JSONEq(t, `1`, string(returned["commits"]))
+
+			if tc.expectedKeys == nil {
+				assert.NotContains(t, returned, "included")
+				return
+			}
+
+			var included map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(returned["included"], &included))
+			keys := make([]string, 0, len(included))
+			for k := range included {
+				keys = append(keys, k)
+			}
+			assert.ElementsMatch(t, tc.expectedKeys, keys)
+
+			if raw, ok := included["files"]; ok {
+				var files []*github.CommitFile
+				require.NoError(t, json.Unmarshal(raw, &files))
+				require.Len(t, files, 1)
+				assert.Equal(t, "main.go", files[0].GetFilename())
+			}
+			if raw, ok := included["reviews"]; ok {
+				var reviews []*github.PullRequestReview
+				require.NoError(t, json.Unmarshal(raw, &reviews))
+				require.Len(t, reviews, 1)
+				assert.Equal(t, "APPROVED", reviews[0].GetState())
+			}
+			if raw, ok := included["comments"]; ok {
+				var comments []*github.PullRequestComment
+				require.NoError(t, json.Unmarshal(raw, &comments))
+				require.Len(t, comments, 1)
+				assert.Equal(t, "Nit", comments[0].GetBody())
+			}
+			if raw, ok := included["commits"]; ok {
+				var commits []pullRequestCommit
+				require.NoError(t, json.Unmarshal(raw, &commits))
+				require.Len(t, commits, 1)
+				assert.Equal(t, "abcdef1234567", commits[0].SHA)
+				assert.Equal(t, "octocat", commits[0].Author.Login)
+			}
+		})
+	}
+}
+
+func Test_GetPullRequest_IncludeMarkdown(t *testing.T) {
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposPullsByOwnerByRepoByPullNumber,
+			&github.PullRequest{Number: github.Ptr(42), Title: github.Ptr("Test PR"), State: github.Ptr("open")},
+		),
+		mock.WithRequestMatch(
+			mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+			[]*github.RepositoryCommit{
+				{SHA: github.Ptr("abcdef1234567"), Commit: &github.Commit{Message: github.Ptr("Add feature\n\nDetails")}, Author: &github.User{Login: github.Ptr("octocat")}},
+			},
+		),
+		mock.WithRequestMatch(
+			mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+			[]*github.PullRequestReview{},
+		),
+	)
+	client := github.NewClient(mockedClient)
+	_, handler := GetPullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":           "owner",
+		"repo":            "repo",
+		"pullNumber":      float64(42),
+		"include":         []interface{}{"commits", "reviews"},
+		"response_format": "markdown",
+	}))
+	require.NoError(t, err)
+
+	expected := "# #42 Test PR\n\n" +
+		"- **State**: open\n" +
+		"\n## Commits\n\n" +
+		"| SHA | Message | Author |\n| --- | --- | --- |\n| abcdef1 | Add feature | octocat |\n" +
+		"\n## Reviews\n\nNone.\n"
+	assert.Equal(t, expected, getTextResult(t, result).Text)
+}
+
 func Test_UpdatePullRequest(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -451,6 +696,7 @@ func Test_MergePullRequest(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "commit_title")
 	assert.Contains(t, tool.InputSchema.Properties, "commit_message")
 	assert.Contains(t, tool.InputSchema.Properties, "merge_method")
+	assert.Contains(t, tool.InputSchema.Properties, "enable_auto_merge")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
 	// Setup mock merge result for success case
@@ -549,35 +795,48 @@ func Test_MergePullRequest(t *testing.T) {
 	}
 }
 
-func Test_GetPullRequestFiles(t *testing.T) {
-	// Verify tool definition once
-	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestFiles(stubGetClientFn(mockClient), translations.NullTranslationHelper)
-
-	assert.Equal(t, "get_pull_request_files", tool.Name)
-	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "owner")
-	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
-
-	// Setup mock PR files for success case
-	mockFiles := []*github.CommitFile{
-		{
-			Filename:  github.Ptr("file1.go"),
-			Status:    github.Ptr("modified"),
-			Additions: github.Ptr(10),
-			Deletions: github.Ptr(5),
-			Changes:   github.Ptr(15),
-			Patch:     github.Ptr("@@ -1,5 +1,10 @@"),
-		},
-		{
-			Filename:  github.Ptr("file2.go"),
-			Status:    github.Ptr("added"),
-			Additions: github.Ptr(20),
-			Deletions: github.Ptr(0),
-			Changes:   github.Ptr(20),
-			Patch:     github.Ptr("@@ -0,0 +1,20 @@"),
+func Test_MergePullRequest_AutoMerge(t *testing.T) {
+	notMergeable := mock.WithRequestMatchHandler(
+		mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
+		mockResponse(t, http.StatusMethodNotAllowed, map[string]string{"message": "Required status check \"build\" is expected."}),
+	)
+
+	// graphQLHandler answers the node ID lookup of pull request 42 and the auto-merge mutation
+	graphQLHandler := func(mutationVariables map[string]interface{}, mutation interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Query     string                 `json:"query"`
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			switch body.Query {
+			case issueNodeIDQuery:
+				assert.Equal(t, map[string]interface{}{"owner": "owner", "repo": "repo", "number": float64(42)}, body.Variables)
+				mockResponse(t, http.StatusOK, map[string]interface{}{
+					"data": map[string]interface{}{
+						"repository": map[string]interface{}{
+							"issueOrPullRequest": map[string]interface{}{"id": "PR_42"},
+						},
+					},
+				})(w, r)
+			case enablePullRequestAutoMergeMutation:
+				assert.Equal(t, mutationVariables, body.Variables)
+				mockResponse(t, http.StatusOK, mutation)(w, r)
+			default:
+				t.Errorf("unexpected query: %s", body.Query)
+			}
+		}
+	}
+	autoMergeEnabled := map[string]interface{}{
+		"data": map[string]interface{}{
+			"enablePullRequestAutoMerge": map[string]interface{}{
+				"pullRequest": map[string]interface{}{
+					"autoMergeRequest": map[string]interface{}{
+						"mergeMethod": "SQUASH",
+						"enabledAt":   "2025-03-01T10:00:00Z",
+					},
+				},
+			},
 		},
 	}
 
@@ -586,43 +845,104 @@ func Test_GetPullRequestFiles(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedFiles  []*github.CommitFile
+		expectedResult string
 		expectedErrMsg string
 	}{
 		{
-			name: "successful files fetch",
+			name: "merged right away",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
-					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
-					mockFiles,
+					mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
+					&github.PullRequestMergeResult{
+						Merged:  github.Ptr(true),
+						Message: github.Ptr("Pull Request successfully merged"),
+						SHA:     github.Ptr("abc123"),
+					},
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":      "owner",
-				"repo":       "repo",
-				"pullNumber": float64(42),
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"enable_auto_merge": true,
 			},
-			expectError:   false,
-			expectedFiles: mockFiles,
+			expectedResult: `{"merged": true, "sha": "abc123", "message": "Pull Request successfully merged", "auto_merge_enabled": false}`,
 		},
 		{
-			name: "files fetch fails",
+			name: "auto-merge enabled when checks are pending",
 			mockedClient: mock.NewMockedHTTPClient(
+				notMergeable,
 				mock.WithRequestMatchHandler(
-					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					postGraphQL,
+					graphQLHandler(map[string]interface{}{
+						"pullRequestId":  "PR_42",
+						"mergeMethod":    "SQUASH",
+						"commitHeadline": "Add feature (#42)",
+						"commitBody":     nil,
+					}, autoMergeEnabled),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"merge_method":      "squash",
+				"commit_title":      "Add feature (#42)",
+				"enable_auto_merge": true,
+			},
+			expectedResult: `{"merged": false, "auto_merge_enabled": true, "merge_method": "squash", "enabled_at": "2025-03-01T10:00:00Z"}`,
+		},
+		{
+			name: "auto-merge not allowed in the repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				notMergeable,
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(map[string]interface{}{
+						"pullRequestId":  "PR_42",
+						"mergeMethod":    nil,
+						"commitHeadline": nil,
+						"commitBody":     nil,
+					}, map[string]interface{}{
+						"data": map[string]interface{}{"enablePullRequestAutoMerge": nil},
+						"errors": []map[string]interface{}{
+							{"message": "Pull request Auto merge is not allowed for this repository"},
+						},
 					}),
 				),
 			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"enable_auto_merge": true,
+			},
+			expectedErrMsg: "pull request can't be merged yet, and enabling auto-merge failed: graphql: Pull request Auto merge is not allowed for this repository",
+		},
+		{
+			name:         "invalid merge method",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"merge_method":      "fast-forward",
+				"enable_auto_merge": true,
+			},
+			expectedErrMsg: `invalid merge_method "fast-forward", must be one of: merge, squash, rebase`,
+		},
+		{
+			name: "not mergeable without enable_auto_merge",
+			mockedClient: mock.NewMockedHTTPClient(
+				notMergeable,
+			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
-				"pullNumber": float64(999),
+				"pullNumber": float64(42),
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get pull request files",
+			expectedErrMsg: "failed to merge pull request",
 		},
 	}
 
@@ -630,13 +950,10 @@ func Test_GetPullRequestFiles(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestFiles(stubGetClientFn(client), translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			_, handler := MergePullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Call handler
-			result, err := handler(context.Background(), request)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
 
 			// Verify results
 			if tc.expectError {
@@ -646,70 +963,55 @@ func Test_GetPullRequestFiles(t *testing.T) {
 			}
 
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedFiles []*github.CommitFile
-			err = json.Unmarshal([]byte(textContent.Text), &returnedFiles)
-			require.NoError(t, err)
-			assert.Len(t, returnedFiles, len(tc.expectedFiles))
-			for i, file := range returnedFiles {
-				assert.Equal(t, *tc.expectedFiles[i].Filename, *file.Filename)
-				assert.Equal(t, *tc.expectedFiles[i].Status, *file.Status)
-				assert.Equal(t, *tc.expectedFiles[i].Additions, *file.Additions)
-				assert.Equal(t, *tc.expectedFiles[i].Deletions, *file.Deletions)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
 			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
 		})
 	}
 }
 
-func Test_GetPullRequestStatus(t *testing.T) {
+func Test_DisablePullRequestAutoMerge(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := DisablePullRequestAutoMerge(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "get_pull_request_status", tool.Name)
+	assert.Equal(t, "disable_pull_request_auto_merge", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock PR for successful PR fetch
-	mockPR := &github.PullRequest{
-		Number:  github.Ptr(42),
-		Title:   github.Ptr("Test PR"),
-		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
-		Head: &github.PullRequestBranch{
-			SHA: github.Ptr("abcd1234"),
-			Ref: github.Ptr("feature-branch"),
-		},
+	// graphQLHandler answers the node ID lookup with lookup and the mutation with mutation
+	graphQLHandler := func(lookup, mutation interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Query     string                 `json:"query"`
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			switch body.Query {
+			case issueNodeIDQuery:
+				assert.Equal(t, map[string]interface{}{"owner": "owner", "repo": "repo", "number": float64(42)}, body.Variables)
+				mockResponse(t, http.StatusOK, lookup)(w, r)
+			case disablePullRequestAutoMergeMutation:
+				assert.Equal(t, map[string]interface{}{"pullRequestId": "PR_42"}, body.Variables)
+				mockResponse(t, http.StatusOK, mutation)(w, r)
+			default:
+				t.Errorf("unexpected query: %s", body.Query)
+			}
+		}
 	}
-
-	// Setup mock status for success case
-	mockStatus := &github.CombinedStatus{
-		State:      github.Ptr("success"),
-		TotalCount: github.Ptr(3),
-		Statuses: []*github.RepoStatus{
-			{
-				State:       github.Ptr("success"),
-				Context:     github.Ptr("continuous-integration/travis-ci"),
-				Description: github.Ptr("Build succeeded"),
-				TargetURL:   github.Ptr("https://travis-ci.org/owner/repo/builds/123"),
-			},
-			{
-				State:       github.Ptr("success"),
-				Context:     github.Ptr("codecov/patch"),
-				Description: github.Ptr("Coverage increased"),
-				TargetURL:   github.Ptr("https://codecov.io/gh/owner/repo/pull/42"),
-			},
-			{
-				State:       github.Ptr("success"),
-				Context:     github.Ptr("lint/golangci-lint"),
-				Description: github.Ptr("No issues found"),
-				TargetURL:   github.Ptr("https://golangci.com/r/owner/repo/pull/42"),
+	pullRequest42 := map[string]interface{}{
+		"data": map[string]interface{}{
+			"repository": map[string]interface{}{
+				"issueOrPullRequest": map[string]interface{}{"id": "PR_42"},
 			},
 		},
 	}
@@ -718,20 +1020,25 @@ func Test_GetPullRequestStatus(t *testing.T) {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedStatus *github.CombinedStatus
+		expectedResult string
 		expectedErrMsg string
 	}{
 		{
-			name: "successful status fetch",
+			name: "auto-merge disabled",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposPullsByOwnerByRepoByPullNumber,
-					mockPR,
-				),
-				mock.WithRequestMatch(
-					mock.GetReposCommitsStatusByOwnerByRepoByRef,
-					mockStatus,
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					graphQLHandler(pullRequest42, map[string]interface{}{
+						"data": map[string]interface{}{
+							"disablePullRequestAutoMerge": map[string]interface{}{
+								"pullRequest": map[string]interface{}{
+									"number":           42,
+									"url":              "https://github.com/owner/repo/pull/42",
+									"autoMergeRequest": nil,
+								},
+							},
+						},
+					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -739,40 +1046,40 @@ func Test_GetPullRequestStatus(t *testing.T) {
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:    false,
-			expectedStatus: mockStatus,
+			expectedResult: `{"number": 42, "url": "https://github.com/owner/repo/pull/42", "auto_merge_enabled": false}`,
 		},
 		{
-			name: "PR fetch fails",
+			name: "pull request not found",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposPullsByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
-					}),
+					postGraphQL,
+					graphQLHandler(map[string]interface{}{
+						"data": map[string]interface{}{
+							"repository": map[string]interface{}{"issueOrPullRequest": nil},
+						},
+						"errors": []map[string]interface{}{
+							{"type": "NOT_FOUND", "message": "Could not resolve to an issue or pull request with the number of 42."},
+						},
+					}, nil),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
-				"pullNumber": float64(999),
+				"pullNumber": float64(42),
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get pull request",
+			expectedErrMsg: "issue or pull request not found: owner/repo#42",
 		},
 		{
-			name: "status fetch fails",
+			name: "mutation fails",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposPullsByOwnerByRepoByPullNumber,
-					mockPR,
-				),
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsStatusesByOwnerByRepoByRef,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					postGraphQL,
+					graphQLHandler(pullRequest42, map[string]interface{}{
+						"data": map[string]interface{}{"disablePullRequestAutoMerge": nil},
+						"errors": []map[string]interface{}{
+							{"message": "Resource not accessible by integration"},
+						},
 					}),
 				),
 			),
@@ -781,8 +1088,7 @@ func Test_GetPullRequestStatus(t *testing.T) {
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get combined status",
+			expectedErrMsg: "failed to disable auto-merge: graphql: Resource not accessible by integration",
 		},
 	}
 
@@ -790,126 +1096,133 @@ func Test_GetPullRequestStatus(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestStatus(stubGetClientFn(client), translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			_, handler := DisablePullRequestAutoMerge(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Call handler
-			result, err := handler(context.Background(), request)
-
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				return
-			}
-
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedStatus github.CombinedStatus
-			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
-			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedStatus.State, *returnedStatus.State)
-			assert.Equal(t, *tc.expectedStatus.TotalCount, *returnedStatus.TotalCount)
-			assert.Len(t, returnedStatus.Statuses, len(tc.expectedStatus.Statuses))
-			for i, status := range returnedStatus.Statuses {
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].State, *status.State)
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].Context, *status.Context)
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].Description, *status.Description)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
 			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
 		})
 	}
 }
 
-func Test_UpdatePullRequestBranch(t *testing.T) {
-	// Verify tool definition once
+func Test_PullRequestDraftState(t *testing.T) {
+	// Verify tool definitions once
 	mockClient := github.NewClient(nil)
-	tool, _ := UpdatePullRequestBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := MarkPullRequestReadyForReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "update_pull_request_branch", tool.Name)
+	assert.Equal(t, "mark_pull_request_ready_for_review", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
-	assert.Contains(t, tool.InputSchema.Properties, "expectedHeadSha")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock update result for success case
-	mockUpdateResult := &github.PullRequestBranchUpdateResponse{
-		Message: github.Ptr("Branch was updated successfully"),
-		URL:     github.Ptr("https://api.github.com/repos/owner/repo/pulls/42"),
+	tool, _ = ConvertPullRequestToDraft(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "convert_pull_request_to_draft", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// graphQLHandler answers the node ID lookup of pull request 42, and mutation with payload
+	graphQLHandler := func(mutation string, payload interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Query     string                 `json:"query"`
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			switch body.Query {
+			case issueNodeIDQuery:
+				mockResponse(t, http.StatusOK, map[string]interface{}{
+					"data": map[string]interface{}{
+						"repository": map[string]interface{}{
+							"issueOrPullRequest": map[string]interface{}{"id": "PR_42"},
+						},
+					},
+				})(w, r)
+			case mutation:
+				assert.Equal(t, map[string]interface{}{"pullRequestId": "PR_42"}, body.Variables)
+				mockResponse(t, http.StatusOK, payload)(w, r)
+			default:
+				t.Errorf("unexpected query: %s", body.Query)
+			}
+		}
+	}
+	pullRequest := func(field string, draft bool) map[string]interface{} {
+		return map[string]interface{}{
+			"data": map[string]interface{}{
+				field: map[string]interface{}{
+					"pullRequest": map[string]interface{}{
+						"number":  42,
+						"url":     "https://github.com/owner/repo/pull/42",
+						"isDraft": draft,
+					},
+				},
+			},
+		}
+	}
+	requestArgs := map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
 	}
 
 	tests := []struct {
-		name                 string
-		mockedClient         *http.Client
-		requestArgs          map[string]interface{}
-		expectError          bool
-		expectedUpdateResult *github.PullRequestBranchUpdateResponse
-		expectedErrMsg       string
+		name           string
+		newTool        func(GetClientFn, translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc)
+		mockedClient   *http.Client
+		expectedState  pullRequestDraftState
+		expectedErrMsg string
 	}{
 		{
-			name: "successful branch update",
+			name:    "mark ready for review",
+			newTool: MarkPullRequestReadyForReview,
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
-					expectRequestBody(t, map[string]interface{}{
-						"expected_head_sha": "abcd1234",
-					}).andThen(
-						mockResponse(t, http.StatusAccepted, mockUpdateResult),
-					),
+					postGraphQL,
+					graphQLHandler(markPullRequestReadyMutation, pullRequest("markPullRequestReadyForReview", false)),
 				),
 			),
-			requestArgs: map[string]interface{}{
-				"owner":           "owner",
-				"repo":            "repo",
-				"pullNumber":      float64(42),
-				"expectedHeadSha": "abcd1234",
-			},
-			expectError:          false,
-			expectedUpdateResult: mockUpdateResult,
+			expectedState: pullRequestDraftState{Number: 42, URL: "https://github.com/owner/repo/pull/42", Draft: false},
 		},
 		{
-			name: "branch update without expected SHA",
+			name:    "convert to draft",
+			newTool: ConvertPullRequestToDraft,
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
-					expectRequestBody(t, map[string]interface{}{}).andThen(
-						mockResponse(t, http.StatusAccepted, mockUpdateResult),
-					),
+					postGraphQL,
+					graphQLHandler(convertPullRequestToDraftMutation, pullRequest("convertPullRequestToDraft", true)),
 				),
 			),
-			requestArgs: map[string]interface{}{
-				"owner":      "owner",
-				"repo":       "repo",
-				"pullNumber": float64(42),
-			},
-			expectError:          false,
-			expectedUpdateResult: mockUpdateResult,
+			expectedState: pullRequestDraftState{Number: 42, URL: "https://github.com/owner/repo/pull/42", Draft: true},
 		},
 		{
-			name: "branch update fails",
+			name:    "number of an issue",
+			newTool: ConvertPullRequestToDraft,
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusConflict)
-						_, _ = w.Write([]byte(`{"message": "Merge conflict"}`))
+					postGraphQL,
+					graphQLHandler(convertPullRequestToDraftMutation, map[string]interface{}{
+						"data": map[string]interface{}{"convertPullRequestToDraft": nil},
+						"errors": []map[string]interface{}{
+							{"message": "Could not resolve to a node with the global id of 'PR_42'"},
+						},
 					}),
 				),
 			),
-			requestArgs: map[string]interface{}{
-				"owner":      "owner",
-				"repo":       "repo",
-				"pullNumber": float64(42),
-			},
-			expectError:    true,
-			expectedErrMsg: "failed to update pull request branch",
+			expectedErrMsg: "failed to update pull request: graphql: Could not resolve to a node with the global id of 'PR_42'",
 		},
 	}
 
@@ -917,87 +1230,72 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := UpdatePullRequestBranch(stubGetClientFn(client), translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			_, handler := tc.newTool(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Call handler
-			result, err := handler(context.Background(), request)
+			result, err := handler(context.Background(), createMCPRequest(requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
 
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
 				return
 			}
 
-			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
-
-			assert.Contains(t, textContent.Text, "is in progress")
+			var state pullRequestDraftState
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &state))
+			assert.Equal(t, tc.expectedState, state)
 		})
 	}
 }
 
-func Test_GetPullRequestComments(t *testing.T) {
+func Test_GetPullRequestFiles(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetPullRequestFiles(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "get_pull_request_comments", tool.Name)
+	assert.Equal(t, "get_pull_request_files", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock PR comments for success case
-	mockComments := []*github.PullRequestComment{
+	// Setup mock PR files for success case
+	mockFiles := []*github.CommitFile{
 		{
-			ID:      github.Ptr(int64(101)),
-			Body:    github.Ptr("This looks good"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r101"),
-			User: &github.User{
-				Login: github.Ptr("reviewer1"),
-			},
-			Path:      github.Ptr("file1.go"),
-			Position:  github.Ptr(5),
-			CommitID:  github.Ptr("abcdef123456"),
-			CreatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
-			UpdatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+			Filename:  github.Ptr("file1.go"),
+			Status:    github.Ptr("modified"),
+			Additions: github.Ptr(10),
+			Deletions: github.Ptr(5),
+			Changes:   github.Ptr(15),
+			Patch:     github.Ptr("@@ -1,5 +1,10 @@"),
 		},
 		{
-			ID:      github.Ptr(int64(102)),
-			Body:    github.Ptr("Please fix this"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r102"),
-			User: &github.User{
-				Login: github.Ptr("reviewer2"),
-			},
-			Path:      github.Ptr("file2.go"),
-			Position:  github.Ptr(10),
-			CommitID:  github.Ptr("abcdef123456"),
-			CreatedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
-			UpdatedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
+			Filename:  github.Ptr("file2.go"),
+			Status:    github.Ptr("added"),
+			Additions: github.Ptr(20),
+			Deletions: github.Ptr(0),
+			Changes:   github.Ptr(20),
+			Patch:     github.Ptr("@@ -0,0 +1,20 @@"),
 		},
 	}
 
 	tests := []struct {
-		name             string
-		mockedClient     *http.Client
-		requestArgs      map[string]interface{}
-		expectError      bool
-		expectedComments []*github.PullRequestComment
-		expectedErrMsg   string
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedFiles  []*github.CommitFile
+		expectedErrMsg string
 	}{
 		{
-			name: "successful comments fetch",
+			name: "successful files fetch",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
-					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
-					mockComments,
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles,
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -1005,14 +1303,14 @@ func Test_GetPullRequestComments(t *testing.T) {
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:      false,
-			expectedComments: mockComments,
+			expectError:   false,
+			expectedFiles: mockFiles,
 		},
 		{
-			name: "comments fetch fails",
+			name: "files fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 						w.WriteHeader(http.StatusNotFound)
 						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
@@ -1025,7 +1323,7 @@ func Test_GetPullRequestComments(t *testing.T) {
 				"pullNumber": float64(999),
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get pull request comments",
+			expectedErrMsg: "failed to get pull request files",
 		},
 	}
 
@@ -1033,7 +1331,7 @@ func Test_GetPullRequestComments(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestComments(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := GetPullRequestFiles(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1054,56 +1352,59 @@ func Test_GetPullRequestComments(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedComments []*github.PullRequestComment
-			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+			var returnedFiles []*github.CommitFile
+			err = json.Unmarshal([]byte(textContent.Text), &returnedFiles)
 			require.NoError(t, err)
-			assert.Len(t, returnedComments, len(tc.expectedComments))
-			for i, comment := range returnedComments {
-				assert.Equal(t, *tc.expectedComments[i].ID, *comment.ID)
-				assert.Equal(t, *tc.expectedComments[i].Body, *comment.Body)
-				assert.Equal(t, *tc.expectedComments[i].User.Login, *comment.User.Login)
-				assert.Equal(t, *tc.expectedComments[i].Path, *comment.Path)
-				assert.Equal(t, *tc.expectedComments[i].HTMLURL, *comment.HTMLURL)
+			assert.Len(t, returnedFiles, len(tc.expectedFiles))
+			for i, file := range returnedFiles {
+				assert.Equal(t, *tc.expectedFiles[i].Filename, *file.Filename)
+				assert.Equal(t, *tc.expectedFiles[i].Status, *file.Status)
+				assert.Equal(t, *tc.expectedFiles[i].Additions, *file.Additions)
+				assert.Equal(t, *tc.expectedFiles[i].Deletions, *file.Deletions)
 			}
 		})
 	}
 }
 
-func Test_GetPullRequestReviews(t *testing.T) {
+func Test_ListPullRequestCommits(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestReviews(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListPullRequestCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "get_pull_request_reviews", tool.Name)
+	assert.Equal(t, "list_pull_request_commits", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock PR reviews for success case
-	mockReviews := []*github.PullRequestReview{
+	date := time.Date(2025, 4, 1, 9, 30, 0, 0, time.UTC)
+	mockCommits := []*github.RepositoryCommit{
 		{
-			ID:      github.Ptr(int64(201)),
-			State:   github.Ptr("APPROVED"),
-			Body:    github.Ptr("LGTM"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-201"),
-			User: &github.User{
-				Login: github.Ptr("approver"),
+			SHA: github.Ptr("abc123"),
+			Commit: &github.Commit{
+				Message: github.Ptr("feat: add login form"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Mona Octocat"),
+					Email: github.Ptr("mona@example.com"),
+					Date:  &github.Timestamp{Time: date},
+				},
 			},
-			CommitID:    github.Ptr("abcdef123456"),
-			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+			Author: &github.User{Login: github.Ptr("octocat")},
 		},
 		{
-			ID:      github.Ptr(int64(202)),
-			State:   github.Ptr("CHANGES_REQUESTED"),
-			Body:    github.Ptr("Please address the following issues"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-202"),
-			User: &github.User{
-				Login: github.Ptr("reviewer"),
+			SHA: github.Ptr("def456"),
+			Commit: &github.Commit{
+				Message: github.Ptr("fix typo"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Someone Else"),
+					Email: github.Ptr("someone@example.com"),
+					Date:  &github.Timestamp{Time: date.Add(time.Hour)},
+				},
 			},
-			CommitID:    github.Ptr("abcdef123456"),
-			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
+			// Commits whose email is not linked to a GitHub account have no author
 		},
 	}
 
@@ -1112,43 +1413,1411 @@ func Test_GetPullRequestReviews(t *testing.T) {
 		mockedClient    *http.Client
 		requestArgs     map[string]interface{}
 		expectError     bool
-		expectedReviews []*github.PullRequestReview
+		expectedCommits []pullRequestCommit
 		expectedErrMsg  string
 	}{
 		{
-			name: "successful reviews fetch",
+			name: "list commits with pagination",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
-					mockReviews,
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "5",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
+				"page":       float64(2),
+				"perPage":    float64(5),
 			},
-			expectError:     false,
-			expectedReviews: mockReviews,
-		},
-		{
+			expectError: false,
+			expectedCommits: []pullRequestCommit{
+				{
+					SHA:     "abc123",
+					Message: "feat: add login form",
+					Author:  pullRequestCommitAuthor{Name: "Mona Octocat", Email: "mona@example.com", Login: "octocat", Date: github.Timestamp{Time: date}},
+				},
+				{
+					SHA:     "def456",
+					Message: "fix typo",
+					Author:  pullRequestCommitAuthor{Name: "Someone Else", Email: "someone@example.com", Date: github.Timestamp{Time: date.Add(time.Hour)}},
+				},
+			},
+		},
+		{
+			name: "list commits fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommitsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list pull request commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListPullRequestCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedCommits []pullRequestCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
+			require.NoError(t, err)
+			require.Len(t, returnedCommits, len(tc.expectedCommits))
+			for i, commit := range returnedCommits {
+				assert.Equal(t, tc.expectedCommits[i].SHA, commit.SHA)
+				assert.Equal(t, tc.expectedCommits[i].Message, commit.Message)
+				assert.Equal(t, tc.expectedCommits[i].Author.Name, commit.Author.Name)
+				assert.Equal(t, tc.expectedCommits[i].Author.Email, commit.Author.Email)
+				assert.Equal(t, tc.expectedCommits[i].Author.Login, commit.Author.Login)
+				assert.True(t, tc.expectedCommits[i].Author.Date.Equal(commit.Author.Date))
+			}
+		})
+	}
+}
+
+func Test_GetReferencedPullRequestsForCommit(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetReferencedPullRequestsForCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_referenced_pull_requests_for_commit", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	mergedAt := github.Timestamp{Time: time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)}
+	mockPulls := []*github.PullRequest{
+		{
+			Number:   github.Ptr(42),
+			Title:    github.Ptr("Add feature"),
+			State:    github.Ptr("closed"),
+			MergedAt: &mergedAt,
+			HTMLURL:  github.Ptr("https://github.com/owner/repo/pull/42"),
+		},
+		{
+			Number:  github.Ptr(43),
+			Title:   github.Ptr("Backport feature"),
+			State:   github.Ptr("open"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/43"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedPulls  []commitPullRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "successful pull requests fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockPulls),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+			},
+			expectError: false,
+			expectedPulls: []commitPullRequest{
+				{Number: 42, Title: "Add feature", State: "closed", Merged: true, MergedAt: &mergedAt, URL: "https://github.com/owner/repo/pull/42"},
+				{Number: 43, Title: "Backport feature", State: "open", Merged: false, URL: "https://github.com/owner/repo/pull/43"},
+			},
+		},
+		{
+			name: "commit not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "No commit found for SHA: missing"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "commit not found: missing in owner/repo",
+		},
+		{
+			name: "list pull requests fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+				"sha":   "abc123",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list pull requests for commit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetReferencedPullRequestsForCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedPulls []commitPullRequest
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPulls)
+			require.NoError(t, err)
+			require.Len(t, returnedPulls, len(tc.expectedPulls))
+			for i, pr := range returnedPulls {
+				assert.Equal(t, tc.expectedPulls[i].Number, pr.Number)
+				assert.Equal(t, tc.expectedPulls[i].Title, pr.Title)
+				assert.Equal(t, tc.expectedPulls[i].State, pr.State)
+				assert.Equal(t, tc.expectedPulls[i].Merged, pr.Merged)
+				assert.Equal(t, tc.expectedPulls[i].URL, pr.URL)
+				if tc.expectedPulls[i].MergedAt != nil {
+					require.NotNil(t, pr.MergedAt)
+					assert.True(t, tc.expectedPulls[i].MergedAt.Equal(*pr.MergedAt))
+				}
+			}
+		})
+	}
+}
+
+func Test_GetPullRequestStatus(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pull_request_status", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock PR for successful PR fetch
+	mockPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		Title:   github.Ptr("Test PR"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+		Head: &github.PullRequestBranch{
+			SHA: github.Ptr("abcd1234"),
+			Ref: github.Ptr("feature-branch"),
+		},
+	}
+
+	// Setup mock status for success case
+	mockStatus := &github.CombinedStatus{
+		State:      github.Ptr("success"),
+		TotalCount: github.Ptr(3),
+		Statuses: []*github.RepoStatus{
+			{
+				State:       github.Ptr("success"),
+				Context:     github.Ptr("continuous-integration/travis-ci"),
+				Description: github.Ptr("Build succeeded"),
+				TargetURL:   github.Ptr("https://travis-ci.org/owner/repo/builds/123"),
+			},
+			{
+				State:       github.Ptr("success"),
+				Context:     github.Ptr("codecov/patch"),
+				Description: github.Ptr("Coverage increased"),
+				TargetURL:   github.Ptr("https://codecov.io/gh/owner/repo/pull/42"),
+			},
+			{
+				State:       github.Ptr("success"),
+				Context:     github.Ptr("lint/golangci-lint"),
+				Description: github.Ptr("No issues found"),
+				TargetURL:   github.Ptr("https://golangci.com/r/owner/repo/pull/42"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedStatus *github.CombinedStatus
+		expectedErrMsg string
+	}{
+		{
+			name: "successful status fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    false,
+			expectedStatus: mockStatus,
+		},
+		{
+			name: "PR fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+		{
+			name: "status fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsStatusesByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get combined status",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestStatus(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedStatus github.CombinedStatus
+			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedStatus.State, *returnedStatus.State)
+			assert.Equal(t, *tc.expectedStatus.TotalCount, *returnedStatus.TotalCount)
+			assert.Len(t, returnedStatus.Statuses, len(tc.expectedStatus.Statuses))
+			for i, status := range returnedStatus.Statuses {
+				assert.Equal(t, *tc.expectedStatus.Statuses[i].State, *status.State)
+				assert.Equal(t, *tc.expectedStatus.Statuses[i].Context, *status.Context)
+				assert.Equal(t, *tc.expectedStatus.Statuses[i].Description, *status.Description)
+			}
+		})
+	}
+}
+
+func Test_CheckPullRequestMergeable(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CheckPullRequestMergeable(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "check_pull_request_mergeable", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "max_attempts")
+	assert.Contains(t, tool.InputSchema.Properties, "interval_ms")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	pending := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		MergeableState: github.Ptr("unknown"),
+	}
+	computed := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		Mergeable:      github.Ptr(false),
+		MergeableState: github.Ptr("dirty"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult pullRequestMergeability
+		expectedErrMsg string
+	}{
+		{
+			name: "mergeability computed after polling",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					pending,
+					pending,
+					computed,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"interval_ms": float64(1),
+			},
+			expectError:    false,
+			expectedResult: pullRequestMergeability{Mergeable: github.Ptr(false), MergeableState: "dirty", State: "open", Attempts: 3},
+		},
+		{
+			name: "mergeability still unknown after the last attempt",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					pending,
+					pending,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"pullNumber":   float64(42),
+				"max_attempts": float64(2),
+				"interval_ms":  float64(1),
+			},
+			expectError:    false,
+			expectedResult: pullRequestMergeability{MergeableState: "unknown", State: "open", Attempts: 2},
+		},
+		{
+			name: "merged pull request is not polled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{
+						Number:         github.Ptr(42),
+						State:          github.Ptr("closed"),
+						Merged:         github.Ptr(true),
+						MergeableState: github.Ptr("unknown"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    false,
+			expectedResult: pullRequestMergeability{MergeableState: "unknown", State: "closed", Merged: true, Attempts: 1},
+		},
+		{
+			name:         "too many attempts",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"pullNumber":   float64(42),
+				"max_attempts": float64(100),
+			},
+			expectError:    false,
+			expectedErrMsg: "max_attempts must be between 1 and 20",
+		},
+		{
+			name: "PR fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CheckPullRequestMergeable(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned pullRequestMergeability
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UpdatePullRequestBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdatePullRequestBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_pull_request_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "expectedHeadSha")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock update result for success case
+	mockUpdateResult := &github.PullRequestBranchUpdateResponse{
+		Message: github.Ptr("Branch was updated successfully"),
+		URL:     github.Ptr("https://api.github.com/repos/owner/repo/pulls/42"),
+	}
+
+	tests := []struct {
+		name                 string
+		mockedClient         *http.Client
+		requestArgs          map[string]interface{}
+		expectError          bool
+		expectedUpdateResult *github.PullRequestBranchUpdateResponse
+		expectedErrMsg       string
+	}{
+		{
+			name: "successful branch update",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"expected_head_sha": "abcd1234",
+					}).andThen(
+						mockResponse(t, http.StatusAccepted, mockUpdateResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"expectedHeadSha": "abcd1234",
+			},
+			expectError:          false,
+			expectedUpdateResult: mockUpdateResult,
+		},
+		{
+			name: "branch update without expected SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{}).andThen(
+						mockResponse(t, http.StatusAccepted, mockUpdateResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:          false,
+			expectedUpdateResult: mockUpdateResult,
+		},
+		{
+			name: "branch update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsUpdateBranchByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"message": "Merge conflict"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update pull request branch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdatePullRequestBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			assert.Contains(t, textContent.Text, "is in progress")
+		})
+	}
+}
+
+func Test_GetPullRequestComments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pull_request_comments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock PR comments for success case
+	mockComments := []*github.PullRequestComment{
+		{
+			ID:      github.Ptr(int64(101)),
+			Body:    github.Ptr("This looks good"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r101"),
+			User: &github.User{
+				Login: github.Ptr("reviewer1"),
+			},
+			Path:      github.Ptr("file1.go"),
+			Position:  github.Ptr(5),
+			CommitID:  github.Ptr("abcdef123456"),
+			CreatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+			UpdatedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+		},
+		{
+			ID:      github.Ptr(int64(102)),
+			Body:    github.Ptr("Please fix this"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r102"),
+			User: &github.User{
+				Login: github.Ptr("reviewer2"),
+			},
+			Path:      github.Ptr("file2.go"),
+			Position:  github.Ptr(10),
+			CommitID:  github.Ptr("abcdef123456"),
+			CreatedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
+			UpdatedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedComments []*github.PullRequestComment
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful comments fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mockComments,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:      false,
+			expectedComments: mockComments,
+		},
+		{
+			name: "comments fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request comments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedComments []*github.PullRequestComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+			require.NoError(t, err)
+			assert.Len(t, returnedComments, len(tc.expectedComments))
+			for i, comment := range returnedComments {
+				assert.Equal(t, *tc.expectedComments[i].ID, *comment.ID)
+				assert.Equal(t, *tc.expectedComments[i].Body, *comment.Body)
+				assert.Equal(t, *tc.expectedComments[i].User.Login, *comment.User.Login)
+				assert.Equal(t, *tc.expectedComments[i].Path, *comment.Path)
+				assert.Equal(t, *tc.expectedComments[i].HTMLURL, *comment.HTMLURL)
+			}
+		})
+	}
+}
+
+func Test_GetPullRequestReviews(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestReviews(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pull_request_reviews", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock PR reviews for success case
+	mockReviews := []*github.PullRequestReview{
+		{
+			ID:      github.Ptr(int64(201)),
+			State:   github.Ptr("APPROVED"),
+			Body:    github.Ptr("LGTM"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-201"),
+			User: &github.User{
+				Login: github.Ptr("approver"),
+			},
+			CommitID:    github.Ptr("abcdef123456"),
+			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+		},
+		{
+			ID:      github.Ptr(int64(202)),
+			State:   github.Ptr("CHANGES_REQUESTED"),
+			Body:    github.Ptr("Please address the following issues"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-202"),
+			User: &github.User{
+				Login: github.Ptr("reviewer"),
+			},
+			CommitID:    github.Ptr("abcdef123456"),
+			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedReviews []*github.PullRequestReview
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful reviews fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:     false,
+			expectedReviews: mockReviews,
+		},
+		{
 			name: "reviews fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request reviews",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestReviews(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedReviews []*github.PullRequestReview
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReviews)
+			require.NoError(t, err)
+			assert.Len(t, returnedReviews, len(tc.expectedReviews))
+			for i, review := range returnedReviews {
+				assert.Equal(t, *tc.expectedReviews[i].ID, *review.ID)
+				assert.Equal(t, *tc.expectedReviews[i].State, *review.State)
+				assert.Equal(t, *tc.expectedReviews[i].Body, *review.Body)
+				assert.Equal(t, *tc.expectedReviews[i].User.Login, *review.User.Login)
+				assert.Equal(t, *tc.expectedReviews[i].HTMLURL, *review.HTMLURL)
+			}
+		})
+	}
+}
+
+func Test_ListRequestedReviewers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRequestedReviewers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_requested_reviewers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "users and teams requested",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					&github.Reviewers{
+						Users: []*github.User{{Login: github.Ptr("octocat"), ID: github.Ptr(int64(1))}},
+						Teams: []*github.Team{{Slug: github.Ptr("maintainers"), Name: github.Ptr("Maintainers")}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedResult: `{"users": ["octocat"], "teams": ["maintainers"]}`,
+		},
+		{
+			name: "no reviewers requested",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					&github.Reviewers{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedResult: `{"users": [], "teams": []}`,
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectedErrMsg: "pull request 999 not found in owner/repo",
+		},
+		{
+			name: "server error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list requested reviewers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRequestedReviewers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
+
+func Test_CreatePullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreatePullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "event")
+	assert.Contains(t, tool.InputSchema.Properties, "commitId")
+	assert.Contains(t, tool.InputSchema.Properties, "comments")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock review for success case
+	mockReview := &github.PullRequestReview{
+		ID:      github.Ptr(int64(301)),
+		State:   github.Ptr("APPROVED"),
+		Body:    github.Ptr("Looks good!"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-301"),
+		User: &github.User{
+			Login: github.Ptr("reviewer"),
+		},
+		CommitID:    github.Ptr("abcdef123456"),
+		SubmittedAt: &github.Timestamp{Time: time.Now()},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedReview *github.PullRequestReview
+		expectedErrMsg string
+	}{
+		{
+			name: "successful review creation with body only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body":  "Looks good!",
+						"event": "APPROVE",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"body":       "Looks good!",
+				"event":      "APPROVE",
+			},
+			expectError:    false,
+			expectedReview: mockReview,
+		},
+		{
+			name: "successful review creation with commitId",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body":      "Looks good!",
+						"event":     "APPROVE",
+						"commit_id": "abcdef123456",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"body":       "Looks good!",
+				"event":      "APPROVE",
+				"commitId":   "abcdef123456",
+			},
+			expectError:    false,
+			expectedReview: mockReview,
+		},
+		{
+			name: "successful review creation with comments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body":  "Some issues to fix",
+						"event": "REQUEST_CHANGES",
+						"comments": []interface{}{
+							map[string]interface{}{
+								"path":     "file1.go",
+								"position": float64(10),
+								"body":     "This needs to be fixed",
+							},
+							map[string]interface{}{
+								"path":     "file2.go",
+								"position": float64(20),
+								"body":     "Consider a different approach here",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"body":       "Some issues to fix",
+				"event":      "REQUEST_CHANGES",
+				"comments": []interface{}{
+					map[string]interface{}{
+						"path":     "file1.go",
+						"position": float64(10),
+						"body":     "This needs to be fixed",
+					},
+					map[string]interface{}{
+						"path":     "file2.go",
+						"position": float64(20),
+						"body":     "Consider a different approach here",
+					},
+				},
+			},
+			expectError:    false,
+			expectedReview: mockReview,
+		},
+		{
+			name: "invalid comment format",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Invalid comment format"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "REQUEST_CHANGES",
+				"comments": []interface{}{
+					map[string]interface{}{
+						"path": "file1.go",
+						// missing position
+						"body": "This needs to be fixed",
+					},
+				},
+			},
+			expectError:    false,
+			expectedErrMsg: "each comment must have either position or line",
+		},
+		{
+			name: "successful review creation with line parameter",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body":  "Code review comments",
+						"event": "COMMENT",
+						"comments": []interface{}{
+							map[string]interface{}{
+								"path": "main.go",
+								"line": float64(42),
+								"body": "Consider adding a comment here",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"body":       "Code review comments",
+				"event":      "COMMENT",
+				"comments": []interface{}{
+					map[string]interface{}{
+						"path": "main.go",
+						"line": float64(42),
+						"body": "Consider adding a comment here",
+					},
+				},
+			},
+			expectError:    false,
+			expectedReview: mockReview,
+		},
+		{
+			name: "successful review creation with multi-line comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body":  "Multi-line comment review",
+						"event": "COMMENT",
+						"comments": []interface{}{
+							map[string]interface{}{
+								"path":       "main.go",
+								"start_line": float64(10),
+								"line":       float64(15),
+								"side":       "RIGHT",
+								"body":       "This entire block needs refactoring",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"body":       "Multi-line comment review",
+				"event":      "COMMENT",
+				"comments": []interface{}{
+					map[string]interface{}{
+						"path":       "main.go",
+						"start_line": float64(10),
+						"line":       float64(15),
+						"side":       "RIGHT",
+						"body":       "This entire block needs refactoring",
+					},
+				},
+			},
+			expectError:    false,
+			expectedReview: mockReview,
+		},
+		{
+			name:         "invalid multi-line comment - missing line parameter",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "COMMENT",
+				"comments": []interface{}{
+					map[string]interface{}{
+						"path":       "main.go",
+						"start_line": float64(10),
+						// missing line parameter
+						"body": "Invalid multi-line comment",
+					},
+				},
+			},
+			expectError:    false,
+			expectedErrMsg: "each comment must have either position or line", // Updated error message
+		},
+		{
+			name: "invalid comment - mixing position with line parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReview,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "COMMENT",
+				"comments": []interface{}{
+					map[string]interface{}{
+						"path":     "main.go",
+						"position": float64(5),
+						"line":     float64(42),
+						"body":     "Invalid parameter combination",
+					},
+				},
+			},
+			expectError:    false,
+			expectedErrMsg: "position cannot be combined with line, side, start_line, or start_side",
+		},
+		{
+			name:         "invalid multi-line comment - missing side parameter",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "COMMENT",
+				"comments": []interface{}{
+					map[string]interface{}{
+						"path":       "main.go",
+						"start_line": float64(10),
+						"line":       float64(15),
+						"start_side": "LEFT",
+						// missing side parameter
+						"body": "Invalid multi-line comment",
+					},
+				},
+			},
+			expectError:    false,
+			expectedErrMsg: "if start_side is provided, side must also be provided",
+		},
+		{
+			name: "pending review without event",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body": "Looks good!",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"body":       "Looks good!",
+			},
+			expectError:    false,
+			expectedReview: mockReview,
+		},
+		{
+			name:         "invalid event",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "MERGE",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid event "MERGE", must be one of: APPROVE, REQUEST_CHANGES, COMMENT`,
+		},
+		{
+			name: "review creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Invalid comment format"}`))
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
-				"pullNumber": float64(999),
+				"pullNumber": float64(42),
+				"body":       "Looks good!",
+				"event":      "APPROVE",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get pull request reviews",
+			expectedErrMsg: "failed to create pull request review",
 		},
 	}
 
@@ -1156,7 +2825,7 @@ func Test_GetPullRequestReviews(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestReviews(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := CreatePullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1173,72 +2842,103 @@ func Test_GetPullRequestReviews(t *testing.T) {
 
 			require.NoError(t, err)
 
+			// For error messages in the result
+			if tc.expectedErrMsg != "" {
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedReviews []*github.PullRequestReview
-			err = json.Unmarshal([]byte(textContent.Text), &returnedReviews)
+			var returnedReview github.PullRequestReview
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReview)
 			require.NoError(t, err)
-			assert.Len(t, returnedReviews, len(tc.expectedReviews))
-			for i, review := range returnedReviews {
-				assert.Equal(t, *tc.expectedReviews[i].ID, *review.ID)
-				assert.Equal(t, *tc.expectedReviews[i].State, *review.State)
-				assert.Equal(t, *tc.expectedReviews[i].Body, *review.Body)
-				assert.Equal(t, *tc.expectedReviews[i].User.Login, *review.User.Login)
-				assert.Equal(t, *tc.expectedReviews[i].HTMLURL, *review.HTMLURL)
-			}
+			assert.Equal(t, *tc.expectedReview.ID, *returnedReview.ID)
+			assert.Equal(t, *tc.expectedReview.State, *returnedReview.State)
+			assert.Equal(t, *tc.expectedReview.Body, *returnedReview.Body)
+			assert.Equal(t, *tc.expectedReview.User.Login, *returnedReview.User.Login)
+			assert.Equal(t, *tc.expectedReview.HTMLURL, *returnedReview.HTMLURL)
 		})
 	}
 }
 
-func Test_CreatePullRequestReview(t *testing.T) {
+func Test_AddPullRequestReviewComment(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreatePullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := AddPullRequestReviewComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "create_pull_request_review", tool.Name)
+	assert.Equal(t, "add_pull_request_review_comment", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "review_id")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
 	assert.Contains(t, tool.InputSchema.Properties, "body")
-	assert.Contains(t, tool.InputSchema.Properties, "event")
-	assert.Contains(t, tool.InputSchema.Properties, "commitId")
-	assert.Contains(t, tool.InputSchema.Properties, "comments")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "event"})
-
-	// Setup mock review for success case
-	mockReview := &github.PullRequestReview{
-		ID:      github.Ptr(int64(301)),
-		State:   github.Ptr("APPROVED"),
-		Body:    github.Ptr("Looks good!"),
-		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-301"),
-		User: &github.User{
-			Login: github.Ptr("reviewer"),
+	assert.Contains(t, tool.InputSchema.Properties, "line")
+	assert.Contains(t, tool.InputSchema.Properties, "side")
+	assert.Contains(t, tool.InputSchema.Properties, "start_line")
+	assert.Contains(t, tool.InputSchema.Properties, "start_side")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "review_id", "path", "body", "line"})
+
+	pendingReview := &github.PullRequestReview{
+		ID:     github.Ptr(int64(301)),
+		NodeID: github.Ptr("PRR_301"),
+		State:  github.Ptr("PENDING"),
+	}
+	submittedReview := &github.PullRequestReview{
+		ID:     github.Ptr(int64(301)),
+		NodeID: github.Ptr("PRR_301"),
+		State:  github.Ptr("APPROVED"),
+	}
+	addedThread := map[string]interface{}{
+		"data": map[string]interface{}{
+			"addPullRequestReviewThread": map[string]interface{}{
+				"thread": map[string]interface{}{
+					"id": "PRRT_1",
+					"comments": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"databaseId": 501, "url": "https://github.com/owner/repo/pull/42#discussion_r501"},
+						},
+					},
+				},
+			},
 		},
-		CommitID:    github.Ptr("abcdef123456"),
-		SubmittedAt: &github.Timestamp{Time: time.Now()},
 	}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedReview *github.PullRequestReview
-		expectedErrMsg string
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedComment pendingReviewComment
+		expectedErrMsg  string
 	}{
 		{
-			name: "successful review creation with body only",
+			name: "add multi-line comment",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+					pendingReview,
+				),
 				mock.WithRequestMatchHandler(
-					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					postGraphQL,
 					expectRequestBody(t, map[string]interface{}{
-						"body":  "Looks good!",
-						"event": "APPROVE",
+						"query": addPullRequestReviewThreadMutation,
+						"variables": map[string]interface{}{
+							"reviewId":  "PRR_301",
+							"path":      "main.go",
+							"body":      "Extract this",
+							"line":      float64(15),
+							"side":      "RIGHT",
+							"startLine": float64(10),
+							"startSide": "RIGHT",
+						},
 					}).andThen(
-						mockResponse(t, http.StatusOK, mockReview),
+						mockResponse(t, http.StatusOK, addedThread),
 					),
 				),
 			),
@@ -1246,92 +2946,73 @@ func Test_CreatePullRequestReview(t *testing.T) {
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"body":       "Looks good!",
-				"event":      "APPROVE",
+				"review_id":  float64(301),
+				"path":       "main.go",
+				"body":       "Extract this",
+				"line":       float64(15),
+				"side":       "RIGHT",
+				"start_line": float64(10),
+				"start_side": "RIGHT",
+			},
+			expectError: false,
+			expectedComment: pendingReviewComment{
+				ThreadID:  "PRRT_1",
+				CommentID: 501,
+				URL:       "https://github.com/owner/repo/pull/42#discussion_r501",
 			},
-			expectError:    false,
-			expectedReview: mockReview,
 		},
 		{
-			name: "successful review creation with commitId",
+			name: "review already submitted",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
-					expectRequestBody(t, map[string]interface{}{
-						"body":      "Looks good!",
-						"event":     "APPROVE",
-						"commit_id": "abcdef123456",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockReview),
-					),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+					submittedReview,
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"body":       "Looks good!",
-				"event":      "APPROVE",
-				"commitId":   "abcdef123456",
+				"review_id":  float64(301),
+				"path":       "main.go",
+				"body":       "Extract this",
+				"line":       float64(15),
 			},
 			expectError:    false,
-			expectedReview: mockReview,
+			expectedErrMsg: "review 301 is approved, comments can only be added to a pending review",
 		},
 		{
-			name: "successful review creation with comments",
+			name: "review not found",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
-					expectRequestBody(t, map[string]interface{}{
-						"body":  "Some issues to fix",
-						"event": "REQUEST_CHANGES",
-						"comments": []interface{}{
-							map[string]interface{}{
-								"path":     "file1.go",
-								"position": float64(10),
-								"body":     "This needs to be fixed",
-							},
-							map[string]interface{}{
-								"path":     "file2.go",
-								"position": float64(20),
-								"body":     "Consider a different approach here",
-							},
-						},
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockReview),
-					),
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"body":       "Some issues to fix",
-				"event":      "REQUEST_CHANGES",
-				"comments": []interface{}{
-					map[string]interface{}{
-						"path":     "file1.go",
-						"position": float64(10),
-						"body":     "This needs to be fixed",
-					},
-					map[string]interface{}{
-						"path":     "file2.go",
-						"position": float64(20),
-						"body":     "Consider a different approach here",
-					},
-				},
+				"review_id":  float64(999),
+				"path":       "main.go",
+				"body":       "Extract this",
+				"line":       float64(15),
 			},
 			expectError:    false,
-			expectedReview: mockReview,
+			expectedErrMsg: "review not found: 999 on owner/repo#42",
 		},
 		{
-			name: "invalid comment format",
+			name: "line outside the diff",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+					pendingReview,
+				),
 				mock.WithRequestMatchHandler(
-					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Invalid comment format"}`))
+					postGraphQL,
+					mockResponse(t, http.StatusOK, map[string]interface{}{
+						"data":   map[string]interface{}{"addPullRequestReviewThread": nil},
+						"errors": []map[string]interface{}{{"message": "Line could not be resolved"}},
 					}),
 				),
 			),
@@ -1339,72 +3020,105 @@ func Test_CreatePullRequestReview(t *testing.T) {
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"event":      "REQUEST_CHANGES",
-				"comments": []interface{}{
-					map[string]interface{}{
-						"path": "file1.go",
-						// missing position
-						"body": "This needs to be fixed",
-					},
-				},
+				"review_id":  float64(301),
+				"path":       "main.go",
+				"body":       "Extract this",
+				"line":       float64(1000),
 			},
 			expectError:    false,
-			expectedErrMsg: "each comment must have either position or line",
+			expectedErrMsg: "failed to add pull request review comment: graphql: Line could not be resolved",
 		},
 		{
-			name: "successful review creation with line parameter",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
-					expectRequestBody(t, map[string]interface{}{
-						"body":  "Code review comments",
-						"event": "COMMENT",
-						"comments": []interface{}{
-							map[string]interface{}{
-								"path": "main.go",
-								"line": float64(42),
-								"body": "Consider adding a comment here",
-							},
-						},
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockReview),
-					),
-				),
-			),
+			name:         "start_side without start_line",
+			mockedClient: mock.NewMockedHTTPClient(),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"body":       "Code review comments",
-				"event":      "COMMENT",
-				"comments": []interface{}{
-					map[string]interface{}{
-						"path": "main.go",
-						"line": float64(42),
-						"body": "Consider adding a comment here",
-					},
-				},
+				"review_id":  float64(301),
+				"path":       "main.go",
+				"body":       "Extract this",
+				"line":       float64(15),
+				"start_side": "LEFT",
 			},
 			expectError:    false,
-			expectedReview: mockReview,
+			expectedErrMsg: "start_side requires start_line",
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddPullRequestReviewComment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returnedComment pendingReviewComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComment)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedComment, returnedComment)
+		})
+	}
+}
+
+func Test_SubmitPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SubmitPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "submit_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "review_id")
+	assert.Contains(t, tool.InputSchema.Properties, "event")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "review_id", "event"})
+
+	mockReview := &github.PullRequestReview{
+		ID:    github.Ptr(int64(301)),
+		State: github.Ptr("CHANGES_REQUESTED"),
+		Body:  github.Ptr("A few things to fix"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedReview *github.PullRequestReview
+		expectedErrMsg string
+	}{
 		{
-			name: "successful review creation with multi-line comment",
+			name: "submit review",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					mock.PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewId,
 					expectRequestBody(t, map[string]interface{}{
-						"body":  "Multi-line comment review",
-						"event": "COMMENT",
-						"comments": []interface{}{
-							map[string]interface{}{
-								"path":       "main.go",
-								"start_line": float64(10),
-								"line":       float64(15),
-								"side":       "RIGHT",
-								"body":       "This entire block needs refactoring",
-							},
-						},
+						"event": "REQUEST_CHANGES",
+						"body":  "A few things to fix",
 					}).andThen(
 						mockResponse(t, http.StatusOK, mockReview),
 					),
@@ -1414,108 +3128,61 @@ func Test_CreatePullRequestReview(t *testing.T) {
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"body":       "Multi-line comment review",
-				"event":      "COMMENT",
-				"comments": []interface{}{
-					map[string]interface{}{
-						"path":       "main.go",
-						"start_line": float64(10),
-						"line":       float64(15),
-						"side":       "RIGHT",
-						"body":       "This entire block needs refactoring",
-					},
-				},
+				"review_id":  float64(301),
+				"event":      "REQUEST_CHANGES",
+				"body":       "A few things to fix",
 			},
 			expectError:    false,
 			expectedReview: mockReview,
 		},
 		{
-			name:         "invalid multi-line comment - missing line parameter",
+			name:         "invalid event",
 			mockedClient: mock.NewMockedHTTPClient(),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"event":      "COMMENT",
-				"comments": []interface{}{
-					map[string]interface{}{
-						"path":       "main.go",
-						"start_line": float64(10),
-						// missing line parameter
-						"body": "Invalid multi-line comment",
-					},
-				},
+				"review_id":  float64(301),
+				"event":      "PENDING",
 			},
 			expectError:    false,
-			expectedErrMsg: "each comment must have either position or line", // Updated error message
+			expectedErrMsg: `invalid event "PENDING", must be one of: APPROVE, REQUEST_CHANGES, COMMENT`,
 		},
 		{
-			name: "invalid comment - mixing position with line parameters",
+			name: "review already submitted",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
-					mockReview,
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewId,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Can not submit a non-pending review"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"event":      "COMMENT",
-				"comments": []interface{}{
-					map[string]interface{}{
-						"path":     "main.go",
-						"position": float64(5),
-						"line":     float64(42),
-						"body":     "Invalid parameter combination",
-					},
-				},
-			},
-			expectError:    false,
-			expectedErrMsg: "position cannot be combined with line, side, start_line, or start_side",
-		},
-		{
-			name:         "invalid multi-line comment - missing side parameter",
-			mockedClient: mock.NewMockedHTTPClient(),
-			requestArgs: map[string]interface{}{
-				"owner":      "owner",
-				"repo":       "repo",
-				"pullNumber": float64(42),
-				"event":      "COMMENT",
-				"comments": []interface{}{
-					map[string]interface{}{
-						"path":       "main.go",
-						"start_line": float64(10),
-						"line":       float64(15),
-						"start_side": "LEFT",
-						// missing side parameter
-						"body": "Invalid multi-line comment",
-					},
-				},
+				"review_id":  float64(301),
+				"event":      "APPROVE",
 			},
 			expectError:    false,
-			expectedErrMsg: "if start_side is provided, side must also be provided",
+			expectedErrMsg: "failed to submit pull request review",
 		},
 		{
-			name: "review creation fails",
+			name: "review not found",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Invalid comment format"}`))
-					}),
+					mock.PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"body":       "Looks good!",
+				"review_id":  float64(999),
 				"event":      "APPROVE",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to create pull request review",
+			expectError:    false,
+			expectedErrMsg: "review not found: 999 on owner/repo#42",
 		},
 	}
 
@@ -1523,7 +3190,7 @@ func Test_CreatePullRequestReview(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreatePullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := SubmitPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1539,26 +3206,20 @@ func Test_CreatePullRequestReview(t *testing.T) {
 			}
 
 			require.NoError(t, err)
+			textContent := getTextResult(t, result)
 
-			// For error messages in the result
 			if tc.expectedErrMsg != "" {
-				textContent := getTextResult(t, result)
+				assert.True(t, result.IsError)
 				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
-
-			// Unmarshal and verify the result
 			var returnedReview github.PullRequestReview
 			err = json.Unmarshal([]byte(textContent.Text), &returnedReview)
 			require.NoError(t, err)
 			assert.Equal(t, *tc.expectedReview.ID, *returnedReview.ID)
 			assert.Equal(t, *tc.expectedReview.State, *returnedReview.State)
 			assert.Equal(t, *tc.expectedReview.Body, *returnedReview.Body)
-			assert.Equal(t, *tc.expectedReview.User.Login, *returnedReview.User.Login)
-			assert.Equal(t, *tc.expectedReview.HTMLURL, *returnedReview.HTMLURL)
 		})
 	}
 }
@@ -1566,7 +3227,7 @@ func Test_CreatePullRequestReview(t *testing.T) {
 func Test_CreatePullRequest(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreatePullRequest(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := CreatePullRequest(stubGetClientFn(mockClient), newIdempotencyCache(idempotencyTTL), translations.NullTranslationHelper)
 
 	assert.Equal(t, "create_pull_request", tool.Name)
 	assert.NotEmpty(t, tool.Description)
@@ -1578,6 +3239,7 @@ func Test_CreatePullRequest(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "base")
 	assert.Contains(t, tool.InputSchema.Properties, "draft")
 	assert.Contains(t, tool.InputSchema.Properties, "maintainer_can_modify")
+	assert.Contains(t, tool.InputSchema.Properties, "idempotency_key")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title", "head", "base"})
 
 	// Setup mock PR for success case
@@ -1678,7 +3340,7 @@ func Test_CreatePullRequest(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreatePullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := CreatePullRequest(stubGetClientFn(client), newIdempotencyCache(idempotencyTTL), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1719,3 +3381,222 @@ func Test_CreatePullRequest(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseClosingReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []issueRef
+	}{
+		{
+			name:     "no references",
+			body:     "Refactor the parser, see #12 for context",
+			expected: nil,
+		},
+		{
+			name: "keywords and forms",
+			body: "Fixes #1, closes octo/other#2 and Resolved: https://github.com/octo/third/issues/3.\nAlso fixes #1 again.",
+			expected: []issueRef{
+				{owner: "owner", repo: "repo", number: 1},
+				{owner: "octo", repo: "other", number: 2},
+				{owner: "octo", repo: "third", number: 3},
+			},
+		},
+		{
+			name: "case insensitive",
+			body: "CLOSE #7",
+			expected: []issueRef{
+				{owner: "owner", repo: "repo", number: 7},
+			},
+		},
+		{
+			name:     "keyword must be a whole word",
+			body:     "prefixes #8",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseClosingReferences(tc.body, "owner", "repo"))
+		})
+	}
+}
+
+func Test_ListReferencedIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReferencedIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_referenced_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockPR := &github.PullRequest{
+		Number: github.Ptr(42),
+		Body:   github.Ptr("Fixes #12 and closes octo/other#3. Resolves #404."),
+	}
+	mockIssues := map[string]*github.Issue{
+		"/repos/owner/repo/issues/12": {
+			Number:  github.Ptr(12),
+			Title:   github.Ptr("Login fails"),
+			State:   github.Ptr("open"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/issues/12"),
+		},
+		"/repos/octo/other/issues/3": {
+			Number:  github.Ptr(3),
+			Title:   github.Ptr("Upstream bug"),
+			State:   github.Ptr("closed"),
+			HTMLURL: github.Ptr("https://github.com/octo/other/issues/3"),
+		},
+	}
+	mockTimeline := []*github.Timeline{
+		{Event: github.Ptr("labeled")},
+		{
+			Event: github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: &github.Issue{
+				Number:        github.Ptr(12),
+				Title:         github.Ptr("Login fails"),
+				State:         github.Ptr("open"),
+				RepositoryURL: github.Ptr("https://api.github.com/repos/owner/repo"),
+			}},
+		},
+		{
+			Event: github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: &github.Issue{
+				Number:     github.Ptr(20),
+				Title:      github.Ptr("Tracking issue"),
+				State:      github.Ptr("open"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/issues/20"),
+				Repository: &github.Repository{Name: github.Ptr("repo"), Owner: &github.User{Login: github.Ptr("owner")}},
+			}},
+		},
+		{
+			Event: github.Ptr("cross-referenced"),
+			Source: &github.Source{Issue: &github.Issue{
+				Number:           github.Ptr(43),
+				State:            github.Ptr("open"),
+				PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/owner/repo/pulls/43")},
+				RepositoryURL:    github.Ptr("https://api.github.com/repos/owner/repo"),
+			}},
+		},
+	}
+
+	getIssueHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issue, ok := mockIssues[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		mockResponse(t, http.StatusOK, issue)(w, r)
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedIssues []referencedIssue
+		expectedErrMsg string
+	}{
+		{
+			name: "closing keywords and cross-references",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					getIssueHandler,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					mockTimeline,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError: false,
+			expectedIssues: []referencedIssue{
+				{Owner: "owner", Repo: "repo", Number: 12, Title: "Login fails", State: "open", URL: "https://github.com/owner/repo/issues/12", Closes: true, CrossReferenced: true},
+				{Owner: "octo", Repo: "other", Number: 3, Title: "Upstream bug", State: "closed", URL: "https://github.com/octo/other/issues/3", Closes: true},
+				{Owner: "owner", Repo: "repo", Number: 404, State: "not_found", Closes: true},
+				{Owner: "owner", Repo: "repo", Number: 20, Title: "Tracking issue", State: "open", URL: "https://github.com/owner/repo/issues/20", CrossReferenced: true},
+			},
+		},
+		{
+			name: "no linked issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{Number: github.Ptr(42), Body: github.Ptr("Refactor only")},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					[]*github.Timeline{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    false,
+			expectedIssues: []referencedIssue{},
+		},
+		{
+			name: "PR fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListReferencedIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedIssues []referencedIssue
+			err = json.Unmarshal([]byte(textContent.Text), &returnedIssues)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedIssues, returnedIssues)
+		})
+	}
+}
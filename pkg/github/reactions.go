@@ -0,0 +1,231 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// reactionContents are the reactions GitHub supports on issues and comments.
+var reactionContents = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+// reactionSubjectTypes are the kinds of objects the reaction tools can react to. Pull requests
+// are issues as far as reactions are concerned, so they use the "issue" subject type.
+var reactionSubjectTypes = []string{"issue", "comment", "pr_review_comment"}
+
+// reaction is the subset of a GitHub reaction returned by the reaction tools.
+type reaction struct {
+	ID      int64  `json:"id"`
+	User    string `json:"user"`
+	Content string `json:"content"`
+}
+
+func newReaction(r *github.Reaction) reaction {
+	return reaction{
+		ID:      r.GetID(),
+		User:    r.GetUser().GetLogin(),
+		Content: r.GetContent(),
+	}
+}
+
+// reactionSubject identifies the object a reaction tool operates on.
+type reactionSubject struct {
+	owner       string
+	repo        string
+	subjectType string
+	issueNumber int
+	commentID   int64
+}
+
+// withReactionSubject returns a ToolOption that adds the parameters identifying the reaction subject.
+func withReactionSubject() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithString("subject_type",
+			mcp.Required(),
+			mcp.Description("Kind of object: 'issue' for an issue or pull request, 'comment' for an issue or pull request comment, 'pr_review_comment' for a pull request review comment"),
+			mcp.Enum(reactionSubjectTypes...),
+		)(tool)
+		mcp.WithNumber("issue_number",
+			mcp.Description("Issue or pull request number (required when subject_type is 'issue')"),
+		)(tool)
+		mcp.WithNumber("comment_id",
+			mcp.Description("Comment ID (required when subject_type is 'comment' or 'pr_review_comment')"),
+		)(tool)
+	}
+}
+
+// reactionSubjectParams returns the reaction subject identified by the request, checking that the
+// ID required by its subject type is present.
+func reactionSubjectParams(request mcp.CallToolRequest) (reactionSubject, error) {
+	owner, err := requiredParam[string](request, "owner")
+	if err != nil {
+		return reactionSubject{}, err
+	}
+	repo, err := requiredParam[string](request, "repo")
+	if err != nil {
+		return reactionSubject{}, err
+	}
+	subjectType, err := requiredParam[string](request, "subject_type")
+	if err != nil {
+		return reactionSubject{}, err
+	}
+
+	subject := reactionSubject{owner: owner, repo: repo, subjectType: subjectType}
+	switch subjectType {
+	case "issue":
+		subject.issueNumber, err = RequiredInt(request, "issue_number")
+	case "comment", "pr_review_comment":
+		var commentID int
+		commentID, err = RequiredInt(request, "comment_id")
+		subject.commentID = int64(commentID)
+	default:
+		err = fmt.Errorf("invalid subject_type %q, must be one of: %s", subjectType, strings.Join(reactionSubjectTypes, ", "))
+	}
+	if err != nil {
+		return reactionSubject{}, err
+	}
+	return subject, nil
+}
+
+// AddReaction creates a tool to add a reaction to an issue, pull request or comment.
+func AddReaction(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_reaction",
+			mcp.WithDescription(t("TOOL_ADD_REACTION_DESCRIPTION", "Add a reaction to an issue, pull request or comment, e.g. to acknowledge it without posting a comment")),
+			withReactionSubject(),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("Reaction to add"),
+				mcp.Enum(reactionContents...),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			subject, err := reactionSubjectParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := requiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !slices.Contains(reactionContents, content) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid content %q, must be one of: %s", content, strings.Join(reactionContents, ", "))), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var created *github.Reaction
+			var resp *github.Response
+			switch subject.subjectType {
+			case "issue":
+				created, resp, err = client.Reactions.CreateIssueReaction(ctx, subject.owner, subject.repo, subject.issueNumber, content)
+			case "comment":
+				created, resp, err = client.Reactions.CreateIssueCommentReaction(ctx, subject.owner, subject.repo, subject.commentID, content)
+			case "pr_review_comment":
+				created, resp, err = client.Reactions.CreatePullRequestCommentReaction(ctx, subject.owner, subject.repo, subject.commentID, content)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to add reaction: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// GitHub responds with 200 and the existing reaction if the user already reacted this way.
+			if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add reaction: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newReaction(created))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListReactions creates a tool to list the reactions to an issue, pull request or comment.
+func ListReactions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_reactions",
+			mcp.WithDescription(t("TOOL_LIST_REACTIONS_DESCRIPTION", "List the reactions to an issue, pull request or comment")),
+			withReactionSubject(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			subject, err := reactionSubjectParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var reactions []*github.Reaction
+			var resp *github.Response
+			switch subject.subjectType {
+			case "issue":
+				reactions, resp, err = client.Reactions.ListIssueReactions(ctx, subject.owner, subject.repo, subject.issueNumber, opts)
+			case "comment":
+				reactions, resp, err = client.Reactions.ListIssueCommentReactions(ctx, subject.owner, subject.repo, subject.commentID, opts)
+			case "pr_review_comment":
+				reactions, resp, err = client.Reactions.ListPullRequestCommentReactions(ctx, subject.owner, subject.repo, subject.commentID, opts)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to list reactions: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list reactions: %s", string(body))), nil
+			}
+
+			result := make([]reaction, 0, len(reactions))
+			for _, r := range reactions {
+				result = append(result, newReaction(r))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,338 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AddReaction(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddReaction(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_reaction", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "subject_type")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type", "content"})
+
+	mockReaction := &github.Reaction{
+		ID:      github.Ptr(int64(7)),
+		User:    &github.User{Login: github.Ptr("octocat")},
+		Content: github.Ptr("+1"),
+	}
+	expectedReaction := reaction{ID: 7, User: "octocat", Content: "+1"}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedReaction reaction
+		expectedErrMsg   string
+	}{
+		{
+			name: "react to an issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"content": "+1",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockReaction),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"issue_number": float64(42),
+				"content":      "+1",
+			},
+			expectError:      false,
+			expectedReaction: expectedReaction,
+		},
+		{
+			name: "react to an issue comment that already has the reaction",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsReactionsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusOK, mockReaction),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "comment",
+				"comment_id":   float64(1001),
+				"content":      "+1",
+			},
+			expectError:      false,
+			expectedReaction: expectedReaction,
+		},
+		{
+			name: "react to a pull request review comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsCommentsReactionsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusCreated, mockReaction),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "pr_review_comment",
+				"comment_id":   float64(2002),
+				"content":      "+1",
+			},
+			expectError:      false,
+			expectedReaction: expectedReaction,
+		},
+		{
+			name:         "invalid content",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"issue_number": float64(42),
+				"content":      "thumbsup",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid content "thumbsup", must be one of: +1, -1, laugh, confused, heart, hooray, rocket, eyes`,
+		},
+		{
+			name:         "invalid subject type",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "commit",
+				"content":      "+1",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid subject_type "commit"`,
+		},
+		{
+			name:         "comment subject without comment_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "comment",
+				"issue_number": float64(42),
+				"content":      "+1",
+			},
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: comment_id",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"issue_number": float64(999),
+				"content":      "+1",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add reaction",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddReaction(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedReaction reaction
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReaction)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReaction, returnedReaction)
+		})
+	}
+}
+
+func Test_ListReactions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReactions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_reactions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "subject_type")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type"})
+
+	mockReactions := []*github.Reaction{
+		{ID: github.Ptr(int64(1)), User: &github.User{Login: github.Ptr("octocat")}, Content: github.Ptr("heart")},
+		{ID: github.Ptr(int64(2)), User: &github.User{Login: github.Ptr("hubot")}, Content: github.Ptr("rocket")},
+	}
+	expectedReactions := []reaction{
+		{ID: 1, User: "octocat", Content: "heart"},
+		{ID: 2, User: "hubot", Content: "rocket"},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedReactions []reaction
+		expectedErrMsg    string
+	}{
+		{
+			name: "list issue reactions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReactions),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"issue_number": float64(42),
+				"page":         float64(2),
+				"perPage":      float64(10),
+			},
+			expectError:       false,
+			expectedReactions: expectedReactions,
+		},
+		{
+			name: "list issue comment reactions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesCommentsReactionsByOwnerByRepoByCommentId,
+					mockReactions,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "comment",
+				"comment_id":   float64(1001),
+			},
+			expectError:       false,
+			expectedReactions: expectedReactions,
+		},
+		{
+			name: "list pull request review comment reactions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsReactionsByOwnerByRepoByCommentId,
+					mockReactions,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "pr_review_comment",
+				"comment_id":   float64(2002),
+			},
+			expectError:       false,
+			expectedReactions: expectedReactions,
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"issue_number": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list reactions",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListReactions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedReactions []reaction
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReactions)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReactions, returnedReactions)
+		})
+	}
+}
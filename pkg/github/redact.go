@@ -0,0 +1,63 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// redactedSecret replaces secrets in tool output. It is what GitHub itself returns in place
+// of secrets it never discloses, such as webhook secrets.
+const redactedSecret = "********"
+
+var (
+	// gitHubTokenPattern matches GitHub personal access, OAuth, user-to-server, server-to-server
+	// and refresh tokens, and fine-grained personal access tokens.
+	gitHubTokenPattern = regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)
+
+	// secretFieldPattern matches the value of JSON fields that hold credentials.
+	secretFieldPattern = regexp.MustCompile(`("(?:secret|token|password|client_secret|access_token|refresh_token|private_key)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// urlCredentialsPattern matches credentials embedded in URLs, as user info or query parameters.
+	urlCredentialsPattern = regexp.MustCompile(`(https?://)[^/\s:@"]+:[^/\s@"]+@|([?&](?:access_token|token|client_secret)=)[^&\s"]+`)
+)
+
+// redactSecrets replaces GitHub tokens and other credentials in s with redactedSecret.
+func redactSecrets(s string) string {
+	s = gitHubTokenPattern.ReplaceAllString(s, redactedSecret)
+	s = secretFieldPattern.ReplaceAllString(s, `${1}"`+redactedSecret+`"`)
+	return urlCredentialsPattern.ReplaceAllStringFunc(s, func(m string) string {
+		sub := urlCredentialsPattern.FindStringSubmatch(m)
+		if sub[1] != "" {
+			return sub[1] + redactedSecret + "@"
+		}
+		return sub[2] + redactedSecret
+	})
+}
+
+// withRedaction wraps a tool handler so that neither its text results nor its errors carry
+// secrets. Tool output goes straight into the model context, and often into logs.
+func withRedaction(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if err != nil {
+			// The redacted error cannot wrap the original one, which would still expose the secret.
+			if redacted := redactSecrets(err.Error()); redacted != err.Error() {
+				err = errors.New(redacted)
+			}
+			return result, err
+		}
+		if result != nil {
+			for i, content := range result.Content {
+				if text, ok := content.(mcp.TextContent); ok {
+					text.Text = redactSecrets(text.Text)
+					result.Content[i] = text
+				}
+			}
+		}
+		return result, nil
+	}
+}
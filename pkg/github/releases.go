@@ -0,0 +1,548 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxReleasePages caps how many pages of releases are read to find the releases of a page
+// of tags. Releases are listed newest first, so only very old tags can be missed.
+const maxReleasePages = 10
+
+// taggedRelease is a tag joined with the release created from it, if there is one.
+type taggedRelease struct {
+	Name       string      `json:"name"`
+	CommitSHA  string      `json:"commit_sha"`
+	HasRelease bool        `json:"has_release"`
+	Release    *tagRelease `json:"release,omitempty"`
+}
+
+type tagRelease struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name,omitempty"`
+	Draft       bool              `json:"draft"`
+	Prerelease  bool              `json:"prerelease"`
+	PublishedAt *github.Timestamp `json:"published_at,omitempty"`
+	HTMLURL     string            `json:"html_url"`
+}
+
+// joinTagsWithReleases pairs every tag with the release of the same tag name. Tags without
+// a release keep HasRelease false; releases whose tag is not in tags are ignored.
+func joinTagsWithReleases(tags []*github.RepositoryTag, releases []*github.RepositoryRelease) []taggedRelease {
+	byTag := make(map[string]*github.RepositoryRelease, len(releases))
+	for _, r := range releases {
+		// A published release wins over a draft that reuses its tag name.
+		if existing, ok := byTag[r.GetTagName()]; ok && !existing.GetDraft() {
+			continue
+		}
+		byTag[r.GetTagName()] = r
+	}
+
+	result := make([]taggedRelease, 0, len(tags))
+	for _, tag := range tags {
+		item := taggedRelease{
+			Name:      tag.GetName(),
+			CommitSHA: tag.GetCommit().GetSHA(),
+		}
+		if r, ok := byTag[tag.GetName()]; ok {
+			item.HasRelease = true
+			item.Release = &tagRelease{
+				ID:          r.GetID(),
+				Name:        r.GetName(),
+				Draft:       r.GetDraft(),
+				Prerelease:  r.GetPrerelease(),
+				PublishedAt: r.PublishedAt,
+				HTMLURL:     r.GetHTMLURL(),
+			}
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// ListRepositoryTagsWithReleases creates a tool to list the tags of a repository together with their releases.
+func ListRepositoryTagsWithReleases(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_tags_with_releases",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_TAGS_WITH_RELEASES_DESCRIPTION", "List the tags of a GitHub repository, each with the release created from it, if any")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			tags, resp, err := client.Repositories.ListTags(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list tags: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list tags: %s", string(body))), nil
+			}
+
+			// The releases of a page of tags can be spread over any page of releases, so read
+			// releases until every tag is matched or there are none left.
+			wanted := make(map[string]bool, len(tags))
+			for _, tag := range tags {
+				wanted[tag.GetName()] = true
+			}
+			var releases []*github.RepositoryRelease
+			opts := &github.ListOptions{PerPage: 100}
+			for page := 0; page < maxReleasePages && len(wanted) > 0; page++ {
+				batch, releasesResp, err := client.Repositories.ListReleases(ctx, owner, repo, opts)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list releases: %w", err)
+				}
+				_ = releasesResp.Body.Close()
+
+				for _, r := range batch {
+					releases = append(releases, r)
+					if !r.GetDraft() {
+						delete(wanted, r.GetTagName())
+					}
+				}
+				if releasesResp.NextPage == 0 {
+					break
+				}
+				opts.Page = releasesResp.NextPage
+			}
+
+			r, err := json.Marshal(joinTagsWithReleases(tags, releases))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// gitTagObjectTypes are the kinds of git object an annotated tag can point to.
+var gitTagObjectTypes = []string{"commit", "tree", "blob"}
+
+// createdTag is the ref of a new annotated tag. SHA is the SHA of the tag object itself and
+// ObjectSHA the SHA of the object it was created for.
+type createdTag struct {
+	Ref       string `json:"ref"`
+	SHA       string `json:"sha"`
+	ObjectSHA string `json:"object_sha"`
+	Message   string `json:"message"`
+}
+
+// CreateTag creates a tool to create an annotated tag in a GitHub repository.
+func CreateTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_tag",
+			mcp.WithDescription(t("TOOL_CREATE_TAG_DESCRIPTION", "Create an annotated tag in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag name, e.g. v1.2.0"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Tag message"),
+			),
+			mcp.WithString("object",
+				mcp.Required(),
+				mcp.Description("SHA of the git object to tag"),
+			),
+			mcp.WithString("type",
+				mcp.Description("Type of the tagged object (defaults to commit)"),
+				mcp.Enum(gitTagObjectTypes...),
+			),
+			mcp.WithString("tagger_name",
+				mcp.Description("Name of the tagger (defaults to the authenticated user)"),
+			),
+			mcp.WithString("tagger_email",
+				mcp.Description("Email of the tagger, required with tagger_name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tagName, err := requiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := requiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			object, err := requiredParam[string](request, "object")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			objectType, err := OptionalParam[string](request, "type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			taggerName, err := OptionalParam[string](request, "tagger_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			taggerEmail, err := OptionalParam[string](request, "tagger_email")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if objectType == "" {
+				objectType = "commit"
+			}
+			if !slices.Contains(gitTagObjectTypes, objectType) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid type %q, must be one of: %s", objectType, strings.Join(gitTagObjectTypes, ", "))), nil
+			}
+			if (taggerName == "") != (taggerEmail == "") {
+				return mcp.NewToolResultError("tagger_name and tagger_email must be given together"), nil
+			}
+
+			tag := &github.Tag{
+				Tag:     github.Ptr(tagName),
+				Message: github.Ptr(message),
+				Object: &github.GitObject{
+					SHA:  github.Ptr(object),
+					Type: github.Ptr(objectType),
+				},
+			}
+			if taggerName != "" {
+				tag.Tagger = &github.CommitAuthor{
+					Name:  github.Ptr(taggerName),
+					Email: github.Ptr(taggerEmail),
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The tag object on its own is unreachable; the ref under refs/tags is what makes it a tag.
+			created, resp, err := client.Git.CreateTag(ctx, owner, repo, tag)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					// e.g. the object does not exist or is not of the given type
+					return mcp.NewToolResultError(fmt.Sprintf("failed to create tag object: %s", err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to create tag object: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			ref, resp, err := client.Git.CreateRef(ctx, owner, repo, &github.Reference{
+				Ref:    github.Ptr("refs/tags/" + tagName),
+				Object: &github.GitObject{SHA: created.SHA},
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to create tag ref, tag %s may already exist in %s/%s: %s", tagName, owner, repo, err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to create tag ref: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create tag ref: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(createdTag{
+				Ref:       ref.GetRef(),
+				SHA:       created.GetSHA(),
+				ObjectSHA: created.GetObject().GetSHA(),
+				Message:   created.GetMessage(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// releaseAsset is a file attached to a release. BrowserDownloadURL downloads it without
+// authentication for public repositories.
+type releaseAsset struct {
+	ID                 int64             `json:"id"`
+	Name               string            `json:"name"`
+	Label              string            `json:"label,omitempty"`
+	ContentType        string            `json:"content_type"`
+	State              string            `json:"state"`
+	Size               int               `json:"size"`
+	DownloadCount      int               `json:"download_count"`
+	Uploader           string            `json:"uploader,omitempty"`
+	CreatedAt          *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt          *github.Timestamp `json:"updated_at,omitempty"`
+	BrowserDownloadURL string            `json:"browser_download_url"`
+}
+
+func newReleaseAsset(a *github.ReleaseAsset) releaseAsset {
+	return releaseAsset{
+		ID:                 a.GetID(),
+		Name:               a.GetName(),
+		Label:              a.GetLabel(),
+		ContentType:        a.GetContentType(),
+		State:              a.GetState(),
+		Size:               a.GetSize(),
+		DownloadCount:      a.GetDownloadCount(),
+		Uploader:           a.GetUploader().GetLogin(),
+		CreatedAt:          a.CreatedAt,
+		UpdatedAt:          a.UpdatedAt,
+		BrowserDownloadURL: a.GetBrowserDownloadURL(),
+	}
+}
+
+// ListReleaseAssets creates a tool to list the files attached to a release.
+func ListReleaseAssets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_release_assets",
+			mcp.WithDescription(t("TOOL_LIST_RELEASE_ASSETS_DESCRIPTION", "List the files attached to a release of a GitHub repository, with their size, content type, download count and download URL")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("release_id",
+				mcp.Required(),
+				mcp.Description("ID of the release"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			releaseID, err := RequiredInt(request, "release_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			assets, resp, err := client.Repositories.ListReleaseAssets(ctx, owner, repo, int64(releaseID), opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("release not found: %d in %s/%s", releaseID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list release assets: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list release assets: %s", string(body))), nil
+			}
+
+			result := make([]releaseAsset, 0, len(assets))
+			for _, a := range assets {
+				result = append(result, newReleaseAsset(a))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UploadReleaseAsset creates a tool to attach a file to a release.
+func UploadReleaseAsset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("upload_release_asset",
+			mcp.WithDescription(t("TOOL_UPLOAD_RELEASE_ASSET_DESCRIPTION", "Attach a file, such as a build artifact, to a release of a GitHub repository and return its download URL")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("release_id",
+				mcp.Required(),
+				mcp.Description("ID of the release"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("File name of the asset, e.g. app-linux-amd64.tar.gz"),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("Content of the file, base64 encoded"),
+			),
+			mcp.WithString("content_type",
+				mcp.Description("Media type of the file, e.g. application/gzip (defaults to the type of the file extension)"),
+			),
+			mcp.WithString("label",
+				mcp.Description("Short description shown instead of the file name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			releaseID, err := RequiredInt(request, "release_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			encoded, err := requiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			contentType, err := OptionalParam[string](request, "content_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			label, err := OptionalParam[string](request, "label")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			content, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("content is not valid base64: %s", err.Error())), nil
+			}
+			if contentType == "" {
+				contentType = mime.TypeByExtension(filepath.Ext(name))
+			}
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github only uploads assets from an *os.File, so build the upload request directly
+			query := url.Values{"name": {name}}
+			if label != "" {
+				query.Set("label", label)
+			}
+			u := fmt.Sprintf("repos/%s/%s/releases/%d/assets?%s", url.PathEscape(owner), url.PathEscape(repo), releaseID, query.Encode())
+			req, err := client.NewUploadRequest(u, bytes.NewReader(content), int64(len(content)), contentType)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			asset := new(github.ReleaseAsset)
+			resp, err := client.Do(ctx, req, asset)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("release not found: %d in %s/%s", releaseID, owner, repo)), nil
+				}
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					var errResp *github.ErrorResponse
+					if errors.As(err, &errResp) && slices.ContainsFunc(errResp.Errors, func(e github.Error) bool { return e.Code == "already_exists" }) {
+						return mcp.NewToolResultError(fmt.Sprintf("release %d already has an asset named %s, delete it or choose another name", releaseID, name)), nil
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to upload release asset: %s", err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to upload release asset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to upload release asset: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newReleaseAsset(asset))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,755 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryTagsWithReleases(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryTagsWithReleases(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_tags_with_releases", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockTags := []*github.RepositoryTag{
+		{Name: github.Ptr("v2.0.0-rc.1"), Commit: &github.Commit{SHA: github.Ptr("ccc")}},
+		{Name: github.Ptr("v1.1.0"), Commit: &github.Commit{SHA: github.Ptr("bbb")}},
+		{Name: github.Ptr("v1.0.0"), Commit: &github.Commit{SHA: github.Ptr("aaa")}},
+	}
+	firstReleases := []*github.RepositoryRelease{
+		{
+			ID:         github.Ptr(int64(3)),
+			TagName:    github.Ptr("v2.0.0-rc.1"),
+			Name:       github.Ptr("2.0.0 RC 1"),
+			Prerelease: github.Ptr(true),
+			HTMLURL:    github.Ptr("https://github.com/owner/repo/releases/tag/v2.0.0-rc.1"),
+		},
+	}
+	secondReleases := []*github.RepositoryRelease{
+		{
+			ID:      github.Ptr(int64(1)),
+			TagName: github.Ptr("v1.0.0"),
+			Name:    github.Ptr("1.0.0"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/releases/tag/v1.0.0"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult []taggedRelease
+		expectedErrMsg string
+	}{
+		{
+			name: "joins tags with releases across release pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTagsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockTags),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Query().Get("page") == "2" {
+							mockResponse(t, http.StatusOK, secondReleases)(w, r)
+							return
+						}
+						w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/releases?page=2&per_page=100>; rel="next"`)
+						mockResponse(t, http.StatusOK, firstReleases)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedResult: []taggedRelease{
+				{
+					Name:       "v2.0.0-rc.1",
+					CommitSHA:  "ccc",
+					HasRelease: true,
+					Release: &tagRelease{
+						ID:         3,
+						Name:       "2.0.0 RC 1",
+						Prerelease: true,
+						HTMLURL:    "https://github.com/owner/repo/releases/tag/v2.0.0-rc.1",
+					},
+				},
+				{Name: "v1.1.0", CommitSHA: "bbb", HasRelease: false},
+				{
+					Name:       "v1.0.0",
+					CommitSHA:  "aaa",
+					HasRelease: true,
+					Release: &tagRelease{
+						ID:      1,
+						Name:    "1.0.0",
+						HTMLURL: "https://github.com/owner/repo/releases/tag/v1.0.0",
+					},
+				},
+			},
+		},
+		{
+			name: "repository without tags does not list releases",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTagsByOwnerByRepo,
+					[]*github.RepositoryTag{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedResult: []taggedRelease{},
+		},
+		{
+			name: "list tags fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTagsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list tags",
+		},
+		{
+			name: "list releases fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTagsByOwnerByRepo,
+					mockTags,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+						_, _ = w.Write([]byte(`{"message": "Internal Server Error"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list releases",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositoryTagsWithReleases(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returned []taggedRelease
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_JoinTagsWithReleases(t *testing.T) {
+	tags := []*github.RepositoryTag{
+		{Name: github.Ptr("v1.0.0"), Commit: &github.Commit{SHA: github.Ptr("aaa")}},
+		{Name: github.Ptr("v0.9.0"), Commit: &github.Commit{SHA: github.Ptr("999")}},
+	}
+
+	t.Run("published release wins over a draft with the same tag", func(t *testing.T) {
+		releases := []*github.RepositoryRelease{
+			{ID: github.Ptr(int64(2)), TagName: github.Ptr("v1.0.0"), Draft: github.Ptr(true)},
+			{ID: github.Ptr(int64(1)), TagName: github.Ptr("v1.0.0")},
+			{ID: github.Ptr(int64(3)), TagName: github.Ptr("v1.0.0"), Draft: github.Ptr(true)},
+		}
+		result := joinTagsWithReleases(tags, releases)
+		require.Len(t, result, 2)
+		require.True(t, result[0].HasRelease)
+		assert.Equal(t, int64(1), result[0].Release.ID)
+		assert.False(t, result[1].HasRelease)
+		assert.Nil(t, result[1].Release)
+	})
+
+	t.Run("draft release is used when it is the only one", func(t *testing.T) {
+		releases := []*github.RepositoryRelease{
+			{ID: github.Ptr(int64(2)), TagName: github.Ptr("v0.9.0"), Draft: github.Ptr(true)},
+		}
+		result := joinTagsWithReleases(tags, releases)
+		require.True(t, result[1].HasRelease)
+		assert.True(t, result[1].Release.Draft)
+	})
+
+	t.Run("releases of other tags are ignored", func(t *testing.T) {
+		releases := []*github.RepositoryRelease{
+			{ID: github.Ptr(int64(5)), TagName: github.Ptr("v2.0.0")},
+		}
+		result := joinTagsWithReleases(tags, releases)
+		assert.False(t, result[0].HasRelease)
+		assert.False(t, result[1].HasRelease)
+	})
+}
+
+func Test_CreateTag(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateTag(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "tag")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.Contains(t, tool.InputSchema.Properties, "object")
+	assert.Contains(t, tool.InputSchema.Properties, "type")
+	assert.Contains(t, tool.InputSchema.Properties, "tagger_name")
+	assert.Contains(t, tool.InputSchema.Properties, "tagger_email")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag", "message", "object"})
+
+	mockTag := &github.Tag{
+		Tag:     github.Ptr("v1.2.0"),
+		SHA:     github.Ptr("tagsha"),
+		Message: github.Ptr("Release 1.2.0"),
+		Object: &github.GitObject{
+			SHA:  github.Ptr("commitsha"),
+			Type: github.Ptr("commit"),
+		},
+	}
+	mockRef := &github.Reference{
+		Ref:    github.Ptr("refs/tags/v1.2.0"),
+		Object: &github.GitObject{SHA: github.Ptr("tagsha"), Type: github.Ptr("tag")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult createdTag
+		expectedErrMsg string
+	}{
+		{
+			name: "creates tag object and ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTagsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"tag":     "v1.2.0",
+						"message": "Release 1.2.0",
+						"object":  "commitsha",
+						"type":    "commit",
+						"tagger": map[string]interface{}{
+							"name":  "Release Bot",
+							"email": "bot@example.com",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockTag),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "refs/tags/v1.2.0",
+						"sha": "tagsha",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRef),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"tag":          "v1.2.0",
+				"message":      "Release 1.2.0",
+				"object":       "commitsha",
+				"tagger_name":  "Release Bot",
+				"tagger_email": "bot@example.com",
+			},
+			expectError: false,
+			expectedResult: createdTag{
+				Ref:       "refs/tags/v1.2.0",
+				SHA:       "tagsha",
+				ObjectSHA: "commitsha",
+				Message:   "Release 1.2.0",
+			},
+		},
+		{
+			name:         "invalid object type",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"tag":     "v1.2.0",
+				"message": "Release 1.2.0",
+				"object":  "commitsha",
+				"type":    "tag",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid type "tag", must be one of: commit, tree, blob`,
+		},
+		{
+			name:         "tagger name without email",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"tag":         "v1.2.0",
+				"message":     "Release 1.2.0",
+				"object":      "commitsha",
+				"tagger_name": "Release Bot",
+			},
+			expectError:    false,
+			expectedErrMsg: "tagger_name and tagger_email must be given together",
+		},
+		{
+			name: "tag already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.PostReposGitTagsByOwnerByRepo,
+					mockTag,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Reference already exists"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"tag":     "v1.2.0",
+				"message": "Release 1.2.0",
+				"object":  "commitsha",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to create tag ref, tag v1.2.0 may already exist in owner/repo",
+		},
+		{
+			name: "create tag object fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTagsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "missing",
+				"tag":     "v1.2.0",
+				"message": "Release 1.2.0",
+				"object":  "commitsha",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create tag object",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateTag(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned createdTag
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListReleaseAssets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReleaseAssets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_release_assets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "release_id")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "release_id"})
+
+	mockAssets := []*github.ReleaseAsset{
+		{
+			ID:                 github.Ptr(int64(1)),
+			Name:               github.Ptr("app-linux-amd64.tar.gz"),
+			Label:              github.Ptr("Linux build"),
+			ContentType:        github.Ptr("application/gzip"),
+			State:              github.Ptr("uploaded"),
+			Size:               github.Ptr(1024),
+			DownloadCount:      github.Ptr(42),
+			Uploader:           &github.User{Login: github.Ptr("octocat")},
+			BrowserDownloadURL: github.Ptr("https://github.com/owner/repo/releases/download/v1.0.0/app-linux-amd64.tar.gz"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult []releaseAsset
+		expectedErrMsg string
+	}{
+		{
+			name: "list assets",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesAssetsByOwnerByRepoByReleaseId,
+					expectQueryParams(t, map[string]string{"page": "2", "per_page": "10"}).andThen(
+						mockResponse(t, http.StatusOK, mockAssets),
+					),
+				),
+			),
+			expectedResult: []releaseAsset{
+				{
+					ID:                 1,
+					Name:               "app-linux-amd64.tar.gz",
+					Label:              "Linux build",
+					ContentType:        "application/gzip",
+					State:              "uploaded",
+					Size:               1024,
+					DownloadCount:      42,
+					Uploader:           "octocat",
+					BrowserDownloadURL: "https://github.com/owner/repo/releases/download/v1.0.0/app-linux-amd64.tar.gz",
+				},
+			},
+		},
+		{
+			name: "release not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesAssetsByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectedErrMsg: "release not found: 7 in owner/repo",
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesAssetsByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list release assets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListReleaseAssets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(7),
+				"page":       float64(2),
+				"perPage":    float64(10),
+			}))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned []releaseAsset
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UploadReleaseAsset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UploadReleaseAsset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "upload_release_asset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "release_id")
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.Contains(t, tool.InputSchema.Properties, "content_type")
+	assert.Contains(t, tool.InputSchema.Properties, "label")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "release_id", "name", "content"})
+
+	// expectUpload checks the name, media type and decoded content of the uploaded asset.
+	expectUpload := func(t *testing.T, query map[string]string, contentType, content string) http.HandlerFunc {
+		return expectQueryParams(t, query).andThen(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, contentType, r.Header.Get("Content-Type"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, content, string(body))
+				mockResponse(t, http.StatusCreated, &github.ReleaseAsset{
+					ID:                 github.Ptr(int64(1)),
+					Name:               github.Ptr(query["name"]),
+					ContentType:        github.Ptr(contentType),
+					State:              github.Ptr("uploaded"),
+					Size:               github.Ptr(len(content)),
+					BrowserDownloadURL: github.Ptr("https://github.com/owner/repo/releases/download/v1.0.0/" + query["name"]),
+				})(w, r)
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult releaseAsset
+		expectedErrMsg string
+	}{
+		{
+			name: "upload with content type and label",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					expectUpload(t, map[string]string{"name": "checksums.txt", "label": "Checksums"}, "text/plain", "abc123  app.tar.gz\n"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"release_id":   float64(7),
+				"name":         "checksums.txt",
+				"content":      base64.StdEncoding.EncodeToString([]byte("abc123  app.tar.gz\n")),
+				"content_type": "text/plain",
+				"label":        "Checksums",
+			},
+			expectedResult: releaseAsset{
+				ID:                 1,
+				Name:               "checksums.txt",
+				ContentType:        "text/plain",
+				State:              "uploaded",
+				Size:               19,
+				BrowserDownloadURL: "https://github.com/owner/repo/releases/download/v1.0.0/checksums.txt",
+			},
+		},
+		{
+			name: "content type defaults to binary",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					expectUpload(t, map[string]string{"name": "app"}, "application/octet-stream", "\x7fELF"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(7),
+				"name":       "app",
+				"content":    base64.StdEncoding.EncodeToString([]byte("\x7fELF")),
+			},
+			expectedResult: releaseAsset{
+				ID:                 1,
+				Name:               "app",
+				ContentType:        "application/octet-stream",
+				State:              "uploaded",
+				Size:               4,
+				BrowserDownloadURL: "https://github.com/owner/repo/releases/download/v1.0.0/app",
+			},
+		},
+		{
+			name:         "invalid base64",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(7),
+				"name":       "app",
+				"content":    "not base64!",
+			},
+			expectedErrMsg: "content is not valid base64",
+		},
+		{
+			name: "asset name already taken",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "Validation Failed",
+						"errors":  []map[string]string{{"resource": "ReleaseAsset", "code": "already_exists", "field": "name"}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(7),
+				"name":       "app",
+				"content":    base64.StdEncoding.EncodeToString([]byte("app")),
+			},
+			expectedErrMsg: "release 7 already has an asset named app, delete it or choose another name",
+		},
+		{
+			name: "release not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(7),
+				"name":       "app",
+				"content":    base64.StdEncoding.EncodeToString([]byte("app")),
+			},
+			expectedErrMsg: "release not found: 7 in owner/repo",
+		},
+		{
+			name: "upload fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposReleasesAssetsByOwnerByRepoByReleaseId,
+					mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"release_id": float64(7),
+				"name":       "app",
+				"content":    base64.StdEncoding.EncodeToString([]byte("app")),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to upload release asset",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UploadReleaseAsset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned releaseAsset
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
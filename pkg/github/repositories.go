@@ -3,9 +3,15 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -80,6 +86,420 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 		}
 }
 
+// GetCombinedStatus creates a tool to get the combined commit status of a branch, tag or commit.
+func GetCombinedStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_combined_status",
+			mcp.WithDescription(t("TOOL_GET_COMBINED_STATUS_DESCRIPTION", "Get the combined status of the commit a branch, tag or SHA points to: the overall state (success, pending, failure) and the individual status contexts")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch name, tag name or commit SHA"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := requiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("ref not found: %s in %s/%s", ref, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get combined status: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get combined status: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(status)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// commitComment is a comment on a commit. Comments on a line of the diff of the commit have a
+// path and a position, the others are on the commit as a whole.
+type commitComment struct {
+	ID        int64             `json:"id"`
+	Author    string            `json:"author"`
+	Body      string            `json:"body"`
+	Path      string            `json:"path,omitempty"`
+	Position  *int              `json:"position,omitempty"`
+	CommitID  string            `json:"commit_id"`
+	CreatedAt *github.Timestamp `json:"created_at,omitempty"`
+	HTMLURL   string            `json:"html_url"`
+}
+
+func newCommitComment(c *github.RepositoryComment) commitComment {
+	return commitComment{
+		ID:        c.GetID(),
+		Author:    c.GetUser().GetLogin(),
+		Body:      c.GetBody(),
+		Path:      c.GetPath(),
+		Position:  c.Position,
+		CommitID:  c.GetCommitID(),
+		CreatedAt: c.CreatedAt,
+		HTMLURL:   c.GetHTMLURL(),
+	}
+}
+
+// ListCommitComments creates a tool to list the comments on a commit.
+func ListCommitComments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_commit_comments",
+			mcp.WithDescription(t("TOOL_LIST_COMMIT_COMMENTS_DESCRIPTION", "List the comments on a commit, including comments on lines of its diff")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("Commit SHA"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := requiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comments, resp, err := client.Repositories.ListCommitComments(ctx, owner, repo, sha, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("commit not found: %s in %s/%s", sha, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list commit comments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list commit comments: %s", string(body))), nil
+			}
+
+			result := make([]commitComment, 0, len(comments))
+			for _, c := range comments {
+				result = append(result, newCommitComment(c))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateCommitComment creates a tool to comment on a commit, or on a line of its diff.
+func CreateCommitComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_commit_comment",
+			mcp.WithDescription(t("TOOL_CREATE_COMMIT_COMMENT_DESCRIPTION", "Comment on a commit, outside of any pull request. With path and position, the comment is on a line of the diff of the commit")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("Commit SHA"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Comment text"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Path of the file to comment on"),
+			),
+			mcp.WithNumber("position",
+				mcp.Description("Line index in the diff of the file to comment on, counting from the first @@ hunk header. Requires path"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := requiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := requiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			position, err := OptionalIntParam(request, "position")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			comment := &github.RepositoryComment{
+				Body: github.Ptr(body),
+			}
+			if path != "" {
+				comment.Path = github.Ptr(path)
+			}
+			if position != 0 {
+				if path == "" {
+					return mcp.NewToolResultError("position requires path"), nil
+				}
+				comment.Position = github.Ptr(position)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			created, resp, err := client.Repositories.CreateComment(ctx, owner, repo, sha, comment)
+			if err != nil {
+				if resp != nil {
+					switch resp.StatusCode {
+					case http.StatusNotFound:
+						return mcp.NewToolResultError(fmt.Sprintf("commit not found: %s in %s/%s", sha, owner, repo)), nil
+					case http.StatusUnprocessableEntity:
+						// e.g. the path is not part of the commit, or the position is outside of its diff
+						return mcp.NewToolResultError(fmt.Sprintf("failed to create commit comment: %s", err.Error())), nil
+					}
+				}
+				return nil, fmt.Errorf("failed to create commit comment: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create commit comment: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newCommitComment(created))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRepository creates a tool to get the details of a GitHub repository.
+func GetRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_DESCRIPTION", "Get details of a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithConditionalRequest(),
+			WithResponseFormat(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			format, err := OptionalResponseFormat(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			client, err = conditionalClient(client, request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if isNotModified(resp) {
+				return notModifiedResult(resp), nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get repository: %s", string(body))), nil
+			}
+
+			result, err := formattedResult(format, repository, func() string { return repositoryMarkdown(repository) })
+			if err != nil {
+				return nil, err
+			}
+
+			return withETag(result, resp), nil
+		}
+}
+
+// language is a repository language with its share of the repository's code.
+type language struct {
+	Name       string  `json:"name"`
+	Bytes      int     `json:"bytes"`
+	Percentage float64 `json:"percentage"`
+}
+
+// ListLanguages creates a tool to list the languages of a repository.
+func ListLanguages(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_languages",
+			mcp.WithDescription(t("TOOL_LIST_LANGUAGES_DESCRIPTION", "List the languages of a GitHub repository, largest first, with the bytes of code and percentage of the repository each accounts for")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			languages, resp, err := client.Repositories.ListLanguages(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list languages: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list languages: %s", string(body))), nil
+			}
+
+			total := 0
+			for _, bytes := range languages {
+				total += bytes
+			}
+			result := make([]language, 0, len(languages))
+			for name, bytes := range languages {
+				l := language{Name: name, Bytes: bytes}
+				if total > 0 {
+					l.Percentage = math.Round(float64(bytes)*1000/float64(total)) / 10
+				}
+				result = append(result, l)
+			}
+			sort.Slice(result, func(i, j int) bool {
+				if result[i].Bytes != result[j].Bytes {
+					return result[i].Bytes > result[j].Bytes
+				}
+				return result[i].Name < result[j].Name
+			})
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
 // ListCommits creates a tool to get commits of a branch in a repository.
 func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_commits",
@@ -95,7 +515,20 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			mcp.WithString("sha",
 				mcp.Description("Branch name"),
 			),
+			mcp.WithString("path",
+				mcp.Description("Only commits changing this file or directory path, e.g. to get the history of a file"),
+			),
+			mcp.WithString("author",
+				mcp.Description("Only commits by this GitHub login or email address"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only commits after this date (ISO 8601 timestamp, e.g. 2023-01-15T14:30:00Z or 2023-01-15)"),
+			),
+			mcp.WithString("until",
+				mcp.Description("Only commits before this date (ISO 8601 timestamp, e.g. 2023-01-15T14:30:00Z or 2023-01-15)"),
+			),
 			WithPagination(),
+			WithConditionalRequest(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -110,24 +543,55 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			author, err := OptionalParam[string](request, "author")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalTimeParam(request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			until, err := OptionalTimeParam(request, "until")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			opts := &github.CommitsListOptions{
-				SHA: sha,
+				SHA:    sha,
+				Path:   path,
+				Author: author,
 				ListOptions: github.ListOptions{
 					Page:    pagination.page,
 					PerPage: pagination.perPage,
 				},
 			}
+			if since != nil {
+				opts.Since = *since
+			}
+			if until != nil {
+				opts.Until = *until
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			client, err = conditionalClient(client, request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, opts)
+			if isNotModified(resp) {
+				return notModifiedResult(resp), nil
+			}
 			if err != nil {
 				return nil, fmt.Errorf("failed to list commits: %w", err)
 			}
@@ -146,7 +610,7 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return withETag(mcp.NewToolResultText(string(r)), resp), nil
 		}
 }
 
@@ -201,10 +665,103 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(branches)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// branchStaleness tells how far a branch has drifted from the default branch of its repository.
+// The merge base is the last commit the two branches have in common.
+type branchStaleness struct {
+	Branch        string            `json:"branch"`
+	DefaultBranch string            `json:"default_branch"`
+	Status        string            `json:"status"`
+	AheadBy       int               `json:"ahead_by"`
+	BehindBy      int               `json:"behind_by"`
+	MergeBaseSHA  string            `json:"merge_base_sha"`
+	MergeBaseDate *github.Timestamp `json:"merge_base_date,omitempty"`
+}
+
+// GetBranchStaleness creates a tool to compare a branch with the default branch of its repository.
+func GetBranchStaleness(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch_staleness",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_STALENESS_DESCRIPTION", "Get how many commits a branch is ahead of and behind the default branch of its repository, and when the two last had a commit in common")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch to compare with the default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository not found: %s/%s", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			_ = resp.Body.Close()
+			defaultBranch := repository.GetDefaultBranch()
+
+			// Only the counts matter, so keep the list of commits in the comparison short
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, defaultBranch, branch, &github.ListOptions{PerPage: 1})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("branch not found: %s in %s/%s", branch, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to compare branches: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare branches: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(branches)
+			mergeBase := comparison.GetMergeBaseCommit()
+			r, err := json.Marshal(branchStaleness{
+				Branch:        branch,
+				DefaultBranch: defaultBranch,
+				Status:        comparison.GetStatus(),
+				AheadBy:       comparison.GetAheadBy(),
+				BehindBy:      comparison.GetBehindBy(),
+				MergeBaseSHA:  mergeBase.GetSHA(),
+				MergeBaseDate: mergeBase.GetCommit().GetCommitter().Date,
+			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -242,7 +799,7 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 				mcp.Description("Branch to create/update the file in"),
 			),
 			mcp.WithString("sha",
-				mcp.Description("SHA of file being replaced (for updates)"),
+				mcp.Description("Blob SHA of the file being replaced. When omitted, the current SHA of the file on the branch is used, or the file is created if it doesn't exist"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -290,13 +847,43 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 				opts.SHA = github.Ptr(sha)
 			}
 
-			// Create or update the file
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+
+			// Otherwise look up the SHA of the file being replaced, if there is one
+			fetchedSHA, fileMissing := false, false
+			if sha == "" {
+				current, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: branch})
+				switch {
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					// The file doesn't exist yet, so it is created.
+					fileMissing = true
+				case err != nil:
+					return nil, fmt.Errorf("failed to get current file: %w", err)
+				case dirContent != nil:
+					return mcp.NewToolResultError(fmt.Sprintf("%s is a directory in %s/%s", path, owner, repo)), nil
+				default:
+					opts.SHA = current.SHA
+					fetchedSHA = true
+				}
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+			}
+
+			// Create or update the file
 			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
 			if err != nil {
+				// The file was changed by someone else between looking up its SHA and replacing it,
+				// or created by someone else after it was found missing, in which case GitHub
+				// answers that the SHA of the file to replace wasn't supplied.
+				concurrent := resp != nil && (fetchedSHA && (resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusUnprocessableEntity) ||
+					fileMissing && resp.StatusCode == http.StatusUnprocessableEntity && strings.Contains(err.Error(), `"sha" wasn't supplied`))
+				if concurrent {
+					return mcp.NewToolResultError(fmt.Sprintf("file %s changed concurrently on %s, retry: %s", path, branch, err.Error())), nil
+				}
 				return nil, fmt.Errorf("failed to create/update file: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -391,7 +978,7 @@ func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFun
 // GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
 func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_file_contents",
-			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository")),
+			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository. Symlinks to a file return the file; other symlinks return their target, and submodules the URL of their repository and the commit they are pinned to")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner (username or organization)"),
@@ -407,6 +994,10 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 			mcp.WithString("branch",
 				mcp.Description("Branch to get contents from"),
 			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to get contents at. Use instead of branch, e.g. to look at a file as it was at an earlier commit"),
+			),
+			WithConditionalRequest(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -425,14 +1016,36 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if branch != "" && ref != "" {
+				return mcp.NewToolResultError("branch and ref cannot be used together"), nil
+			}
+			if ref == "" {
+				ref = branch
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			opts := &github.RepositoryContentGetOptions{Ref: branch}
-			fileContent, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+			contentsClient, err := conditionalClient(client, request)
 			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.RepositoryContentGetOptions{Ref: ref}
+			fileContent, dirContent, resp, err := contentsClient.Repositories.GetContents(ctx, owner, repo, path, opts)
+			if isNotModified(resp) {
+				return notModifiedResult(resp), nil
+			}
+			if err != nil {
+				// A missing path and a missing ref are both reported as 404, so check the ref
+				// to tell which of the two it is.
+				if ref != "" && resp != nil && resp.StatusCode == http.StatusNotFound {
+					return refContentNotFound(ctx, client, owner, repo, path, ref)
+				}
 				return nil, fmt.Errorf("failed to get file contents: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -446,10 +1059,189 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 			}
 
 			var result interface{}
-			if fileContent != nil {
-				result = fileContent
-			} else {
+			switch {
+			case fileContent == nil:
 				result = dirContent
+			case fileContent.GetType() == "symlink" || fileContent.GetType() == "submodule":
+				result = newLinkedContent(fileContent)
+			default:
+				result = fileContent
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return withETag(mcp.NewToolResultText(string(r)), resp), nil
+		}
+}
+
+// linkedContent is what get_file_contents returns for a path that holds no content of its
+// own: a symlink whose target is not a regular file, in which case Target is the path it
+// points to, or a submodule, in which case SHA is the commit of the submodule repository at
+// SubmoduleGitURL it is pinned to.
+type linkedContent struct {
+	Type            string `json:"type"`
+	Name            string `json:"name"`
+	Path            string `json:"path"`
+	SHA             string `json:"sha"`
+	Target          string `json:"target,omitempty"`
+	SubmoduleGitURL string `json:"submodule_git_url,omitempty"`
+	HTMLURL         string `json:"html_url,omitempty"`
+}
+
+func newLinkedContent(c *github.RepositoryContent) linkedContent {
+	return linkedContent{
+		Type:            c.GetType(),
+		Name:            c.GetName(),
+		Path:            c.GetPath(),
+		SHA:             c.GetSHA(),
+		Target:          c.GetTarget(),
+		SubmoduleGitURL: c.GetSubmoduleGitURL(),
+		HTMLURL:         c.GetHTMLURL(),
+	}
+}
+
+// Limits of get_multiple_file_contents: how many files can be requested at once, how many of
+// them are fetched concurrently, and how many bytes of content are returned in total.
+const (
+	maxMultipleFilePaths        = 50
+	multipleFileContentsWorkers = 5
+	maxMultipleFileContentSize  = 1 << 20
+)
+
+// fileContentsEntry is the content of one of the files requested from get_multiple_file_contents,
+// or the reason it could not be returned.
+type fileContentsEntry struct {
+	Content string `json:"content,omitempty"`
+	SHA     string `json:"sha,omitempty"`
+	Size    int    `json:"size,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// fetchFileContents gets the decoded content of the file at path. Failures that only concern
+// this file, such as a missing path, are returned in the entry rather than as an error.
+func fetchFileContents(ctx context.Context, client *github.Client, owner, repo, path, ref string) fileContentsEntry {
+	fileContent, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fileContentsEntry{Error: "not found"}
+		}
+		return fileContentsEntry{Error: err.Error()}
+	}
+	_ = resp.Body.Close()
+
+	switch {
+	case fileContent == nil:
+		return fileContentsEntry{Error: "is a directory"}
+	case fileContent.GetType() == "symlink":
+		return fileContentsEntry{SHA: fileContent.GetSHA(), Error: fmt.Sprintf("is a symlink to %s", fileContent.GetTarget())}
+	case fileContent.GetType() == "submodule":
+		return fileContentsEntry{SHA: fileContent.GetSHA(), Error: fmt.Sprintf("is a submodule of %s", fileContent.GetSubmoduleGitURL())}
+	}
+	content, err := fileContent.GetContent()
+	if err != nil {
+		// The contents API leaves out the content of files larger than 1 MB
+		return fileContentsEntry{Error: fmt.Sprintf("content not available: %s", err.Error())}
+	}
+	return fileContentsEntry{
+		Content: content,
+		SHA:     fileContent.GetSHA(),
+		Size:    len(content),
+	}
+}
+
+// GetMultipleFileContents creates a tool to get the contents of several files of a repository at once.
+func GetMultipleFileContents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_multiple_file_contents",
+			mcp.WithDescription(t("TOOL_GET_MULTIPLE_FILE_CONTENTS_DESCRIPTION", "Get the contents of several files of a GitHub repository in one call, keyed by path. Files that can't be read get an error instead of content. Up to 50 files and 1 MiB of content in total")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("paths",
+				mcp.Required(),
+				mcp.Description("Paths of the files to get"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to get the files at (default: the default branch)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			paths, err := OptionalStringArrayParam(request, "paths")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Each path is fetched once, however often it is requested
+			var unique []string
+			for _, path := range paths {
+				if !slices.Contains(unique, path) {
+					unique = append(unique, path)
+				}
+			}
+			if len(unique) == 0 {
+				return mcp.NewToolResultError("missing required parameter: paths"), nil
+			}
+			if len(unique) > maxMultipleFilePaths {
+				return mcp.NewToolResultError(fmt.Sprintf("too many paths: %d, at most %d files can be requested at once", len(unique), maxMultipleFilePaths)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			entries, errs := fanOut(ctx, unique, multipleFileContentsWorkers, func(ctx context.Context, path string) (fileContentsEntry, error) {
+				return fetchFileContents(ctx, client, owner, repo, path, ref), nil
+			})
+			for i, err := range errs {
+				if err != nil {
+					// The request was cancelled before the file was fetched
+					entries[i] = fileContentsEntry{Error: err.Error()}
+				}
+			}
+
+			// The size limit is applied in the order the paths were requested, so that the
+			// same request always returns the same files.
+			result := make(map[string]fileContentsEntry, len(unique))
+			total := 0
+			for i, path := range unique {
+				entry := entries[i]
+				if entry.Error == "" {
+					if total+entry.Size > maxMultipleFileContentSize {
+						entry = fileContentsEntry{
+							SHA:   entry.SHA,
+							Size:  entry.Size,
+							Error: fmt.Sprintf("left out, the content would exceed the limit of %d bytes in total; request it separately", maxMultipleFileContentSize),
+						}
+					} else {
+						total += entry.Size
+					}
+				}
+				result[path] = entry
 			}
 
 			r, err := json.Marshal(result)
@@ -461,6 +1253,34 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
+// refContentNotFound explains why path could not be found at ref: either the repository or the
+// ref doesn't exist, or the path didn't exist at the commit the ref points to.
+func refContentNotFound(ctx context.Context, client *github.Client, owner, repo, path, ref string) (*mcp.CallToolResult, error) {
+	_, resp, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, ref, "")
+	if err != nil {
+		// Malformed refs and unknown SHAs are reported as 422.
+		if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+			return mcp.NewToolResultError(fmt.Sprintf("ref %s not found in %s/%s", ref, owner, repo)), nil
+		}
+		// Unknown refs are reported as 404, but so are missing and inaccessible repositories,
+		// so check the repository to tell which of the two it is.
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			_, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found or not accessible", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			_ = resp.Body.Close()
+			return mcp.NewToolResultError(fmt.Sprintf("ref %s not found in %s/%s", ref, owner, repo)), nil
+		}
+		return nil, fmt.Errorf("failed to resolve ref: %w", err)
+	}
+	_ = resp.Body.Close()
+	return mcp.NewToolResultError(fmt.Sprintf("path %s did not exist at ref %s in %s/%s", path, ref, owner, repo)), nil
+}
+
 // ForkRepository creates a tool to fork a repository.
 func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("fork_repository",
@@ -476,6 +1296,9 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 			mcp.WithString("organization",
 				mcp.Description("Organization to fork to"),
 			),
+			mcp.WithBoolean("wait",
+				mcp.Description("Wait until the fork has been created before returning"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -490,6 +1313,10 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			wait, err := OptionalParam[bool](request, "wait")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			opts := &github.RepositoryCreateForkOptions{}
 			if org != "" {
@@ -505,7 +1332,10 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
 				// and it's not a real error.
 				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Fork is in progress"), nil
+					if wait {
+						return waitForFork(ctx, client, forkedRepo)
+					}
+					return mcp.NewToolResultText(fmt.Sprintf("Fork is in progress: %s", forkedRepo.GetFullName())), nil
 				}
 				return nil, fmt.Errorf("failed to fork repository: %w", err)
 			}
@@ -528,6 +1358,161 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
+// forkPollInterval and forkPollAttempts control how long waitForFork polls for
+// a newly created fork before giving up.
+var (
+	forkPollInterval = 2 * time.Second
+	forkPollAttempts = 15
+)
+
+// waitForFork polls the repository API until the fork created asynchronously by GitHub
+// is ready, and returns its full name once it is. The fork is visible before GitHub has
+// finished copying its contents, so it only counts as ready once it reports a default
+// branch and commits can be listed on it.
+func waitForFork(ctx context.Context, client *github.Client, fork *github.Repository) (*mcp.CallToolResult, error) {
+	owner := fork.GetOwner().GetLogin()
+	name := fork.GetName()
+	if owner == "" || name == "" {
+		return mcp.NewToolResultError("fork is in progress, but GitHub did not report its location"), nil
+	}
+
+	for attempt := 0; attempt < forkPollAttempts; attempt++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(forkPollInterval):
+		}
+
+		repository, resp, err := client.Repositories.Get(ctx, owner, name)
+		if err != nil {
+			// The fork is not visible until GitHub has started creating it.
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get forked repository: %w", err)
+		}
+		_ = resp.Body.Close()
+		if repository.GetDefaultBranch() == "" {
+			continue
+		}
+
+		commits, resp, err := client.Repositories.ListCommits(ctx, owner, name, &github.CommitsListOptions{
+			SHA:         repository.GetDefaultBranch(),
+			ListOptions: github.ListOptions{PerPage: 1},
+		})
+		if err != nil {
+			// Until its contents are copied the fork is reported as empty (409) or its
+			// default branch as missing (404).
+			if resp != nil && (resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list commits of forked repository: %w", err)
+		}
+		_ = resp.Body.Close()
+		if len(commits) == 0 {
+			continue
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Fork is ready: %s", repository.GetFullName())), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Fork is in progress: %s is not ready yet", fork.GetFullName())), nil
+}
+
+// forkSync is the outcome of syncing a branch of a fork with its upstream repository. Status is
+// "merged" when upstream changes were brought in, "up_to_date" when there were none and "conflict"
+// when the branch has diverged from upstream in a way GitHub can't merge automatically.
+type forkSync struct {
+	Status     string `json:"status"`
+	MergeType  string `json:"merge_type,omitempty"`
+	BaseBranch string `json:"base_branch,omitempty"`
+	Message    string `json:"message"`
+}
+
+// SyncFork creates a tool to sync a branch of a fork with its upstream repository.
+func SyncFork(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_fork",
+			mcp.WithDescription(t("TOOL_SYNC_FORK_DESCRIPTION", "Sync a branch of a forked repository with the upstream repository, reporting whether changes were merged, the branch was already up to date, or it has conflicts")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Owner of the fork"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Name of the fork"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch of the fork to sync"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			merge, resp, err := client.Repositories.MergeUpstream(ctx, owner, repo, &github.RepoMergeUpstreamRequest{
+				Branch: github.Ptr(branch),
+			})
+			if err != nil {
+				var errResp *github.ErrorResponse
+				switch {
+				case resp != nil && resp.StatusCode == http.StatusConflict && errors.As(err, &errResp):
+					// A conflict is an outcome of the sync rather than a failure of the tool.
+					r, err := json.Marshal(forkSync{Status: "conflict", Message: errResp.Message})
+					if err != nil {
+						return nil, fmt.Errorf("failed to marshal response: %w", err)
+					}
+					return mcp.NewToolResultText(string(r)), nil
+				case resp != nil && resp.StatusCode == http.StatusUnprocessableEntity:
+					// The repository is not a fork, or the branch doesn't exist.
+					return mcp.NewToolResultError(fmt.Sprintf("failed to sync fork: %s", err.Error())), nil
+				default:
+					return nil, fmt.Errorf("failed to sync fork: %w", err)
+				}
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to sync fork: %s", string(body))), nil
+			}
+
+			result := forkSync{
+				Status:     "merged",
+				MergeType:  merge.GetMergeType(),
+				BaseBranch: merge.GetBaseBranch(),
+				Message:    merge.GetMessage(),
+			}
+			if merge.GetMergeType() == "none" {
+				result.Status = "up_to_date"
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
 // CreateBranch creates a tool to create a new branch.
 func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("create_branch",
@@ -613,6 +1598,78 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
+// DeleteBranch creates a tool to delete a branch of a GitHub repository.
+func DeleteBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_branch",
+			mcp.WithDescription(t("TOOL_DELETE_BRANCH_DESCRIPTION", "Delete a branch of a GitHub repository, e.g. after its pull request was merged. The default branch of the repository can't be deleted")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Name of the branch to delete"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch = strings.TrimPrefix(branch, "refs/heads/")
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The API deletes the default branch too, which leaves the repository without one
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			_ = resp.Body.Close()
+			if branch == repository.GetDefaultBranch() {
+				return mcp.NewToolResultError(fmt.Sprintf("refusing to delete %s, it is the default branch of %s/%s", branch, owner, repo)), nil
+			}
+
+			resp, err = client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+branch)
+			if err != nil {
+				// e.g. the branch does not exist, or is protected
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to delete branch %s: %s", branch, err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to delete branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete branch: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Branch %s deleted from %s/%s", branch, owner, repo)), nil
+		}
+}
+
 // PushFiles creates a tool to push multiple files in a single commit to a GitHub repository.
 func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("push_files",
@@ -653,6 +1710,11 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				mcp.Required(),
 				mcp.Description("Commit message"),
 			),
+			mcp.WithNumber("retry_on_conflict",
+				mcp.Description("How many times to retry when the branch is updated by someone else while pushing, recreating the commit on top of the new head of the branch (default 0, max 5)"),
+				mcp.Min(0),
+				mcp.Max(maxPushFilesRetries),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -671,6 +1733,13 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			retries, err := OptionalIntParam(request, "retry_on_conflict")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if retries < 0 || retries > maxPushFilesRetries {
+				return mcp.NewToolResultError(fmt.Sprintf("retry_on_conflict must be between 0 and %d, got %d", maxPushFilesRetries, retries)), nil
+			}
 
 			// Parse files parameter - this should be an array of objects with path and content
 			filesObj, ok := request.Params.Arguments["files"].([]interface{})
@@ -678,25 +1747,6 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				return mcp.NewToolResultError("files parameter must be an array of objects with path and content"), nil
 			}
 
-			client, err := getClient(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
-			}
-
-			// Get the reference for the branch
-			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get branch reference: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			// Get the commit object that the branch points to
-			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get base commit: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
-
 			// Create tree entries for all files
 			var entries []*github.TreeEntry
 
@@ -725,32 +1775,33 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				})
 			}
 
-			// Create a new tree with the file entries
-			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, entries)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create tree: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			// Create a new commit
-			commit := &github.Commit{
-				Message: github.Ptr(message),
-				Tree:    newTree,
-				Parents: []*github.Commit{{SHA: baseCommit.SHA}},
-			}
-			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+			client, err := getClient(ctx)
 			if err != nil {
-				return nil, fmt.Errorf("failed to create commit: %w", err)
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			defer func() { _ = resp.Body.Close() }()
 
-			// Update the reference to point to the new commit
-			ref.Object.SHA = newCommit.SHA
-			updatedRef, resp, err := client.Git.UpdateRef(ctx, owner, repo, ref, false)
-			if err != nil {
-				return nil, fmt.Errorf("failed to update reference: %w", err)
+			// The files replace whatever the branch has at their paths, so when the branch
+			// moves before the ref is updated, the commit can be recreated on the new head.
+			var updatedRef *github.Reference
+			for attempt := 0; ; attempt++ {
+				var conflict bool
+				updatedRef, conflict, err = pushTreeEntries(ctx, client, owner, repo, branch, message, entries)
+				if err != nil {
+					return nil, err
+				}
+				if !conflict {
+					break
+				}
+				if attempt == retries {
+					msg := fmt.Sprintf("branch %s was updated while pushing, and the files were not pushed", branch)
+					if retries == 0 {
+						msg += "; set retry_on_conflict to push them on top of the new head"
+					} else {
+						msg += fmt.Sprintf(", still conflicting after %d retries", retries)
+					}
+					return mcp.NewToolResultError(msg), nil
+				}
 			}
-			defer func() { _ = resp.Body.Close() }()
 
 			r, err := json.Marshal(updatedRef)
 			if err != nil {
@@ -760,3 +1811,75 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// maxPushFilesRetries is the most times push_files recreates its commit when the branch is
+// updated concurrently.
+const maxPushFilesRetries = 5
+
+// pushTreeEntries commits entries on top of the head of branch and moves the branch to the new
+// commit. conflict is set, without an error, when the branch was updated in the meantime and
+// could not be fast-forwarded to the commit.
+func pushTreeEntries(ctx context.Context, client *github.Client, owner, repo, branch, message string, entries []*github.TreeEntry) (updatedRef *github.Reference, conflict bool, err error) {
+	// Get the reference for the branch
+	ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get branch reference: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	// Get the commit object that the branch points to
+	baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get base commit: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	// Create a new tree with the file entries
+	newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, entries)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create tree: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	// Create a new commit
+	commit := &github.Commit{
+		Message: github.Ptr(message),
+		Tree:    newTree,
+		Parents: []*github.Commit{{SHA: baseCommit.SHA}},
+	}
+	newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create commit: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	// Update the reference to point to the new commit
+	ref.Object.SHA = newCommit.SHA
+	updatedRef, resp, err = client.Git.UpdateRef(ctx, owner, repo, ref, false)
+	if err != nil {
+		if isRefUpdateConflict(resp, err) {
+			return nil, true, nil
+		}
+		return nil, false, fmt.Errorf("failed to update reference: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	return updatedRef, false, nil
+}
+
+// isRefUpdateConflict reports whether a failed ref update was rejected because the ref moved:
+// GitHub answers 409 when the ref is updated concurrently, and 422 when the new commit is no
+// longer a fast-forward of it.
+func isRefUpdateConflict(resp *github.Response, err error) bool {
+	if resp == nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusUnprocessableEntity:
+		var errResp *github.ErrorResponse
+		return errors.As(err, &errResp) && strings.Contains(strings.ToLower(errResp.Message), "fast forward")
+	}
+	return false
+}
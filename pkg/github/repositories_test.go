@@ -2,8 +2,11 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,6 +29,8 @@ func Test_GetFileContents(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "path")
 	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "if_none_match")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
 
 	// Setup mock file content for success case
@@ -64,6 +69,7 @@ func Test_GetFileContents(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
+		expectToolErr  bool
 		expectedResult interface{}
 		expectedErrMsg string
 	}{
@@ -106,14 +112,205 @@ func Test_GetFileContents(t *testing.T) {
 			expectError:    false,
 			expectedResult: mockDirContent,
 		},
+		{
+			name: "successful file content fetch at commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{
+						"ref": "a1b2c3d",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileContent),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "README.md",
+				"ref":   "a1b2c3d",
+			},
+			expectError:    false,
+			expectedResult: mockFileContent,
+		},
+		{
+			name: "path did not exist at ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					"a1b2c3d4e5f6",
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "docs/new.md",
+				"ref":   "a1b2c3d",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "path docs/new.md did not exist at ref a1b2c3d in owner/repo",
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "No commit found for SHA: deadbeef"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "README.md",
+				"ref":   "deadbeef",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "ref deadbeef not found in owner/repo",
+		},
+		{
+			name: "unknown ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{FullName: github.Ptr("owner/repo")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "README.md",
+				"ref":   "feature/gone",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "ref feature/gone not found in owner/repo",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "private-repo",
+				"path":  "README.md",
+				"ref":   "main",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "repository owner/private-repo not found or not accessible",
+		},
+		{
+			name: "symlink to a directory",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					&github.RepositoryContent{
+						Type:    github.Ptr("symlink"),
+						Name:    github.Ptr("lib"),
+						Path:    github.Ptr("lib"),
+						SHA:     github.Ptr("aaa111"),
+						Size:    github.Ptr(10),
+						Target:  github.Ptr("vendor/lib"),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/lib"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "lib",
+			},
+			expectError: false,
+			expectedResult: linkedContent{
+				Type:    "symlink",
+				Name:    "lib",
+				Path:    "lib",
+				SHA:     "aaa111",
+				Target:  "vendor/lib",
+				HTMLURL: "https://github.com/owner/repo/blob/main/lib",
+			},
+		},
+		{
+			name: "submodule",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					&github.RepositoryContent{
+						Type:            github.Ptr("submodule"),
+						Name:            github.Ptr("third_party"),
+						Path:            github.Ptr("vendor/third_party"),
+						SHA:             github.Ptr("bbb222"),
+						Size:            github.Ptr(0),
+						SubmoduleGitURL: github.Ptr("https://github.com/other/third_party.git"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "vendor/third_party",
+			},
+			expectError: false,
+			expectedResult: linkedContent{
+				Type:            "submodule",
+				Name:            "third_party",
+				Path:            "vendor/third_party",
+				SHA:             "bbb222",
+				SubmoduleGitURL: "https://github.com/other/third_party.git",
+			},
+		},
+		{
+			name:         "branch and ref together",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"path":   "README.md",
+				"branch": "main",
+				"ref":    "v1.0.0",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "branch and ref cannot be used together",
+		},
 		{
 			name: "content fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposContentsByOwnerByRepoByPath,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
 					}),
 				),
 			),
@@ -162,6 +359,12 @@ func Test_GetFileContents(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
 			// Verify based on expected type
 			switch expected := tc.expectedResult.(type) {
 			case *github.RepositoryContent:
@@ -181,77 +384,153 @@ func Test_GetFileContents(t *testing.T) {
 					assert.Equal(t, *expected[i].Path, *content.Path)
 					assert.Equal(t, *expected[i].Type, *content.Type)
 				}
+			case linkedContent:
+				var returnedContent linkedContent
+				err = json.Unmarshal([]byte(textContent.Text), &returnedContent)
+				require.NoError(t, err)
+				assert.Equal(t, expected, returnedContent)
 			}
 		})
 	}
 }
 
-func Test_ForkRepository(t *testing.T) {
+func Test_GetMultipleFileContents(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := ForkRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetMultipleFileContents(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "fork_repository", tool.Name)
+	assert.Equal(t, "get_multiple_file_contents", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "organization")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
-
-	// Setup mock forked repo for success case
-	mockForkedRepo := &github.Repository{
-		ID:       github.Ptr(int64(123456)),
-		Name:     github.Ptr("repo"),
-		FullName: github.Ptr("new-owner/repo"),
-		Owner: &github.User{
-			Login: github.Ptr("new-owner"),
-		},
-		HTMLURL:       github.Ptr("https://github.com/new-owner/repo"),
-		DefaultBranch: github.Ptr("main"),
-		Fork:          github.Ptr(true),
-		ForksCount:    github.Ptr(0),
+	assert.Contains(t, tool.InputSchema.Properties, "paths")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "paths"})
+
+	file := func(path, content string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Type:     github.Ptr("file"),
+			Path:     github.Ptr(path),
+			SHA:      github.Ptr("sha-" + path),
+			Encoding: github.Ptr("base64"),
+			Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+		}
+	}
+	largeContent := strings.Repeat("x", maxMultipleFileContentSize/2+1)
+
+	// serveContents serves the files of a repository by path, a directory at "src", a symlink
+	// at "lib" and a submodule at "vendor/dep"
+	serveContents := func(files map[string]*github.RepositoryContent) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			path := strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/contents/")
+			switch path {
+			case "src":
+				mockResponse(t, http.StatusOK, []*github.RepositoryContent{file("src/main.go", "package main")})(w, r)
+				return
+			case "lib":
+				mockResponse(t, http.StatusOK, &github.RepositoryContent{Type: github.Ptr("symlink"), Path: github.Ptr("lib"), SHA: github.Ptr("sha-lib"), Target: github.Ptr("vendor/lib")})(w, r)
+				return
+			case "vendor/dep":
+				mockResponse(t, http.StatusOK, &github.RepositoryContent{Type: github.Ptr("submodule"), Path: github.Ptr("vendor/dep"), SHA: github.Ptr("sha-dep"), SubmoduleGitURL: github.Ptr("https://github.com/other/dep.git")})(w, r)
+				return
+			}
+			f, ok := files[path]
+			if !ok {
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, f)(w, r)
+		}
 	}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedRepo   *github.Repository
-		expectedErrMsg string
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectToolErr   bool
+		expectedEntries map[string]fileContentsEntry
+		expectedText    string
 	}{
 		{
-			name: "successful repository fork",
+			name: "get several files",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposForksByOwnerByRepo,
-					mockResponse(t, http.StatusAccepted, mockForkedRepo),
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{
+						"ref": "v1.0.0",
+					}).andThen(
+						serveContents(map[string]*github.RepositoryContent{
+							"README.md": file("README.md", "# Hello"),
+							"go.mod":    file("go.mod", "module example.com/hello"),
+						}),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
+				"paths": []interface{}{"README.md", "go.mod", "missing.txt", "src", "lib", "vendor/dep", "README.md"},
+				"ref":   "v1.0.0",
+			},
+			expectedEntries: map[string]fileContentsEntry{
+				"README.md":   {Content: "# Hello", SHA: "sha-README.md", Size: 7},
+				"go.mod":      {Content: "module example.com/hello", SHA: "sha-go.mod", Size: 24},
+				"missing.txt": {Error: "not found"},
+				"src":         {Error: "is a directory"},
+				"lib":         {SHA: "sha-lib", Error: "is a symlink to vendor/lib"},
+				"vendor/dep":  {SHA: "sha-dep", Error: "is a submodule of https://github.com/other/dep.git"},
 			},
-			expectError:  false,
-			expectedRepo: mockForkedRepo,
 		},
 		{
-			name: "repository fork fails",
+			name: "files beyond the size limit are left out in request order",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposForksByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusForbidden)
-						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
+					mock.GetReposContentsByOwnerByRepoByPath,
+					serveContents(map[string]*github.RepositoryContent{
+						"a.txt":     file("a.txt", largeContent),
+						"b.txt":     file("b.txt", largeContent),
+						"small.txt": file("small.txt", "small"),
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
+				"paths": []interface{}{"a.txt", "b.txt", "small.txt"},
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to fork repository",
+			expectedEntries: map[string]fileContentsEntry{
+				"a.txt":     {Content: largeContent, SHA: "sha-a.txt", Size: len(largeContent)},
+				"b.txt":     {SHA: "sha-b.txt", Size: len(largeContent), Error: "left out, the content would exceed the limit of 1048576 bytes in total; request it separately"},
+				"small.txt": {Content: "small", SHA: "sha-small.txt", Size: 5},
+			},
+		},
+		{
+			name:         "no paths",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"paths": []interface{}{},
+			},
+			expectToolErr: true,
+			expectedText:  "missing required parameter: paths",
+		},
+		{
+			name:         "too many paths",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"paths": func() []interface{} {
+					paths := make([]interface{}, 0, maxMultipleFilePaths+1)
+					for i := 0; i <= maxMultipleFilePaths; i++ {
+						paths = append(paths, fmt.Sprintf("file%d.txt", i))
+					}
+					return paths
+				}(),
+			},
+			expectToolErr: true,
+			expectedText:  "too many paths: 51, at most 50 files can be requested at once",
 		},
 	}
 
@@ -259,63 +538,59 @@ func Test_ForkRepository(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := ForkRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := GetMultipleFileContents(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
 			// Call handler
 			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
 
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
 				return
 			}
 
+			// Unmarshal and verify the result
+			var returnedEntries map[string]fileContentsEntry
+			err = json.Unmarshal([]byte(textContent.Text), &returnedEntries)
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
-
-			assert.Contains(t, textContent.Text, "Fork is in progress")
+			assert.Equal(t, tc.expectedEntries, returnedEntries)
 		})
 	}
 }
 
-func Test_CreateBranch(t *testing.T) {
+func Test_ForkRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ForkRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "create_branch", tool.Name)
+	assert.Equal(t, "fork_repository", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "branch")
-	assert.Contains(t, tool.InputSchema.Properties, "from_branch")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
-
-	// Setup mock repository for default branch test
-	mockRepo := &github.Repository{
-		DefaultBranch: github.Ptr("main"),
-	}
+	assert.Contains(t, tool.InputSchema.Properties, "organization")
+	assert.Contains(t, tool.InputSchema.Properties, "wait")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
-	// Setup mock reference for from_branch tests
-	mockSourceRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123def456"),
+	// Setup mock forked repo for success case
+	mockForkedRepo := &github.Repository{
+		ID:       github.Ptr(int64(123456)),
+		Name:     github.Ptr("repo"),
+		FullName: github.Ptr("new-owner/repo"),
+		Owner: &github.User{
+			Login: github.Ptr("new-owner"),
 		},
+		HTMLURL:       github.Ptr("https://github.com/new-owner/repo"),
+		DefaultBranch: github.Ptr("main"),
+		Fork:          github.Ptr(true),
+		ForksCount:    github.Ptr(0),
 	}
-
-	// Setup mock created reference
-	mockCreatedRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/new-feature"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123def456"),
-		},
+	mockForkCommits := []*github.RepositoryCommit{
+		{SHA: github.Ptr("abc123def456")},
 	}
 
 	tests := []struct {
@@ -323,129 +598,157 @@ func Test_CreateBranch(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedRef    *github.Reference
+		expectedText   string
 		expectedErrMsg string
 	}{
 		{
-			name: "successful branch creation with from_branch",
+			name: "successful repository fork",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockSourceRef,
-				),
-				mock.WithRequestMatch(
-					mock.PostReposGitRefsByOwnerByRepo,
-					mockCreatedRef,
+				mock.WithRequestMatchHandler(
+					mock.PostReposForksByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, mockForkedRepo),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":       "owner",
-				"repo":        "repo",
-				"branch":      "new-feature",
-				"from_branch": "main",
+				"owner": "owner",
+				"repo":  "repo",
 			},
-			expectError: false,
-			expectedRef: mockCreatedRef,
+			expectError:  false,
+			expectedText: "Fork is in progress: new-owner/repo",
 		},
 		{
-			name: "successful branch creation with default branch",
+			name: "successful repository fork with wait",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposByOwnerByRepo,
-					mockRepo,
+				mock.WithRequestMatchHandler(
+					mock.PostReposForksByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, mockForkedRepo),
 				),
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockSourceRef,
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusOK, mockForkedRepo),
 				),
 				mock.WithRequestMatchHandler(
-					mock.PostReposGitRefsByOwnerByRepo,
-					expectRequestBody(t, map[string]interface{}{
-						"ref": "refs/heads/new-feature",
-						"sha": "abc123def456",
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sha":      "main",
+						"per_page": "1",
 					}).andThen(
-						mockResponse(t, http.StatusCreated, mockCreatedRef),
+						mockResponse(t, http.StatusOK, mockForkCommits),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":  "owner",
-				"repo":   "repo",
-				"branch": "new-feature",
+				"owner":        "owner",
+				"repo":         "repo",
+				"organization": "new-owner",
+				"wait":         true,
 			},
-			expectError: false,
-			expectedRef: mockCreatedRef,
+			expectError:  false,
+			expectedText: "Fork is ready: new-owner/repo",
 		},
 		{
-			name: "fail to get repository",
+			name: "fork with wait polls until the fork exists",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposForksByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, mockForkedRepo),
+				),
 				mock.WithRequestMatchHandler(
 					mock.GetReposByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Repository not found"}`))
-					}),
+					func() http.HandlerFunc {
+						calls := 0
+						return func(w http.ResponseWriter, r *http.Request) {
+							calls++
+							if calls == 1 {
+								mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)(w, r)
+								return
+							}
+							mockResponse(t, http.StatusOK, mockForkedRepo)(w, r)
+						}
+					}(),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockForkCommits,
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":  "owner",
-				"repo":   "nonexistent-repo",
-				"branch": "new-feature",
+				"owner": "owner",
+				"repo":  "repo",
+				"wait":  true,
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get repository",
+			expectError:  false,
+			expectedText: "Fork is ready: new-owner/repo",
 		},
 		{
-			name: "fail to get reference",
+			name: "fork with wait polls until the fork has its contents",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Reference not found"}`))
-					}),
+					mock.PostReposForksByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, mockForkedRepo),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{
+						Name:     github.Ptr("repo"),
+						FullName: github.Ptr("new-owner/repo"),
+					},
+					mockForkedRepo,
+					mockForkedRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					func() http.HandlerFunc {
+						calls := 0
+						return func(w http.ResponseWriter, r *http.Request) {
+							calls++
+							if calls == 1 {
+								mockResponse(t, http.StatusConflict, `{"message": "Git Repository is empty."}`)(w, r)
+								return
+							}
+							mockResponse(t, http.StatusOK, mockForkCommits)(w, r)
+						}
+					}(),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":       "owner",
-				"repo":        "repo",
-				"branch":      "new-feature",
-				"from_branch": "nonexistent-branch",
+				"owner": "owner",
+				"repo":  "repo",
+				"wait":  true,
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get reference",
+			expectError:  false,
+			expectedText: "Fork is ready: new-owner/repo",
 		},
 		{
-			name: "fail to create branch",
+			name: "repository fork fails",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockSourceRef,
-				),
 				mock.WithRequestMatchHandler(
-					mock.PostReposGitRefsByOwnerByRepo,
+					mock.PostReposForksByOwnerByRepo,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Reference already exists"}`))
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":       "owner",
-				"repo":        "repo",
-				"branch":      "existing-branch",
-				"from_branch": "main",
+				"owner": "owner",
+				"repo":  "repo",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to create branch",
+			expectedErrMsg: "failed to fork repository",
 		},
 	}
 
+	// Don't wait between polls for the fork to become available
+	originalInterval := forkPollInterval
+	forkPollInterval = 0
+	t.Cleanup(func() { forkPollInterval = originalInterval })
+
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreateBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ForkRepository(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -465,101 +768,137 @@ func Test_CreateBranch(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedRef github.Reference
-			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
-			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
-			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+			assert.Equal(t, tc.expectedText, textContent.Text)
 		})
 	}
 }
 
-func Test_GetCommit(t *testing.T) {
+func Test_SyncFork(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := SyncFork(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "get_commit", tool.Name)
+	assert.Equal(t, "sync_fork", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "sha")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
-
-	mockCommit := &github.RepositoryCommit{
-		SHA: github.Ptr("abc123def456"),
-		Commit: &github.Commit{
-			Message: github.Ptr("First commit"),
-			Author: &github.CommitAuthor{
-				Name:  github.Ptr("Test User"),
-				Email: github.Ptr("test@example.com"),
-				Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
-			},
-		},
-		Author: &github.User{
-			Login: github.Ptr("testuser"),
-		},
-		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
-		Stats: &github.CommitStats{
-			Additions: github.Ptr(10),
-			Deletions: github.Ptr(2),
-			Total:     github.Ptr(12),
-		},
-		Files: []*github.CommitFile{
-			{
-				Filename:  github.Ptr("file1.go"),
-				Status:    github.Ptr("modified"),
-				Additions: github.Ptr(10),
-				Deletions: github.Ptr(2),
-				Changes:   github.Ptr(12),
-				Patch:     github.Ptr("@@ -1,2 +1,10 @@"),
-			},
-		},
-	}
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedCommit *github.RepositoryCommit
+		expectToolErr  bool
+		expectedSync   forkSync
 		expectedErrMsg string
 	}{
 		{
-			name: "successful commit fetch",
+			name: "upstream changes merged",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepoByRef,
-					mockResponse(t, http.StatusOK, mockCommit),
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"branch": "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepoMergeUpstreamResult{
+							Message:    github.Ptr("Successfully fetched and fast-forwarded from upstream upstream:main."),
+							MergeType:  github.Ptr("fast-forward"),
+							BaseBranch: github.Ptr("upstream:main"),
+						}),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"sha":   "abc123def456",
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError: false,
+			expectedSync: forkSync{
+				Status:     "merged",
+				MergeType:  "fast-forward",
+				BaseBranch: "upstream:main",
+				Message:    "Successfully fetched and fast-forwarded from upstream upstream:main.",
 			},
-			expectError:    false,
-			expectedCommit: mockCommit,
 		},
 		{
-			name: "commit fetch fails",
+			name: "already up to date",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepoByRef,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusOK, &github.RepoMergeUpstreamResult{
+						Message:    github.Ptr("This branch is not behind the upstream upstream:main."),
+						MergeType:  github.Ptr("none"),
+						BaseBranch: github.Ptr("upstream:main"),
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"sha":   "nonexistent-sha",
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError: false,
+			expectedSync: forkSync{
+				Status:     "up_to_date",
+				MergeType:  "none",
+				BaseBranch: "upstream:main",
+				Message:    "This branch is not behind the upstream upstream:main.",
+			},
+		},
+		{
+			name: "merge conflict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "There are merge conflicts"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError: false,
+			expectedSync: forkSync{
+				Status:  "conflict",
+				Message: "There are merge conflicts",
+			},
+		},
+		{
+			name: "not a fork",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "This repository is not a fork"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "This repository is not a fork",
+		},
+		{
+			name: "sync fork fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Forbidden"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to get commit",
+			expectedErrMsg: "failed to sync fork",
 		},
 	}
 
@@ -567,7 +906,7 @@ func Test_GetCommit(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := SyncFork(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -583,155 +922,177 @@ func Test_GetCommit(t *testing.T) {
 			}
 
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedCommit github.RepositoryCommit
-			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
+			var returnedSync forkSync
+			err = json.Unmarshal([]byte(textContent.Text), &returnedSync)
 			require.NoError(t, err)
-
-			assert.Equal(t, *tc.expectedCommit.SHA, *returnedCommit.SHA)
-			assert.Equal(t, *tc.expectedCommit.Commit.Message, *returnedCommit.Commit.Message)
-			assert.Equal(t, *tc.expectedCommit.Author.Login, *returnedCommit.Author.Login)
-			assert.Equal(t, *tc.expectedCommit.HTMLURL, *returnedCommit.HTMLURL)
+			assert.Equal(t, tc.expectedSync, returnedSync)
 		})
 	}
 }
 
-func Test_ListCommits(t *testing.T) {
+func Test_CreateBranch(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := ListCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := CreateBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "list_commits", tool.Name)
+	assert.Equal(t, "create_branch", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "sha")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "from_branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
 
-	// Setup mock commits for success case
-	mockCommits := []*github.RepositoryCommit{
-		{
+	// Setup mock repository for default branch test
+	mockRepo := &github.Repository{
+		DefaultBranch: github.Ptr("main"),
+	}
+
+	// Setup mock reference for from_branch tests
+	mockSourceRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{
 			SHA: github.Ptr("abc123def456"),
-			Commit: &github.Commit{
-				Message: github.Ptr("First commit"),
-				Author: &github.CommitAuthor{
-					Name:  github.Ptr("Test User"),
-					Email: github.Ptr("test@example.com"),
-					Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
-				},
-			},
-			Author: &github.User{
-				Login: github.Ptr("testuser"),
-			},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
 		},
-		{
-			SHA: github.Ptr("def456abc789"),
-			Commit: &github.Commit{
-				Message: github.Ptr("Second commit"),
-				Author: &github.CommitAuthor{
-					Name:  github.Ptr("Another User"),
-					Email: github.Ptr("another@example.com"),
-					Date:  &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
-				},
-			},
-			Author: &github.User{
-				Login: github.Ptr("anotheruser"),
-			},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
+	}
+
+	// Setup mock created reference
+	mockCreatedRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/new-feature"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("abc123def456"),
 		},
 	}
 
 	tests := []struct {
-		name            string
-		mockedClient    *http.Client
-		requestArgs     map[string]interface{}
-		expectError     bool
-		expectedCommits []*github.RepositoryCommit
-		expectedErrMsg  string
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRef    *github.Reference
+		expectedErrMsg string
 	}{
 		{
-			name: "successful commits fetch with default params",
+			name: "successful branch creation with from_branch",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
-					mock.GetReposCommitsByOwnerByRepo,
-					mockCommits,
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockSourceRef,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitRefsByOwnerByRepo,
+					mockCreatedRef,
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "new-feature",
+				"from_branch": "main",
 			},
-			expectError:     false,
-			expectedCommits: mockCommits,
+			expectError: false,
+			expectedRef: mockCreatedRef,
 		},
 		{
-			name: "successful commits fetch with branch",
+			name: "successful branch creation with default branch",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockSourceRef,
+				),
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{
-						"sha":      "main",
-						"page":     "1",
-						"per_page": "30",
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "refs/heads/new-feature",
+						"sha": "abc123def456",
 					}).andThen(
-						mockResponse(t, http.StatusOK, mockCommits),
+						mockResponse(t, http.StatusCreated, mockCreatedRef),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"sha":   "main",
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "new-feature",
 			},
-			expectError:     false,
-			expectedCommits: mockCommits,
+			expectError: false,
+			expectedRef: mockCreatedRef,
 		},
 		{
-			name: "successful commits fetch with pagination",
+			name: "fail to get repository",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{
-						"page":     "2",
-						"per_page": "10",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockCommits),
-					),
+					mock.GetReposByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Repository not found"}`))
+					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"page":    float64(2),
-				"perPage": float64(10),
+				"owner":  "owner",
+				"repo":   "nonexistent-repo",
+				"branch": "new-feature",
 			},
-			expectError:     false,
-			expectedCommits: mockCommits,
+			expectError:    true,
+			expectedErrMsg: "failed to get repository",
 		},
 		{
-			name: "commits fetch fails",
+			name: "fail to get reference",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
+					mock.GetReposGitRefByOwnerByRepoByRef,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+						_, _ = w.Write([]byte(`{"message": "Reference not found"}`))
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "nonexistent-repo",
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "new-feature",
+				"from_branch": "nonexistent-branch",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to list commits",
+			expectedErrMsg: "failed to get reference",
+		},
+		{
+			name: "fail to create branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockSourceRef,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Reference already exists"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "existing-branch",
+				"from_branch": "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create branch",
 		},
 	}
 
@@ -739,7 +1100,7 @@ func Test_ListCommits(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := CreateBranch(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -760,139 +1121,100 @@ func Test_ListCommits(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedCommits []*github.RepositoryCommit
-			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
+			var returnedRef github.Reference
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
 			require.NoError(t, err)
-			assert.Len(t, returnedCommits, len(tc.expectedCommits))
-			for i, commit := range returnedCommits {
-				assert.Equal(t, *tc.expectedCommits[i].SHA, *commit.SHA)
-				assert.Equal(t, *tc.expectedCommits[i].Commit.Message, *commit.Commit.Message)
-				assert.Equal(t, *tc.expectedCommits[i].Author.Login, *commit.Author.Login)
-				assert.Equal(t, *tc.expectedCommits[i].HTMLURL, *commit.HTMLURL)
-			}
+			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
+			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
 		})
 	}
 }
 
-func Test_CreateOrUpdateFile(t *testing.T) {
+func Test_GetCommit(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateOrUpdateFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "create_or_update_file", tool.Name)
+	assert.Equal(t, "get_commit", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "path")
-	assert.Contains(t, tool.InputSchema.Properties, "content")
-	assert.Contains(t, tool.InputSchema.Properties, "message")
-	assert.Contains(t, tool.InputSchema.Properties, "branch")
 	assert.Contains(t, tool.InputSchema.Properties, "sha")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path", "content", "message", "branch"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
 
-	// Setup mock file content response
-	mockFileResponse := &github.RepositoryContentResponse{
-		Content: &github.RepositoryContent{
-			Name:        github.Ptr("example.md"),
-			Path:        github.Ptr("docs/example.md"),
-			SHA:         github.Ptr("abc123def456"),
-			Size:        github.Ptr(42),
-			HTMLURL:     github.Ptr("https://github.com/owner/repo/blob/main/docs/example.md"),
-			DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/docs/example.md"),
-		},
-		Commit: github.Commit{
-			SHA:     github.Ptr("def456abc789"),
-			Message: github.Ptr("Add example file"),
+	mockCommit := &github.RepositoryCommit{
+		SHA: github.Ptr("abc123def456"),
+		Commit: &github.Commit{
+			Message: github.Ptr("First commit"),
 			Author: &github.CommitAuthor{
 				Name:  github.Ptr("Test User"),
 				Email: github.Ptr("test@example.com"),
-				Date:  &github.Timestamp{Time: time.Now()},
+				Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
+			},
+		},
+		Author: &github.User{
+			Login: github.Ptr("testuser"),
+		},
+		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
+		Stats: &github.CommitStats{
+			Additions: github.Ptr(10),
+			Deletions: github.Ptr(2),
+			Total:     github.Ptr(12),
+		},
+		Files: []*github.CommitFile{
+			{
+				Filename:  github.Ptr("file1.go"),
+				Status:    github.Ptr("modified"),
+				Additions: github.Ptr(10),
+				Deletions: github.Ptr(2),
+				Changes:   github.Ptr(12),
+				Patch:     github.Ptr("@@ -1,2 +1,10 @@"),
 			},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
 		},
 	}
 
 	tests := []struct {
-		name            string
-		mockedClient    *http.Client
-		requestArgs     map[string]interface{}
-		expectError     bool
-		expectedContent *github.RepositoryContentResponse
-		expectedErrMsg  string
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedCommit *github.RepositoryCommit
+		expectedErrMsg string
 	}{
 		{
-			name: "successful file creation",
+			name: "successful commit fetch",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PutReposContentsByOwnerByRepoByPath,
-					expectRequestBody(t, map[string]interface{}{
-						"message": "Add example file",
-						"content": "IyBFeGFtcGxlCgpUaGlzIGlzIGFuIGV4YW1wbGUgZmlsZS4=", // Base64 encoded content
-						"branch":  "main",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockFileResponse),
-					),
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusOK, mockCommit),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"path":    "docs/example.md",
-				"content": "# Example\n\nThis is an example file.",
-				"message": "Add example file",
-				"branch":  "main",
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123def456",
 			},
-			expectError:     false,
-			expectedContent: mockFileResponse,
-		},
-		{
-			name: "successful file update with SHA",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PutReposContentsByOwnerByRepoByPath,
-					expectRequestBody(t, map[string]interface{}{
-						"message": "Update example file",
-						"content": "IyBVcGRhdGVkIEV4YW1wbGUKClRoaXMgZmlsZSBoYXMgYmVlbiB1cGRhdGVkLg==", // Base64 encoded content
-						"branch":  "main",
-						"sha":     "abc123def456",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockFileResponse),
-					),
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"path":    "docs/example.md",
-				"content": "# Updated Example\n\nThis file has been updated.",
-				"message": "Update example file",
-				"branch":  "main",
-				"sha":     "abc123def456",
-			},
-			expectError:     false,
-			expectedContent: mockFileResponse,
+			expectError:    false,
+			expectedCommit: mockCommit,
 		},
 		{
-			name: "file creation fails",
+			name: "commit fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PutReposContentsByOwnerByRepoByPath,
+					mock.GetReposCommitsByOwnerByRepoByRef,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Invalid request"}`))
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"path":    "docs/example.md",
-				"content": "#Invalid Content",
-				"message": "Invalid request",
-				"branch":  "nonexistent-branch",
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "nonexistent-sha",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to create/update file",
+			expectedErrMsg: "failed to get commit",
 		},
 	}
 
@@ -900,7 +1222,7 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreateOrUpdateFile(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := GetCommit(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -921,45 +1243,39 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedContent github.RepositoryContentResponse
-			err = json.Unmarshal([]byte(textContent.Text), &returnedContent)
+			var returnedCommit github.RepositoryCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
 			require.NoError(t, err)
 
-			// Verify content
-			assert.Equal(t, *tc.expectedContent.Content.Name, *returnedContent.Content.Name)
-			assert.Equal(t, *tc.expectedContent.Content.Path, *returnedContent.Content.Path)
-			assert.Equal(t, *tc.expectedContent.Content.SHA, *returnedContent.Content.SHA)
-
-			// Verify commit
-			assert.Equal(t, *tc.expectedContent.Commit.SHA, *returnedContent.Commit.SHA)
-			assert.Equal(t, *tc.expectedContent.Commit.Message, *returnedContent.Commit.Message)
+			assert.Equal(t, *tc.expectedCommit.SHA, *returnedCommit.SHA)
+			assert.Equal(t, *tc.expectedCommit.Commit.Message, *returnedCommit.Commit.Message)
+			assert.Equal(t, *tc.expectedCommit.Author.Login, *returnedCommit.Author.Login)
+			assert.Equal(t, *tc.expectedCommit.HTMLURL, *returnedCommit.HTMLURL)
 		})
 	}
 }
 
-func Test_CreateRepository(t *testing.T) {
+func Test_GetCombinedStatus(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetCombinedStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "create_repository", tool.Name)
+	assert.Equal(t, "get_combined_status", tool.Name)
 	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "name")
-	assert.Contains(t, tool.InputSchema.Properties, "description")
-	assert.Contains(t, tool.InputSchema.Properties, "private")
-	assert.Contains(t, tool.InputSchema.Properties, "autoInit")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"name"})
-
-	// Setup mock repository response
-	mockRepo := &github.Repository{
-		Name:        github.Ptr("test-repo"),
-		Description: github.Ptr("Test repository"),
-		Private:     github.Ptr(true),
-		HTMLURL:     github.Ptr("https://github.com/testuser/test-repo"),
-		CloneURL:    github.Ptr("https://github.com/testuser/test-repo.git"),
-		CreatedAt:   &github.Timestamp{Time: time.Now()},
-		Owner: &github.User{
-			Login: github.Ptr("testuser"),
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+
+	mockStatus := &github.CombinedStatus{
+		State:      github.Ptr("failure"),
+		SHA:        github.Ptr("abc123def456"),
+		TotalCount: github.Ptr(2),
+		Statuses: []*github.RepoStatus{
+			{Context: github.Ptr("ci/build"), State: github.Ptr("success"), Description: github.Ptr("Build passed")},
+			{Context: github.Ptr("ci/test"), State: github.Ptr("failure"), Description: github.Ptr("2 tests failed")},
 		},
 	}
 
@@ -968,79 +1284,61 @@ func Test_CreateRepository(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedRepo   *github.Repository
+		expectedStatus *github.CombinedStatus
 		expectedErrMsg string
 	}{
 		{
-			name: "successful repository creation with all parameters",
+			name: "status of a branch",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.EndpointPattern{
-						Pattern: "/user/repos",
-						Method:  "POST",
-					},
-					expectRequestBody(t, map[string]interface{}{
-						"name":        "test-repo",
-						"description": "Test repository",
-						"private":     true,
-						"auto_init":   true,
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
 					}).andThen(
-						mockResponse(t, http.StatusCreated, mockRepo),
+						mockResponse(t, http.StatusOK, mockStatus),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"name":        "test-repo",
-				"description": "Test repository",
-				"private":     true,
-				"autoInit":    true,
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "feature-branch",
 			},
-			expectError:  false,
-			expectedRepo: mockRepo,
+			expectError:    false,
+			expectedStatus: mockStatus,
 		},
 		{
-			name: "successful repository creation with minimal parameters",
+			name: "ref not found",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.EndpointPattern{
-						Pattern: "/user/repos",
-						Method:  "POST",
-					},
-					expectRequestBody(t, map[string]interface{}{
-						"name":        "test-repo",
-						"auto_init":   false,
-						"description": "",
-						"private":     false,
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockRepo),
-					),
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, `{"message": "No commit found for SHA: missing"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"name": "test-repo",
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "missing",
 			},
-			expectError:  false,
-			expectedRepo: mockRepo,
+			expectError:    false,
+			expectedErrMsg: "ref not found: missing in owner/repo",
 		},
 		{
-			name: "repository creation fails",
+			name: "status fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.EndpointPattern{
-						Pattern: "/user/repos",
-						Method:  "POST",
-					},
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Repository creation failed"}`))
-					}),
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Server Error"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"name": "invalid-repo",
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "main",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to create repository",
+			expectedErrMsg: "failed to get combined status",
 		},
 	}
 
@@ -1048,7 +1346,7 @@ func Test_CreateRepository(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := GetCombinedStatus(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1064,126 +1362,1267 @@ func Test_CreateRepository(t *testing.T) {
 			}
 
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedRepo github.Repository
-			err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
-			assert.NoError(t, err)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
 
-			// Verify repository details
-			assert.Equal(t, *tc.expectedRepo.Name, *returnedRepo.Name)
-			assert.Equal(t, *tc.expectedRepo.Description, *returnedRepo.Description)
-			assert.Equal(t, *tc.expectedRepo.Private, *returnedRepo.Private)
-			assert.Equal(t, *tc.expectedRepo.HTMLURL, *returnedRepo.HTMLURL)
-			assert.Equal(t, *tc.expectedRepo.Owner.Login, *returnedRepo.Owner.Login)
+			// Unmarshal and verify the result
+			var returnedStatus github.CombinedStatus
+			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedStatus.State, *returnedStatus.State)
+			assert.Equal(t, *tc.expectedStatus.TotalCount, *returnedStatus.TotalCount)
+			require.Len(t, returnedStatus.Statuses, len(tc.expectedStatus.Statuses))
+			for i, status := range returnedStatus.Statuses {
+				assert.Equal(t, *tc.expectedStatus.Statuses[i].Context, *status.Context)
+				assert.Equal(t, *tc.expectedStatus.Statuses[i].State, *status.State)
+			}
 		})
 	}
 }
 
-func Test_PushFiles(t *testing.T) {
+func Test_ListCommitComments(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := PushFiles(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListCommitComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "push_files", tool.Name)
+	assert.Equal(t, "list_commit_comments", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "branch")
-	assert.Contains(t, tool.InputSchema.Properties, "files")
-	assert.Contains(t, tool.InputSchema.Properties, "message")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch", "files", "message"})
-
-	// Setup mock objects
-	mockRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123"),
-			URL: github.Ptr("https://api.github.com/repos/owner/repo/git/trees/abc123"),
-		},
-	}
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
 
-	mockCommit := &github.Commit{
-		SHA: github.Ptr("abc123"),
-		Tree: &github.Tree{
-			SHA: github.Ptr("def456"),
+	mockComments := []*github.RepositoryComment{
+		{
+			ID:       github.Ptr(int64(1)),
+			User:     &github.User{Login: github.Ptr("octocat")},
+			Body:     github.Ptr("Nice refactoring"),
+			CommitID: github.Ptr("abc123"),
+			HTMLURL:  github.Ptr("https://github.com/owner/repo/commit/abc123#commitcomment-1"),
 		},
-	}
-
-	mockTree := &github.Tree{
-		SHA: github.Ptr("ghi789"),
-	}
-
-	mockNewCommit := &github.Commit{
-		SHA:     github.Ptr("jkl012"),
-		Message: github.Ptr("Update multiple files"),
-		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/jkl012"),
-	}
-
-	mockUpdatedRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("jkl012"),
-			URL: github.Ptr("https://api.github.com/repos/owner/repo/git/trees/jkl012"),
+		{
+			ID:       github.Ptr(int64(2)),
+			User:     &github.User{Login: github.Ptr("hubot")},
+			Body:     github.Ptr("This leaks the file handle"),
+			Path:     github.Ptr("main.go"),
+			Position: github.Ptr(4),
+			CommitID: github.Ptr("abc123"),
+			HTMLURL:  github.Ptr("https://github.com/owner/repo/commit/abc123#r2"),
 		},
 	}
 
-	// Define test cases
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedRef    *github.Reference
-		expectedErrMsg string
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectToolErr    bool
+		expectedComments []commitComment
+		expectedText     string
+		expectedErrMsg   string
 	}{
 		{
-			name: "successful push of multiple files",
+			name: "list commit comments",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
-				),
-				// Get commit
-				mock.WithRequestMatch(
-					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
-					mockCommit,
-				),
-				// Create tree
 				mock.WithRequestMatchHandler(
-					mock.PostReposGitTreesByOwnerByRepo,
-					expectRequestBody(t, map[string]interface{}{
-						"base_tree": "def456",
-						"tree": []interface{}{
-							map[string]interface{}{
-								"path":    "README.md",
-								"mode":    "100644",
-								"type":    "blob",
-								"content": "# Updated README\n\nThis is an updated README file.",
-							},
-							map[string]interface{}{
-								"path":    "docs/example.md",
-								"mode":    "100644",
-								"type":    "blob",
-								"content": "# Example\n\nThis is an example file.",
-							},
-						},
+					mock.GetReposCommitsCommentsByOwnerByRepoByCommitSha,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
 					}).andThen(
-						mockResponse(t, http.StatusCreated, mockTree),
+						mockResponse(t, http.StatusOK, mockComments),
 					),
 				),
-				// Create commit
-				mock.WithRequestMatchHandler(
-					mock.PostReposGitCommitsByOwnerByRepo,
-					expectRequestBody(t, map[string]interface{}{
-						"message": "Update multiple files",
-						"tree":    "ghi789",
-						"parents": []interface{}{"abc123"},
-					}).andThen(
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+			},
+			expectError: false,
+			expectedComments: []commitComment{
+				{ID: 1, Author: "octocat", Body: "Nice refactoring", CommitID: "abc123", HTMLURL: "https://github.com/owner/repo/commit/abc123#commitcomment-1"},
+				{ID: 2, Author: "hubot", Body: "This leaks the file handle", Path: "main.go", Position: github.Ptr(4), CommitID: "abc123", HTMLURL: "https://github.com/owner/repo/commit/abc123#r2"},
+			},
+		},
+		{
+			name: "commit not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCommentsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "fff000",
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "commit not found: fff000 in owner/repo",
+		},
+		{
+			name: "list commit comments fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCommentsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list commit comments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCommitComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedComments []commitComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedComments, returnedComments)
+		})
+	}
+}
+
+func Test_CreateCommitComment(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateCommitComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_commit_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "position")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha", "body"})
+
+	mockComment := &github.RepositoryComment{
+		ID:       github.Ptr(int64(3)),
+		User:     &github.User{Login: github.Ptr("hubot")},
+		Body:     github.Ptr("Deployed to staging"),
+		CommitID: github.Ptr("abc123"),
+		HTMLURL:  github.Ptr("https://github.com/owner/repo/commit/abc123#commitcomment-3"),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectToolErr   bool
+		expectedComment commitComment
+		expectedText    string
+		expectedErrMsg  string
+	}{
+		{
+			name: "comment on a commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCommitsCommentsByOwnerByRepoByCommitSha,
+					expectRequestBody(t, map[string]any{
+						"body": "Deployed to staging",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockComment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"body":  "Deployed to staging",
+			},
+			expectError: false,
+			expectedComment: commitComment{
+				ID:       3,
+				Author:   "hubot",
+				Body:     "Deployed to staging",
+				CommitID: "abc123",
+				HTMLURL:  "https://github.com/owner/repo/commit/abc123#commitcomment-3",
+			},
+		},
+		{
+			name: "comment on a line of the diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCommitsCommentsByOwnerByRepoByCommitSha,
+					expectRequestBody(t, map[string]any{
+						"body":     "This leaks the file handle",
+						"path":     "main.go",
+						"position": float64(4),
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.RepositoryComment{
+							ID:       github.Ptr(int64(4)),
+							User:     &github.User{Login: github.Ptr("hubot")},
+							Body:     github.Ptr("This leaks the file handle"),
+							Path:     github.Ptr("main.go"),
+							Position: github.Ptr(4),
+							CommitID: github.Ptr("abc123"),
+							HTMLURL:  github.Ptr("https://github.com/owner/repo/commit/abc123#r4"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"sha":      "abc123",
+				"body":     "This leaks the file handle",
+				"path":     "main.go",
+				"position": float64(4),
+			},
+			expectError: false,
+			expectedComment: commitComment{
+				ID:       4,
+				Author:   "hubot",
+				Body:     "This leaks the file handle",
+				Path:     "main.go",
+				Position: github.Ptr(4),
+				CommitID: "abc123",
+				HTMLURL:  "https://github.com/owner/repo/commit/abc123#r4",
+			},
+		},
+		{
+			name:         "position without path",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"sha":      "abc123",
+				"body":     "This leaks the file handle",
+				"position": float64(4),
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "position requires path",
+		},
+		{
+			name: "position outside of the diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCommitsCommentsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"sha":      "abc123",
+				"body":     "This leaks the file handle",
+				"path":     "main.go",
+				"position": float64(400),
+			},
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "failed to create commit comment",
+		},
+		{
+			name: "create commit comment fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCommitsCommentsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"body":  "Deployed to staging",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create commit comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateCommitComment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedText)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedComment commitComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComment)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedComment, returnedComment)
+		})
+	}
+}
+
+func Test_ListCommits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "author")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "until")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "if_none_match")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Setup mock commits for success case
+	mockCommits := []*github.RepositoryCommit{
+		{
+			SHA: github.Ptr("abc123def456"),
+			Commit: &github.Commit{
+				Message: github.Ptr("First commit"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Test User"),
+					Email: github.Ptr("test@example.com"),
+					Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
+				},
+			},
+			Author: &github.User{
+				Login: github.Ptr("testuser"),
+			},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
+		},
+		{
+			SHA: github.Ptr("def456abc789"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Second commit"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Another User"),
+					Email: github.Ptr("another@example.com"),
+					Date:  &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+				},
+			},
+			Author: &github.User{
+				Login: github.Ptr("anotheruser"),
+			},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedCommits []*github.RepositoryCommit
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful commits fetch with default params",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockCommits,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sha":      "main",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "main",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with pagination",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "path filter narrows commits to the file history",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						// Only the second commit touches docs/README.md
+						commits := mockCommits
+						if r.URL.Query().Get("path") == "docs/README.md" {
+							commits = mockCommits[1:]
+						}
+						mockResponse(t, http.StatusOK, commits)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "docs/README.md",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits[1:],
+		},
+		{
+			name: "successful commits fetch with author and date range",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"author":   "anotheruser",
+						"since":    "2023-01-15T00:00:00Z",
+						"until":    "2023-02-01T12:00:00Z",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits[1:]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"author": "anotheruser",
+				"since":  "2023-01-15",
+				"until":  "2023-02-01T12:00:00Z",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits[1:],
+		},
+		{
+			name:         "invalid since timestamp",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "last week",
+			},
+			expectError:    false,
+			expectedErrMsg: "parameter since: invalid ISO 8601 timestamp: last week",
+		},
+		{
+			name: "commits fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "nonexistent-repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedCommits []*github.RepositoryCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
+			require.NoError(t, err)
+			assert.Len(t, returnedCommits, len(tc.expectedCommits))
+			for i, commit := range returnedCommits {
+				assert.Equal(t, *tc.expectedCommits[i].SHA, *commit.SHA)
+				assert.Equal(t, *tc.expectedCommits[i].Commit.Message, *commit.Commit.Message)
+				assert.Equal(t, *tc.expectedCommits[i].Author.Login, *commit.Author.Login)
+				assert.Equal(t, *tc.expectedCommits[i].HTMLURL, *commit.HTMLURL)
+			}
+		})
+	}
+}
+
+func Test_CreateOrUpdateFile(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateOrUpdateFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_or_update_file", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path", "content", "message", "branch"})
+
+	// Setup mock file content response
+	mockFileResponse := &github.RepositoryContentResponse{
+		Content: &github.RepositoryContent{
+			Name:        github.Ptr("example.md"),
+			Path:        github.Ptr("docs/example.md"),
+			SHA:         github.Ptr("abc123def456"),
+			Size:        github.Ptr(42),
+			HTMLURL:     github.Ptr("https://github.com/owner/repo/blob/main/docs/example.md"),
+			DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/docs/example.md"),
+		},
+		Commit: github.Commit{
+			SHA:     github.Ptr("def456abc789"),
+			Message: github.Ptr("Add example file"),
+			Author: &github.CommitAuthor{
+				Name:  github.Ptr("Test User"),
+				Email: github.Ptr("test@example.com"),
+				Date:  &github.Timestamp{Time: time.Now()},
+			},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectToolErr   bool
+		expectedContent *github.RepositoryContentResponse
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful file creation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Add example file",
+						"content": "IyBFeGFtcGxlCgpUaGlzIGlzIGFuIGV4YW1wbGUgZmlsZS4=", // Base64 encoded content
+						"branch":  "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileResponse),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Example\n\nThis is an example file.",
+				"message": "Add example file",
+				"branch":  "main",
+			},
+			expectError:     false,
+			expectedContent: mockFileResponse,
+		},
+		{
+			name: "successful file update with SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Update example file",
+						"content": "IyBVcGRhdGVkIEV4YW1wbGUKClRoaXMgZmlsZSBoYXMgYmVlbiB1cGRhdGVkLg==", // Base64 encoded content
+						"branch":  "main",
+						"sha":     "abc123def456",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileResponse),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Updated Example\n\nThis file has been updated.",
+				"message": "Update example file",
+				"branch":  "main",
+				"sha":     "abc123def456",
+			},
+			expectError:     false,
+			expectedContent: mockFileResponse,
+		},
+		{
+			name: "successful file update with fetched SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{
+						"ref": "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileResponse.Content),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Update example file",
+						"content": "IyBVcGRhdGVkIEV4YW1wbGUKClRoaXMgZmlsZSBoYXMgYmVlbiB1cGRhdGVkLg==", // Base64 encoded content
+						"branch":  "main",
+						"sha":     "abc123def456",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileResponse),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Updated Example\n\nThis file has been updated.",
+				"message": "Update example file",
+				"branch":  "main",
+			},
+			expectError:     false,
+			expectedContent: mockFileResponse,
+		},
+		{
+			name: "file changed after fetching its SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockFileResponse.Content,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "docs/example.md does not match abc123def456"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Updated Example\n\nThis file has been updated.",
+				"message": "Update example file",
+				"branch":  "main",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "file docs/example.md changed concurrently on main, retry",
+		},
+		{
+			name: "file created after finding it missing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Invalid request.\n\n\"sha\" wasn't supplied."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Example",
+				"message": "Add example file",
+				"branch":  "main",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "file docs/example.md changed concurrently on main, retry",
+		},
+		{
+			name: "path is a directory",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					[]*github.RepositoryContent{mockFileResponse.Content},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs",
+				"content": "# Example",
+				"message": "Add example file",
+				"branch":  "main",
+			},
+			expectError:    false,
+			expectToolErr:  true,
+			expectedErrMsg: "docs is a directory in owner/repo",
+		},
+		{
+			name: "file creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Invalid request"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "#Invalid Content",
+				"message": "Invalid request",
+				"branch":  "nonexistent-branch",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create/update file",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateOrUpdateFile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedContent github.RepositoryContentResponse
+			err = json.Unmarshal([]byte(textContent.Text), &returnedContent)
+			require.NoError(t, err)
+
+			// Verify content
+			assert.Equal(t, *tc.expectedContent.Content.Name, *returnedContent.Content.Name)
+			assert.Equal(t, *tc.expectedContent.Content.Path, *returnedContent.Content.Path)
+			assert.Equal(t, *tc.expectedContent.Content.SHA, *returnedContent.Content.SHA)
+
+			// Verify commit
+			assert.Equal(t, *tc.expectedContent.Commit.SHA, *returnedContent.Commit.SHA)
+			assert.Equal(t, *tc.expectedContent.Commit.Message, *returnedContent.Commit.Message)
+		})
+	}
+}
+
+func Test_CreateRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.Contains(t, tool.InputSchema.Properties, "private")
+	assert.Contains(t, tool.InputSchema.Properties, "autoInit")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"name"})
+
+	// Setup mock repository response
+	mockRepo := &github.Repository{
+		Name:        github.Ptr("test-repo"),
+		Description: github.Ptr("Test repository"),
+		Private:     github.Ptr(true),
+		HTMLURL:     github.Ptr("https://github.com/testuser/test-repo"),
+		CloneURL:    github.Ptr("https://github.com/testuser/test-repo.git"),
+		CreatedAt:   &github.Timestamp{Time: time.Now()},
+		Owner: &github.User{
+			Login: github.Ptr("testuser"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRepo   *github.Repository
+		expectedErrMsg string
+	}{
+		{
+			name: "successful repository creation with all parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/user/repos",
+						Method:  "POST",
+					},
+					expectRequestBody(t, map[string]interface{}{
+						"name":        "test-repo",
+						"description": "Test repository",
+						"private":     true,
+						"auto_init":   true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name":        "test-repo",
+				"description": "Test repository",
+				"private":     true,
+				"autoInit":    true,
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+		},
+		{
+			name: "successful repository creation with minimal parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/user/repos",
+						Method:  "POST",
+					},
+					expectRequestBody(t, map[string]interface{}{
+						"name":        "test-repo",
+						"auto_init":   false,
+						"description": "",
+						"private":     false,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name": "test-repo",
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+		},
+		{
+			name: "repository creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/user/repos",
+						Method:  "POST",
+					},
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Repository creation failed"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name": "invalid-repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedRepo github.Repository
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
+			assert.NoError(t, err)
+
+			// Verify repository details
+			assert.Equal(t, *tc.expectedRepo.Name, *returnedRepo.Name)
+			assert.Equal(t, *tc.expectedRepo.Description, *returnedRepo.Description)
+			assert.Equal(t, *tc.expectedRepo.Private, *returnedRepo.Private)
+			assert.Equal(t, *tc.expectedRepo.HTMLURL, *returnedRepo.HTMLURL)
+			assert.Equal(t, *tc.expectedRepo.Owner.Login, *returnedRepo.Owner.Login)
+		})
+	}
+}
+
+func Test_DeleteBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mockRepo := &github.Repository{
+		DefaultBranch: github.Ptr("main"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		branch         string
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "delete branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/git/refs/heads/merged-feature", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			branch:         "merged-feature",
+			expectedResult: "Branch merged-feature deleted from owner/repo",
+		},
+		{
+			name: "refuse to delete the default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						t.Error("the default branch must not be deleted")
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			branch:         "refs/heads/main",
+			expectedErrMsg: "refusing to delete main, it is the default branch of owner/repo",
+		},
+		{
+			name: "branch does not exist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Reference does not exist"}`),
+				),
+			),
+			branch:         "missing",
+			expectedErrMsg: "failed to delete branch missing",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			branch:         "merged-feature",
+			expectedErrMsg: "repository owner/repo not found",
+		},
+		{
+			name: "delete fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+				),
+			),
+			branch:         "merged-feature",
+			expectError:    true,
+			expectedErrMsg: "failed to delete branch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": tc.branch,
+			}))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.Equal(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
+
+func Test_PushFiles(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := PushFiles(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "push_files", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "files")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch", "files", "message"})
+
+	// Setup mock objects
+	mockRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("abc123"),
+			URL: github.Ptr("https://api.github.com/repos/owner/repo/git/trees/abc123"),
+		},
+	}
+
+	mockCommit := &github.Commit{
+		SHA: github.Ptr("abc123"),
+		Tree: &github.Tree{
+			SHA: github.Ptr("def456"),
+		},
+	}
+
+	mockTree := &github.Tree{
+		SHA: github.Ptr("ghi789"),
+	}
+
+	mockNewCommit := &github.Commit{
+		SHA:     github.Ptr("jkl012"),
+		Message: github.Ptr("Update multiple files"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/jkl012"),
+	}
+
+	mockUpdatedRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("jkl012"),
+			URL: github.Ptr("https://api.github.com/repos/owner/repo/git/trees/jkl012"),
+		},
+	}
+
+	// Define test cases
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRef    *github.Reference
+		expectedErrMsg string
+	}{
+		{
+			name: "successful push of multiple files",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				// Create tree
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTreesByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"base_tree": "def456",
+						"tree": []interface{}{
+							map[string]interface{}{
+								"path":    "README.md",
+								"mode":    "100644",
+								"type":    "blob",
+								"content": "# Updated README\n\nThis is an updated README file.",
+							},
+							map[string]interface{}{
+								"path":    "docs/example.md",
+								"mode":    "100644",
+								"type":    "blob",
+								"content": "# Example\n\nThis is an example file.",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockTree),
+					),
+				),
+				// Create commit
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Update multiple files",
+						"tree":    "ghi789",
+						"parents": []interface{}{"abc123"},
+					}).andThen(
 						mockResponse(t, http.StatusCreated, mockNewCommit),
 					),
 				),
@@ -1205,46 +2644,289 @@ func Test_PushFiles(t *testing.T) {
 				"files": []interface{}{
 					map[string]interface{}{
 						"path":    "README.md",
-						"content": "# Updated README\n\nThis is an updated README file.",
-					},
-					map[string]interface{}{
-						"path":    "docs/example.md",
-						"content": "# Example\n\nThis is an example file.",
+						"content": "# Updated README\n\nThis is an updated README file.",
+					},
+					map[string]interface{}{
+						"path":    "docs/example.md",
+						"content": "# Example\n\nThis is an example file.",
+					},
+				},
+				"message": "Update multiple files",
+			},
+			expectError: false,
+			expectedRef: mockUpdatedRef,
+		},
+		{
+			name:         "fails when files parameter is invalid",
+			mockedClient: mock.NewMockedHTTPClient(
+			// No requests expected
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"branch":  "main",
+				"files":   "invalid-files-parameter", // Not an array
+				"message": "Update multiple files",
+			},
+			expectError:    false, // This returns a tool error, not a Go error
+			expectedErrMsg: "files parameter must be an array",
+		},
+		{
+			name: "fails when files contains object without path",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"content": "# Missing path",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    false, // This returns a tool error, not a Go error
+			expectedErrMsg: "each file must have a path",
+		},
+		{
+			name: "fails when files contains object without content",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path": "README.md",
+						// Missing content
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    false, // This returns a tool error, not a Go error
+			expectedErrMsg: "each file must have content",
+		},
+		{
+			name: "fails to get branch reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "non-existent-branch",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get branch reference",
+		},
+		{
+			name: "fails to get base commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Fail to get commit
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusNotFound, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get base commit",
+		},
+		{
+			name: "fails to create tree",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				// Fail to create tree
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockResponse(t, http.StatusInternalServerError, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create tree",
+		},
+		{
+			name: "recreates the commit on the new head after a conflicting ref update",
+			mockedClient: func() *http.Client {
+				movedRef := &github.Reference{
+					Ref:    github.Ptr("refs/heads/main"),
+					Object: &github.GitObject{SHA: github.Ptr("moved1")},
+				}
+				movedCommit := &github.Commit{
+					SHA:  github.Ptr("moved1"),
+					Tree: &github.Tree{SHA: github.Ptr("movedtree")},
+				}
+				// The branch moves between reading it and updating it the first time
+				trees, commits, updates := 0, 0, 0
+				return mock.NewMockedHTTPClient(
+					mock.WithRequestMatch(
+						mock.GetReposGitRefByOwnerByRepoByRef,
+						mockRef,
+						movedRef,
+					),
+					mock.WithRequestMatch(
+						mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+						mockCommit,
+						movedCommit,
+					),
+					mock.WithRequestMatchHandler(
+						mock.PostReposGitTreesByOwnerByRepo,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							trees++
+							if trees == 1 {
+								mockResponse(t, http.StatusCreated, mockTree)(w, r)
+								return
+							}
+							// The retry builds on the tree of the new head
+							var body map[string]interface{}
+							require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+							assert.Equal(t, "movedtree", body["base_tree"])
+							mockResponse(t, http.StatusCreated, mockTree)(w, r)
+						}),
+					),
+					mock.WithRequestMatchHandler(
+						mock.PostReposGitCommitsByOwnerByRepo,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							commits++
+							if commits == 1 {
+								mockResponse(t, http.StatusCreated, &github.Commit{SHA: github.Ptr("stale1")})(w, r)
+								return
+							}
+							expectRequestBody(t, map[string]interface{}{
+								"message": "Update multiple files",
+								"tree":    "ghi789",
+								"parents": []interface{}{"moved1"},
+							}).andThen(
+								mockResponse(t, http.StatusCreated, mockNewCommit),
+							).ServeHTTP(w, r)
+						}),
+					),
+					mock.WithRequestMatchHandler(
+						mock.PatchReposGitRefsByOwnerByRepoByRef,
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							updates++
+							if updates == 1 {
+								mockResponse(t, http.StatusConflict, map[string]string{"message": "Reference update failed"})(w, r)
+								return
+							}
+							expectRequestBody(t, map[string]interface{}{
+								"sha":   "jkl012",
+								"force": false,
+							}).andThen(
+								mockResponse(t, http.StatusOK, mockUpdatedRef),
+							).ServeHTTP(w, r)
+						}),
+					),
+				)
+			}(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
 					},
 				},
-				"message": "Update multiple files",
+				"message":           "Update multiple files",
+				"retry_on_conflict": float64(2),
 			},
 			expectError: false,
 			expectedRef: mockUpdatedRef,
 		},
 		{
-			name:         "fails when files parameter is invalid",
-			mockedClient: mock.NewMockedHTTPClient(
-			// No requests expected
-			),
-			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"branch":  "main",
-				"files":   "invalid-files-parameter", // Not an array
-				"message": "Update multiple files",
-			},
-			expectError:    false, // This returns a tool error, not a Go error
-			expectedErrMsg: "files parameter must be an array",
-		},
-		{
-			name: "fails when files contains object without path",
+			name: "fails on a conflicting ref update without retries",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
 				mock.WithRequestMatch(
 					mock.GetReposGitRefByOwnerByRepoByRef,
 					mockRef,
 				),
-				// Get commit
 				mock.WithRequestMatch(
 					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
 					mockCommit,
 				),
+				mock.WithRequestMatch(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockTree,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Update is not a fast forward"}),
+				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
@@ -1252,26 +2934,41 @@ func Test_PushFiles(t *testing.T) {
 				"branch": "main",
 				"files": []interface{}{
 					map[string]interface{}{
-						"content": "# Missing path",
+						"path":    "README.md",
+						"content": "# README",
 					},
 				},
 				"message": "Update file",
 			},
-			expectError:    false, // This returns a tool error, not a Go error
-			expectedErrMsg: "each file must have a path",
+			expectError:    false,
+			expectedErrMsg: "branch main was updated while pushing, and the files were not pushed; set retry_on_conflict to push them on top of the new head",
 		},
 		{
-			name: "fails when files contains object without content",
+			name: "fails when the ref still conflicts after retrying",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
 				mock.WithRequestMatch(
 					mock.GetReposGitRefByOwnerByRepoByRef,
 					mockRef,
+					mockRef,
 				),
-				// Get commit
 				mock.WithRequestMatch(
 					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
 					mockCommit,
+					mockCommit,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockTree,
+					mockTree,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+					mockNewCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "Reference update failed"}),
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -1280,100 +2977,313 @@ func Test_PushFiles(t *testing.T) {
 				"branch": "main",
 				"files": []interface{}{
 					map[string]interface{}{
-						"path": "README.md",
-						// Missing content
+						"path":    "README.md",
+						"content": "# README",
 					},
 				},
-				"message": "Update file",
+				"message":           "Update file",
+				"retry_on_conflict": float64(1),
+			},
+			expectError:    false,
+			expectedErrMsg: "branch main was updated while pushing, and the files were not pushed, still conflicting after 1 retries",
+		},
+		{
+			name:         "fails when retry_on_conflict is out of range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message":           "Update file",
+				"retry_on_conflict": float64(10),
+			},
+			expectError:    false,
+			expectedErrMsg: "retry_on_conflict must be between 0 and 5, got 10",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := PushFiles(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			if tc.expectedErrMsg != "" {
+				require.NotNil(t, result)
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedRef github.Reference
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
+			require.NoError(t, err)
+
+			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
+			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+		})
+	}
+}
+
+func Test_ListBranches(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_branches", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Setup mock branches for success case
+	mockBranches := []*github.Branch{
+		{
+			Name:   github.Ptr("main"),
+			Commit: &github.RepositoryCommit{SHA: github.Ptr("abc123")},
+		},
+		{
+			Name:   github.Ptr("develop"),
+			Commit: &github.RepositoryCommit{SHA: github.Ptr("def456")},
+		},
+	}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		mockResponses []mock.MockBackendOption
+		wantErr       bool
+		errContains   string
+	}{
+		{
+			name: "success",
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"page":  float64(2),
+			},
+			mockResponses: []mock.MockBackendOption{
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepo,
+					mockBranches,
+				),
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing owner",
+			args: map[string]interface{}{
+				"repo": "repo",
+			},
+			mockResponses: []mock.MockBackendOption{},
+			wantErr:       false,
+			errContains:   "missing required parameter: owner",
+		},
+		{
+			name: "missing repo",
+			args: map[string]interface{}{
+				"owner": "owner",
+			},
+			mockResponses: []mock.MockBackendOption{},
+			wantErr:       false,
+			errContains:   "missing required parameter: repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock client
+			mockClient := github.NewClient(mock.NewMockedHTTPClient(tt.mockResponses...))
+			_, handler := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+			// Create request
+			request := createMCPRequest(tt.args)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			if tt.errContains != "" {
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tt.errContains)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			require.NotEmpty(t, textContent.Text)
+
+			// Verify response
+			var branches []*github.Branch
+			err = json.Unmarshal([]byte(textContent.Text), &branches)
+			require.NoError(t, err)
+			assert.Len(t, branches, 2)
+			assert.Equal(t, "main", *branches[0].Name)
+			assert.Equal(t, "develop", *branches[1].Name)
+		})
+	}
+}
+
+func Test_GetBranchStaleness(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBranchStaleness(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_branch_staleness", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mergeBaseDate := &github.Timestamp{Time: time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)}
+	mockRepo := &github.Repository{DefaultBranch: github.Ptr("main")}
+	mockComparison := &github.CommitsComparison{
+		Status:   github.Ptr("diverged"),
+		AheadBy:  github.Ptr(3),
+		BehindBy: github.Ptr(27),
+		MergeBaseCommit: &github.RepositoryCommit{
+			SHA: github.Ptr("abc123"),
+			Commit: &github.Commit{
+				Committer: &github.CommitAuthor{Date: mergeBaseDate},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectToolErr     bool
+		expectedStaleness branchStaleness
+		expectedText      string
+		expectedErrMsg    string
+	}{
+		{
+			name: "compare branch with default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/compare/main...feature", r.URL.Path)
+						mockResponse(t, http.StatusOK, mockComparison)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "feature",
+			},
+			expectError: false,
+			expectedStaleness: branchStaleness{
+				Branch:        "feature",
+				DefaultBranch: "main",
+				Status:        "diverged",
+				AheadBy:       3,
+				BehindBy:      27,
+				MergeBaseSHA:  "abc123",
+				MergeBaseDate: mergeBaseDate,
 			},
-			expectError:    false, // This returns a tool error, not a Go error
-			expectedErrMsg: "each file must have content",
 		},
 		{
-			name: "fails to get branch reference",
+			name: "repository not found",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockResponse(t, http.StatusNotFound, nil),
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
-				"repo":   "repo",
-				"branch": "non-existent-branch",
-				"files": []interface{}{
-					map[string]interface{}{
-						"path":    "README.md",
-						"content": "# README",
-					},
-				},
-				"message": "Update file",
+				"repo":   "missing",
+				"branch": "feature",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get branch reference",
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "repository not found: owner/missing",
 		},
 		{
-			name: "fails to get base commit",
+			name: "branch not found",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
 				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
 				),
-				// Fail to get commit
 				mock.WithRequestMatchHandler(
-					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
-					mockResponse(t, http.StatusNotFound, nil),
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
 				"repo":   "repo",
-				"branch": "main",
-				"files": []interface{}{
-					map[string]interface{}{
-						"path":    "README.md",
-						"content": "# README",
-					},
-				},
-				"message": "Update file",
+				"branch": "gone",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get base commit",
+			expectError:   false,
+			expectToolErr: true,
+			expectedText:  "branch not found: gone in owner/repo",
 		},
 		{
-			name: "fails to create tree",
+			name: "compare fails",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
-				),
-				// Get commit
 				mock.WithRequestMatch(
-					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
-					mockCommit,
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
 				),
-				// Fail to create tree
 				mock.WithRequestMatchHandler(
-					mock.PostReposGitTreesByOwnerByRepo,
-					mockResponse(t, http.StatusInternalServerError, nil),
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Server Error"}),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
 				"repo":   "repo",
-				"branch": "main",
-				"files": []interface{}{
-					map[string]interface{}{
-						"path":    "README.md",
-						"content": "# README",
-					},
-				},
-				"message": "Update file",
+				"branch": "feature",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to create tree",
+			expectedErrMsg: "failed to compare branches",
 		},
 	}
 
@@ -1381,7 +3291,7 @@ func Test_PushFiles(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := PushFiles(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := GetBranchStaleness(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1396,135 +3306,243 @@ func Test_PushFiles(t *testing.T) {
 				return
 			}
 
-			if tc.expectedErrMsg != "" {
-				require.NotNil(t, result)
-				textContent := getTextResult(t, result)
-				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
-				return
-			}
-
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
+			if tc.expectToolErr {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
 
 			// Unmarshal and verify the result
-			var returnedRef github.Reference
-			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
+			var returnedStaleness branchStaleness
+			err = json.Unmarshal([]byte(textContent.Text), &returnedStaleness)
 			require.NoError(t, err)
-
-			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
-			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+			assert.Equal(t, tc.expectedStaleness.Branch, returnedStaleness.Branch)
+			assert.Equal(t, tc.expectedStaleness.DefaultBranch, returnedStaleness.DefaultBranch)
+			assert.Equal(t, tc.expectedStaleness.Status, returnedStaleness.Status)
+			assert.Equal(t, tc.expectedStaleness.AheadBy, returnedStaleness.AheadBy)
+			assert.Equal(t, tc.expectedStaleness.BehindBy, returnedStaleness.BehindBy)
+			assert.Equal(t, tc.expectedStaleness.MergeBaseSHA, returnedStaleness.MergeBaseSHA)
+			assert.True(t, tc.expectedStaleness.MergeBaseDate.Equal(*returnedStaleness.MergeBaseDate))
 		})
 	}
 }
 
-func Test_ListBranches(t *testing.T) {
+func Test_GetRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "list_branches", tool.Name)
+	assert.Equal(t, "get_repository", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "if_none_match")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
-	// Setup mock branches for success case
-	mockBranches := []*github.Branch{
+	mockRepo := &github.Repository{
+		ID:            github.Ptr(int64(1)),
+		Name:          github.Ptr("repo"),
+		FullName:      github.Ptr("owner/repo"),
+		DefaultBranch: github.Ptr("main"),
+		HTMLURL:       github.Ptr("https://github.com/owner/repo"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRepo   *github.Repository
+		expectedETag   string
+		expectedErrMsg string
+	}{
 		{
-			Name:   github.Ptr("main"),
-			Commit: &github.RepositoryCommit{SHA: github.Ptr("abc123")},
+			name: "successful repository retrieval",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("ETag", `"abc"`)
+						mockResponse(t, http.StatusOK, mockRepo)(w, nil)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+			expectedETag: `"abc"`,
 		},
 		{
-			Name:   github.Ptr("develop"),
-			Commit: &github.RepositoryCommit{SHA: github.Ptr("def456")},
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository",
 		},
 	}
 
-	// Test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedETag, result.Meta["etag"])
+
+			// Unmarshal and verify the result
+			var returnedRepo github.Repository
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedRepo.FullName, *returnedRepo.FullName)
+			assert.Equal(t, *tc.expectedRepo.DefaultBranch, *returnedRepo.DefaultBranch)
+		})
+	}
+}
+
+func Test_ListLanguages(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListLanguages(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_languages", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
 	tests := []struct {
-		name          string
-		args          map[string]interface{}
-		mockResponses []mock.MockBackendOption
-		wantErr       bool
-		errContains   string
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedLanguages []language
+		expectedErrMsg    string
 	}{
 		{
-			name: "success",
-			args: map[string]interface{}{
+			name: "languages sorted by size",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposLanguagesByOwnerByRepo,
+					map[string]int{"Shell": 500, "Go": 8000, "Makefile": 500, "Dockerfile": 1000},
+				),
+			),
+			requestArgs: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
-				"page":  float64(2),
 			},
-			mockResponses: []mock.MockBackendOption{
+			expectError: false,
+			expectedLanguages: []language{
+				{Name: "Go", Bytes: 8000, Percentage: 80},
+				{Name: "Dockerfile", Bytes: 1000, Percentage: 10},
+				{Name: "Makefile", Bytes: 500, Percentage: 5},
+				{Name: "Shell", Bytes: 500, Percentage: 5},
+			},
+		},
+		{
+			name: "percentages are rounded",
+			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatch(
-					mock.GetReposBranchesByOwnerByRepo,
-					mockBranches,
+					mock.GetReposLanguagesByOwnerByRepo,
+					map[string]int{"Go": 2, "Python": 1},
 				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedLanguages: []language{
+				{Name: "Go", Bytes: 2, Percentage: 66.7},
+				{Name: "Python", Bytes: 1, Percentage: 33.3},
 			},
-			wantErr: false,
 		},
 		{
-			name: "missing owner",
-			args: map[string]interface{}{
-				"repo": "repo",
+			name: "repository without code",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposLanguagesByOwnerByRepo,
+					map[string]int{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
 			},
-			mockResponses: []mock.MockBackendOption{},
-			wantErr:       false,
-			errContains:   "missing required parameter: owner",
+			expectError:       false,
+			expectedLanguages: []language{},
 		},
 		{
-			name: "missing repo",
-			args: map[string]interface{}{
+			name: "list languages fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLanguagesByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
 				"owner": "owner",
+				"repo":  "missing",
 			},
-			mockResponses: []mock.MockBackendOption{},
-			wantErr:       false,
-			errContains:   "missing required parameter: repo",
+			expectError:    true,
+			expectedErrMsg: "failed to list languages",
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Create mock client
-			mockClient := github.NewClient(mock.NewMockedHTTPClient(tt.mockResponses...))
-			_, handler := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListLanguages(stubGetClientFn(client), translations.NullTranslationHelper)
 
-			// Create request
-			request := createMCPRequest(tt.args)
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
 
 			// Call handler
 			result, err := handler(context.Background(), request)
-			if tt.wantErr {
+
+			// Verify results
+			if tc.expectError {
 				require.Error(t, err)
-				if tt.errContains != "" {
-					assert.Contains(t, err.Error(), tt.errContains)
-				}
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
 				return
 			}
 
 			require.NoError(t, err)
-			require.NotNil(t, result)
-
-			if tt.errContains != "" {
-				textContent := getTextResult(t, result)
-				assert.Contains(t, textContent.Text, tt.errContains)
-				return
-			}
-
 			textContent := getTextResult(t, result)
-			require.NotEmpty(t, textContent.Text)
 
-			// Verify response
-			var branches []*github.Branch
-			err = json.Unmarshal([]byte(textContent.Text), &branches)
+			// Unmarshal and verify the result
+			var returnedLanguages []language
+			err = json.Unmarshal([]byte(textContent.Text), &returnedLanguages)
 			require.NoError(t, err)
-			assert.Len(t, branches, 2)
-			assert.Equal(t, "main", *branches[0].Name)
-			assert.Equal(t, "develop", *branches[1].Name)
+			assert.Equal(t, tc.expectedLanguages, returnedLanguages)
 		})
 	}
 }
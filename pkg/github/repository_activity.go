@@ -0,0 +1,189 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+var (
+	// repositoryActivityTypes are the kinds of ref changes the repository activity endpoint reports.
+	repositoryActivityTypes = []string{"push", "force_push", "branch_creation", "branch_deletion", "pr_merge", "merge_queue_merge"}
+
+	// repositoryActivityPeriods are the time periods repository activity can be limited to.
+	repositoryActivityPeriods = []string{"day", "week", "month", "quarter", "year"}
+)
+
+// repositoryActivity is a change to a ref of a repository. Before and After are the SHAs the
+// ref pointed to before and after the change; Before is all zeros for created branches and
+// After for deleted ones.
+type repositoryActivity struct {
+	ID           int64             `json:"id"`
+	ActivityType string            `json:"activity_type"`
+	Actor        string            `json:"actor,omitempty"`
+	Ref          string            `json:"ref"`
+	Before       string            `json:"before"`
+	After        string            `json:"after"`
+	Timestamp    *github.Timestamp `json:"timestamp,omitempty"`
+}
+
+// repositoryActivityPage is a page of repository activity with the cursor to fetch the next one.
+type repositoryActivityPage struct {
+	HasNextPage bool                 `json:"has_next_page"`
+	EndCursor   string               `json:"end_cursor,omitempty"`
+	Activity    []repositoryActivity `json:"activity"`
+}
+
+// ListRepositoryActivity creates a tool to list the pushes, force pushes, merges and branch
+// creations and deletions of a repository.
+func ListRepositoryActivity(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_activity",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_ACTIVITY_DESCRIPTION", "List the changes to the branches of a GitHub repository, newest first: pushes, force pushes, merges, and branch creations and deletions, with who made them and the SHAs before and after. Useful to audit force pushes")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Only list activity on this ref, e.g. 'main' or 'refs/heads/main'"),
+			),
+			mcp.WithString("actor",
+				mcp.Description("Only list activity by this user"),
+			),
+			mcp.WithString("activity_type",
+				mcp.Description("Only list activity of this type"),
+				mcp.Enum(repositoryActivityTypes...),
+			),
+			mcp.WithString("time_period",
+				mcp.Description("Only list activity from the last day, week, month, quarter or year"),
+				mcp.Enum(repositoryActivityPeriods...),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			actor, err := OptionalParam[string](request, "actor")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			activityType, err := OptionalParam[string](request, "activity_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			timePeriod, err := OptionalParam[string](request, "time_period")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if activityType != "" && !slices.Contains(repositoryActivityTypes, activityType) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid activity_type %q, must be one of: %s", activityType, strings.Join(repositoryActivityTypes, ", "))), nil
+			}
+			if timePeriod != "" && !slices.Contains(repositoryActivityPeriods, timePeriod) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid time_period %q, must be one of: %s", timePeriod, strings.Join(repositoryActivityPeriods, ", "))), nil
+			}
+
+			query := url.Values{}
+			query.Set("per_page", strconv.Itoa(pagination.perPage))
+			for name, value := range map[string]string{
+				"after":         pagination.after,
+				"ref":           ref,
+				"actor":         actor,
+				"activity_type": activityType,
+				"time_period":   timePeriod,
+			} {
+				if value != "" {
+					query.Set(name, value)
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github has no method for this endpoint
+			u := fmt.Sprintf("repos/%s/%s/activity?%s", url.PathEscape(owner), url.PathEscape(repo), query.Encode())
+			req, err := client.NewRequest(http.MethodGet, u, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var activities []struct {
+				ID           int64             `json:"id"`
+				Before       string            `json:"before"`
+				After        string            `json:"after"`
+				Ref          string            `json:"ref"`
+				Timestamp    *github.Timestamp `json:"timestamp"`
+				ActivityType string            `json:"activity_type"`
+				Actor        *github.User      `json:"actor"`
+			}
+			resp, err := client.Do(ctx, req, &activities)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list repository activity: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list repository activity: %s", string(body))), nil
+			}
+
+			// The cursor of the next page is in the "after" parameter of the next link
+			page := repositoryActivityPage{
+				HasNextPage: resp.After != "",
+				EndCursor:   resp.After,
+				Activity:    make([]repositoryActivity, 0, len(activities)),
+			}
+			for _, a := range activities {
+				page.Activity = append(page.Activity, repositoryActivity{
+					ID:           a.ID,
+					ActivityType: a.ActivityType,
+					Actor:        a.Actor.GetLogin(),
+					Ref:          a.Ref,
+					Before:       a.Before,
+					After:        a.After,
+					Timestamp:    a.Timestamp,
+				})
+			}
+
+			r, err := json.Marshal(page)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,178 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryActivity(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryActivity(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_activity", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "actor")
+	assert.Contains(t, tool.InputSchema.Properties, "activity_type")
+	assert.Contains(t, tool.InputSchema.Properties, "time_period")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockActivity := []map[string]interface{}{
+		{
+			"id":            1296269,
+			"node_id":       "MDEwOlJlcG9zaXRvcnkxMjk2MjY5",
+			"before":        "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"after":         "827efc6d56897b048c772eb4087f854f46256132",
+			"ref":           "refs/heads/main",
+			"timestamp":     "2025-03-01T10:00:00Z",
+			"activity_type": "force_push",
+			"actor":         map[string]interface{}{"login": "octocat", "id": 1},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "filtered activity with next page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActivityByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"per_page":      "10",
+						"after":         "Y3Vyc29yOjE=",
+						"ref":           "main",
+						"actor":         "octocat",
+						"activity_type": "force_push",
+						"time_period":   "week",
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							w.Header().Set("Link", `<https://api.github.com/repositories/1/activity?per_page=10&after=Y3Vyc29yOjI%3D>; rel="next"`)
+							mockResponse(t, http.StatusOK, mockActivity)(w, r)
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"ref":           "main",
+				"actor":         "octocat",
+				"activity_type": "force_push",
+				"time_period":   "week",
+				"perPage":       float64(10),
+				"after":         "Y3Vyc29yOjE=",
+			},
+			expectedResult: `{"has_next_page": true, "end_cursor": "Y3Vyc29yOjI=", "activity": [{
+				"id": 1296269,
+				"activity_type": "force_push",
+				"actor": "octocat",
+				"ref": "refs/heads/main",
+				"before": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"after": "827efc6d56897b048c772eb4087f854f46256132",
+				"timestamp": "2025-03-01T10:00:00Z"
+			}]}`,
+		},
+		{
+			name: "last page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActivityByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"per_page": "30"}).andThen(
+						mockResponse(t, http.StatusOK, []map[string]interface{}{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: `{"has_next_page": false, "activity": []}`,
+		},
+		{
+			name:         "invalid activity type",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"activity_type": "tag_creation",
+			},
+			expectedErrMsg: `invalid activity_type "tag_creation", must be one of: push, force_push, branch_creation, branch_deletion, pr_merge, merge_queue_merge`,
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActivityByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "repository owner/missing not found",
+		},
+		{
+			name: "server error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActivityByOwnerByRepo,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list repository activity",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositoryActivity(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
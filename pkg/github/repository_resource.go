@@ -9,7 +9,10 @@ import (
 	"mime"
 	"net/http"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -18,52 +21,220 @@ import (
 )
 
 // GetRepositoryResourceContent defines the resource template and handler for getting repository content.
-func GetRepositoryResourceContent(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+func GetRepositoryResourceContent(getClient GetClientFn, cache *resourceCache, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
 	return mcp.NewResourceTemplate(
-			"repo://{owner}/{repo}/contents{/path*}", // Resource template
+			"repo://{owner}/{repo}/contents{/path*}{?lines}", // Resource template
 			t("RESOURCE_REPOSITORY_CONTENT_DESCRIPTION", "Repository Content"),
 		),
-		RepositoryResourceContentsHandler(getClient)
+		RepositoryResourceContentsHandler(getClient, cache)
 }
 
 // GetRepositoryResourceBranchContent defines the resource template and handler for getting repository content for a branch.
-func GetRepositoryResourceBranchContent(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+func GetRepositoryResourceBranchContent(getClient GetClientFn, cache *resourceCache, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
 	return mcp.NewResourceTemplate(
-			"repo://{owner}/{repo}/refs/heads/{branch}/contents{/path*}", // Resource template
+			"repo://{owner}/{repo}/refs/heads/{branch}/contents{/path*}{?lines}", // Resource template
 			t("RESOURCE_REPOSITORY_CONTENT_BRANCH_DESCRIPTION", "Repository Content for specific branch"),
 		),
-		RepositoryResourceContentsHandler(getClient)
+		RepositoryResourceContentsHandler(getClient, cache)
 }
 
 // GetRepositoryResourceCommitContent defines the resource template and handler for getting repository content for a commit.
-func GetRepositoryResourceCommitContent(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+// With ?view=diff, the resource is the unified diff of the commit instead.
+func GetRepositoryResourceCommitContent(getClient GetClientFn, cache *resourceCache, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
 	return mcp.NewResourceTemplate(
-			"repo://{owner}/{repo}/sha/{sha}/contents{/path*}", // Resource template
+			"repo://{owner}/{repo}/sha/{sha}/contents{/path*}{?lines,view}", // Resource template
 			t("RESOURCE_REPOSITORY_CONTENT_COMMIT_DESCRIPTION", "Repository Content for specific commit"),
 		),
-		RepositoryResourceContentsHandler(getClient)
+		RepositoryResourceContentsHandler(getClient, cache)
 }
 
 // GetRepositoryResourceTagContent defines the resource template and handler for getting repository content for a tag.
-func GetRepositoryResourceTagContent(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+func GetRepositoryResourceTagContent(getClient GetClientFn, cache *resourceCache, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
 	return mcp.NewResourceTemplate(
-			"repo://{owner}/{repo}/refs/tags/{tag}/contents{/path*}", // Resource template
+			"repo://{owner}/{repo}/refs/tags/{tag}/contents{/path*}{?lines}", // Resource template
 			t("RESOURCE_REPOSITORY_CONTENT_TAG_DESCRIPTION", "Repository Content for specific tag"),
 		),
-		RepositoryResourceContentsHandler(getClient)
+		RepositoryResourceContentsHandler(getClient, cache)
 }
 
 // GetRepositoryResourcePrContent defines the resource template and handler for getting repository content for a pull request.
-func GetRepositoryResourcePrContent(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+// With ?view=diff, the resource is the unified diff of the pull request instead.
+func GetRepositoryResourcePrContent(getClient GetClientFn, cache *resourceCache, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
 	return mcp.NewResourceTemplate(
-			"repo://{owner}/{repo}/refs/pull/{prNumber}/head/contents{/path*}", // Resource template
+			"repo://{owner}/{repo}/refs/pull/{prNumber}/head/contents{/path*}{?lines,view}", // Resource template
 			t("RESOURCE_REPOSITORY_CONTENT_PR_DESCRIPTION", "Repository Content for specific pull request"),
 		),
-		RepositoryResourceContentsHandler(getClient)
+		RepositoryResourceContentsHandler(getClient, cache)
+}
+
+// GetRepositoryResourceRefContent defines the resource template and handler for getting repository content
+// for any ref. GitHub resolves the ref to a branch, tag or commit SHA, so callers don't need to know which it is.
+// The ref is a reserved expansion so that it can hold slashes, as in feature/x, encoded or not.
+func GetRepositoryResourceRefContent(getClient GetClientFn, cache *resourceCache, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+	return mcp.NewResourceTemplate(
+			"repo://{owner}/{repo}/ref/{+ref}/contents{/path*}{?lines}", // Resource template
+			t("RESOURCE_REPOSITORY_CONTENT_REF_DESCRIPTION", "Repository Content for a branch, tag or commit SHA"),
+		),
+		RepositoryResourceContentsHandler(getClient, cache)
+}
+
+// maxPullRequestDiffSize is the largest pull request diff, in bytes, returned by the pull request
+// resource. Larger diffs would flood the context of the client, which should rather look at the
+// changed files one by one.
+const maxPullRequestDiffSize = 1 << 20
+
+// pullRequestDiffContents returns the unified diff of a pull request as the contents of a resource,
+// or the lines of it in the range.
+func pullRequestDiffContents(ctx context.Context, client *github.Client, uri, owner, repo string, prNumber int, lines *lineRange) ([]mcp.ResourceContents, error) {
+	diff, resp, err := client.PullRequests.GetRaw(ctx, owner, repo, prNumber, github.RawOptions{Type: github.Diff})
+	if err != nil {
+		// GitHub refuses to render diffs that are too large
+		if resp != nil && resp.StatusCode == http.StatusNotAcceptable {
+			return nil, fmt.Errorf("the diff of pull request #%d is too large for GitHub to render, list its changed files instead", prNumber)
+		}
+		return nil, fmt.Errorf("failed to get pull request diff: %w", err)
+	}
+	if len(diff) > maxPullRequestDiffSize {
+		return nil, fmt.Errorf("the diff of pull request #%d is %d bytes, more than the limit of %d, list its changed files instead", prNumber, len(diff), maxPullRequestDiffSize)
+	}
+	if lines != nil {
+		diff, err = lines.apply(diff)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      uri,
+			MIMEType: "text/x-diff",
+			Text:     diff,
+		},
+	}, nil
+}
+
+// maxCommitDiffSize is the largest commit diff, in bytes, returned by the commit resource. Unlike
+// pull request diffs, larger commit diffs are truncated, since a commit can't be split further.
+const maxCommitDiffSize = 1 << 20
+
+// commitDiffContents returns the unified diff of a commit as the contents of a resource, or the
+// lines of it in the range. Diffs over maxCommitDiffSize are truncated.
+func commitDiffContents(ctx context.Context, client *github.Client, uri, owner, repo, sha string, lines *lineRange) ([]mcp.ResourceContents, error) {
+	diff, resp, err := client.Repositories.GetCommitRaw(ctx, owner, repo, sha, github.RawOptions{Type: github.Diff})
+	if err != nil {
+		// GitHub refuses to render diffs that are too large
+		if resp != nil && (resp.StatusCode == http.StatusNotAcceptable || resp.StatusCode == http.StatusUnprocessableEntity) {
+			return nil, fmt.Errorf("the diff of commit %s is too large for GitHub to render, list its changed files instead", sha)
+		}
+		return nil, fmt.Errorf("failed to get commit diff: %w", err)
+	}
+	if lines != nil {
+		diff, err = lines.apply(diff)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      uri,
+			MIMEType: "text/x-diff",
+			Text:     truncateDiff(diff, maxCommitDiffSize),
+		},
+	}, nil
+}
+
+// truncateDiff cuts a unified diff longer than limit bytes, preferably before the header of a
+// file so that the files that are kept are complete, and notes how much was left out.
+func truncateDiff(diff string, limit int) string {
+	if len(diff) <= limit {
+		return diff
+	}
+	const fileHeader = "diff --git "
+	countFiles := func(s string) int {
+		n := strings.Count(s, "\n"+fileHeader)
+		if strings.HasPrefix(s, fileHeader) {
+			n++
+		}
+		return n
+	}
+
+	// Cut before the last file header within the limit, or within the first file if it is
+	// larger than the limit on its own.
+	// A header whose newline is within the limit counts, even if the rest of the header isn't.
+	cut := strings.LastIndex(diff[:min(len(diff), limit+len(fileHeader))], "\n"+fileHeader)
+	complete := true
+	if cut <= 0 {
+		cut = strings.LastIndex(diff[:limit], "\n")
+		complete = false
+	}
+	kept := diff[:cut+1]
+	shown := countFiles(kept)
+	if !complete {
+		shown--
+	}
+	return kept + fmt.Sprintf("[diff truncated to %d of %d bytes: %d of %d files shown in full]\n", len(kept), len(diff), max(shown, 0), countFiles(diff))
+}
+
+// lineRange is a 1-based, inclusive range of lines of a file requested with the lines query
+// parameter of a repository content resource, e.g. ?lines=100-200.
+type lineRange struct {
+	start, end int
+}
+
+// parseLineRange parses a line range of the form "N-M", or "N" for a single line.
+func parseLineRange(s string) (lineRange, error) {
+	startStr, endStr, isRange := strings.Cut(s, "-")
+	if !isRange {
+		endStr = startStr
+	}
+	start, err := strconv.Atoi(startStr)
+	if err != nil || start < 1 {
+		return lineRange{}, fmt.Errorf("invalid lines %q, must be a line number or a range of line numbers like 100-200", s)
+	}
+	end, err := strconv.Atoi(endStr)
+	if err != nil || end < 1 {
+		return lineRange{}, fmt.Errorf("invalid lines %q, must be a line number or a range of line numbers like 100-200", s)
+	}
+	if start > end {
+		return lineRange{}, fmt.Errorf("invalid lines %q, the range ends before it starts", s)
+	}
+	return lineRange{start: start, end: end}, nil
+}
+
+// apply returns the lines of content in the range, preceded by a line noting the range and the
+// total number of lines of the file.
+func (r lineRange) apply(content string) (string, error) {
+	lines := strings.SplitAfter(content, "\n")
+	// A trailing newline terminates the last line rather than starting another one.
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if r.end > len(lines) {
+		return "", fmt.Errorf("invalid lines %d-%d, the file has %d lines", r.start, r.end, len(lines))
+	}
+	header := fmt.Sprintf("[lines %d-%d of %d]\n", r.start, r.end, len(lines))
+	return header + strings.Join(lines[r.start-1:r.end], ""), nil
+}
+
+// splitRefPath splits the ref matched by {+ref} at its first contents segment, moving the rest
+// to the front of path. The match is greedy, so .../ref/main/contents/docs/contents/a.md gives
+// the ref main/contents/docs and the path a.md; refs rarely have a contents segment, whereas
+// paths can.
+func splitRefPath(ref, path string) (string, string) {
+	segments := strings.Split(ref, "/")
+	i := slices.Index(segments[1:], "contents") + 1
+	if i == 0 {
+		return ref, path
+	}
+	// The contents segment matched by the template belongs to the path too
+	rest := append(segments[i+1:], "contents")
+	if path != "" {
+		rest = append(rest, path)
+	}
+	return strings.Join(segments[:i], "/"), strings.Join(rest, "/")
 }
 
 // RepositoryResourceContentsHandler returns a handler function for repository content requests.
-func RepositoryResourceContentsHandler(getClient GetClientFn) func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+func RepositoryResourceContentsHandler(getClient GetClientFn, cache *resourceCache) func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
 	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
 		// the matcher will give []string with one element
 		// https://github.com/mark3labs/mcp-go/pull/54
@@ -86,8 +257,23 @@ func RepositoryResourceContentsHandler(getClient GetClientFn) func(ctx context.C
 			path = strings.Join(p, "/")
 		}
 
+		var lines *lineRange
+		l, ok := request.Params.Arguments["lines"].([]string)
+		if ok && len(l) > 0 && l[0] != "" {
+			r, err := parseLineRange(l[0])
+			if err != nil {
+				return nil, err
+			}
+			lines = &r
+		}
+
 		opts := &github.RepositoryContentGetOptions{}
 
+		ref, ok := request.Params.Arguments["ref"].([]string)
+		if ok && len(ref) > 0 {
+			opts.Ref, path = splitRefPath(ref[0], path)
+		}
+
 		sha, ok := request.Params.Arguments["sha"].([]string)
 		if ok && len(sha) > 0 {
 			opts.Ref = sha[0]
@@ -111,96 +297,213 @@ func RepositoryResourceContentsHandler(getClient GetClientFn) func(ctx context.C
 		if err != nil {
 			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 		}
-		fileContent, directoryContent, _, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+
+		view, ok := request.Params.Arguments["view"].([]string)
+		if ok && len(view) > 0 && view[0] != "" {
+			if view[0] != "diff" {
+				return nil, fmt.Errorf("invalid view %q, must be diff", view[0])
+			}
+			if len(prNumber) == 0 && len(sha) == 0 {
+				return nil, errors.New("view can only be requested for pull requests and commits")
+			}
+			if len(sha) > 0 {
+				if path != "" {
+					return nil, fmt.Errorf("view=diff returns the diff of the whole commit, it cannot be combined with the path %s", path)
+				}
+				return commitDiffContents(ctx, client, request.Params.URI, owner, repo, sha[0], lines)
+			}
+			if path != "" {
+				return nil, fmt.Errorf("view=diff returns the diff of the whole pull request, it cannot be combined with the path %s", path)
+			}
+			number, err := strconv.Atoi(prNumber[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid pull request number %q", prNumber[0])
+			}
+			return pullRequestDiffContents(ctx, client, request.Params.URI, owner, repo, number, lines)
+		}
+
+		// Resources are polled by clients, so only fetch them again when they have changed
+		uri := request.Params.URI
+		contentsClient := client
+		cached, isCached := cache.lookup(uri)
+		if isCached {
+			contentsClient = ifNoneMatchClient(client, cached.etag)
+		}
+		fileContent, directoryContent, resp, err := contentsClient.Repositories.GetContents(ctx, owner, repo, path, opts)
+		if isCached && isNotModified(resp) {
+			return cached.contents, nil
+		}
 		if err != nil {
 			return nil, err
 		}
 
-		if directoryContent != nil {
-			var resources []mcp.ResourceContents
-			for _, entry := range directoryContent {
-				mimeType := "text/directory"
-				if entry.GetType() == "file" {
-					// this is system dependent, and a best guess
-					ext := filepath.Ext(entry.GetName())
-					mimeType = mime.TypeByExtension(ext)
-					if ext == ".md" {
-						mimeType = "text/markdown"
-					}
-				}
-				resources = append(resources, mcp.TextResourceContents{
-					URI:      entry.GetHTMLURL(),
-					MIMEType: mimeType,
-					Text:     entry.GetName(),
-				})
+		contents, err := repositoryResourceContents(client, uri, path, fileContent, directoryContent, lines)
+		if err != nil {
+			return nil, err
+		}
+		cache.store(uri, resp.Header.Get("ETag"), contents)
+		return contents, nil
+	}
+}
 
+// repositoryResourceContents returns the entries of a directory, or the content of a file
+// downloaded as text or as a base64 encoded blob depending on its MIME type.
+func repositoryResourceContents(client *github.Client, uri, path string, fileContent *github.RepositoryContent, directoryContent []*github.RepositoryContent, lines *lineRange) ([]mcp.ResourceContents, error) {
+	if directoryContent != nil {
+		if lines != nil {
+			return nil, fmt.Errorf("lines can only be requested for files, %s is a directory", path)
+		}
+		var resources []mcp.ResourceContents
+		for _, entry := range directoryContent {
+			mimeType := "text/directory"
+			if entry.GetType() == "file" {
+				// this is system dependent, and a best guess
+				ext := filepath.Ext(entry.GetName())
+				mimeType = mime.TypeByExtension(ext)
+				if ext == ".md" {
+					mimeType = "text/markdown"
+				}
 			}
-			return resources, nil
+			resources = append(resources, mcp.TextResourceContents{
+				URI:      entry.GetHTMLURL(),
+				MIMEType: mimeType,
+				Text:     entry.GetName(),
+			})
 
 		}
-		if fileContent != nil {
-			if fileContent.Content != nil {
-				// download the file content from fileContent.GetDownloadURL() and use the content-type header to determine the MIME type
-				// and return the content as a blob unless it is a text file, where you can return the content as text
-				req, err := http.NewRequest("GET", fileContent.GetDownloadURL(), nil)
-				if err != nil {
-					return nil, fmt.Errorf("failed to create request: %w", err)
-				}
+		return resources, nil
+
+	}
+	if fileContent != nil {
+		if fileContent.Content != nil {
+			// download the file content from fileContent.GetDownloadURL() and use the content-type header to determine the MIME type
+			// and return the content as a blob unless it is a text file, where you can return the content as text
+			req, err := http.NewRequest("GET", fileContent.GetDownloadURL(), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			resp, err := client.Client().Do(req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to send request: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
 
-				resp, err := client.Client().Do(req)
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
 				if err != nil {
-					return nil, fmt.Errorf("failed to send request: %w", err)
+					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				defer func() { _ = resp.Body.Close() }()
+				return nil, fmt.Errorf("failed to fetch file content: %s", string(body))
+			}
 
-				if resp.StatusCode != http.StatusOK {
-					body, err := io.ReadAll(resp.Body)
-					if err != nil {
-						return nil, fmt.Errorf("failed to read response body: %w", err)
-					}
-					return nil, fmt.Errorf("failed to fetch file content: %s", string(body))
-				}
+			ext := filepath.Ext(fileContent.GetName())
+			mimeType := resp.Header.Get("Content-Type")
+			if ext == ".md" {
+				mimeType = "text/markdown"
+			} else if mimeType == "" {
+				// backstop to the file extension if the content type is not set
+				mimeType = mime.TypeByExtension(filepath.Ext(fileContent.GetName()))
+			}
 
-				ext := filepath.Ext(fileContent.GetName())
-				mimeType := resp.Header.Get("Content-Type")
-				if ext == ".md" {
-					mimeType = "text/markdown"
-				} else if mimeType == "" {
-					// backstop to the file extension if the content type is not set
-					mimeType = mime.TypeByExtension(filepath.Ext(fileContent.GetName()))
+			// if the content is a string, return it as text
+			if strings.HasPrefix(mimeType, "text") {
+				content, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse the response body: %w", err)
 				}
 
-				// if the content is a string, return it as text
-				if strings.HasPrefix(mimeType, "text") {
-					content, err := io.ReadAll(resp.Body)
+				text := string(content)
+				if lines != nil {
+					text, err = lines.apply(text)
 					if err != nil {
-						return nil, fmt.Errorf("failed to parse the response body: %w", err)
+						return nil, err
 					}
-
-					return []mcp.ResourceContents{
-						mcp.TextResourceContents{
-							URI:      request.Params.URI,
-							MIMEType: mimeType,
-							Text:     string(content),
-						},
-					}, nil
-				}
-				// otherwise, read the content and encode it as base64
-				decodedContent, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to parse the response body: %w", err)
 				}
 
 				return []mcp.ResourceContents{
-					mcp.BlobResourceContents{
-						URI:      request.Params.URI,
+					mcp.TextResourceContents{
+						URI:      uri,
 						MIMEType: mimeType,
-						Blob:     base64.StdEncoding.EncodeToString(decodedContent), // Encode content as Base64
+						Text:     text,
 					},
 				}, nil
 			}
+			if lines != nil {
+				return nil, fmt.Errorf("lines can only be requested for text files, %s is %s", path, mimeType)
+			}
+			// otherwise, read the content and encode it as base64
+			decodedContent, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the response body: %w", err)
+			}
+
+			return []mcp.ResourceContents{
+				mcp.BlobResourceContents{
+					URI:      uri,
+					MIMEType: mimeType,
+					Blob:     base64.StdEncoding.EncodeToString(decodedContent), // Encode content as Base64
+				},
+			}, nil
 		}
+	}
+
+	return nil, errors.New("no repository resource content found")
+}
+
+// maxCachedResources is the number of repository resources whose contents are kept to answer
+// repeated reads of unchanged resources.
+const maxCachedResources = 500
 
-		return nil, errors.New("no repository resource content found")
+// resourceCache maps the URIs of resources to their contents and the ETag GitHub returned with
+// them, so that they can be fetched conditionally. A 304 Not Modified response does not count
+// against the rate limit. Each server has its own cache, so servers with different tokens don't
+// share contents.
+type resourceCache struct {
+	mu        sync.Mutex
+	size      int
+	resources map[string]cachedResource
+}
+
+type cachedResource struct {
+	etag     string
+	contents []mcp.ResourceContents
+}
+
+func newResourceCache(size int) *resourceCache {
+	return &resourceCache{
+		size:      size,
+		resources: make(map[string]cachedResource),
+	}
+}
+
+// lookup returns the cached contents of the resource with the URI, if there are any.
+func (c *resourceCache) lookup(uri string) (cachedResource, bool) {
+	if uri == "" {
+		return cachedResource{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	resource, ok := c.resources[uri]
+	return resource, ok
+}
+
+// store records the contents of the resource with the URI and their ETag. When the cache is
+// full, an arbitrary resource is forgotten to make room. Resources without an ETag can't be
+// fetched conditionally, so they are not recorded.
+func (c *resourceCache) store(uri, etag string, contents []mcp.ResourceContents) {
+	if uri == "" || etag == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.resources[uri]; !ok && len(c.resources) >= c.size {
+		for evicted := range c.resources {
+			delete(c.resources, evicted)
+			break
+		}
 	}
+	c.resources[uri] = cachedResource{etag: etag, contents: contents}
 }
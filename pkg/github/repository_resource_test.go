@@ -2,13 +2,16 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,6 +20,15 @@ var GetRawReposContentsByOwnerByRepoByPath mock.EndpointPattern = mock.EndpointP
 	Method:  "GET",
 }
 
+const mockPullRequestDiff = `diff --git a/README.md b/README.md
+index 3b18e51..a9c2f4d 100644
+--- a/README.md
++++ b/README.md
+@@ -1 +1,2 @@
+ # Test Repository
++Now with a diff.
+`
+
 func Test_repositoryResourceContentsHandler(t *testing.T) {
 	mockDirContent := []*github.RepositoryContent{
 		{
@@ -165,6 +177,121 @@ func Test_repositoryResourceContentsHandler(t *testing.T) {
 			},
 			expectedResult: expectedTextContent,
 		},
+		{
+			name: "text content fetch with lines",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockTextContent,
+				),
+				mock.WithRequestMatch(
+					GetRawReposContentsByOwnerByRepoByPath,
+					[]byte("line 1\nline 2\nline 3\nline 4\n"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  []string{"owner"},
+				"repo":   []string{"repo"},
+				"path":   []string{"README.md"},
+				"branch": []string{"main"},
+				"lines":  []string{"2-3"},
+			},
+			expectedResult: []mcp.TextResourceContents{
+				{
+					Text:     "[lines 2-3 of 4]\nline 2\nline 3\n",
+					MIMEType: "text/markdown",
+				},
+			},
+		},
+		{
+			name: "text content fetch with single line",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockTextContent,
+				),
+				mock.WithRequestMatch(
+					GetRawReposContentsByOwnerByRepoByPath,
+					[]byte("line 1\nline 2\nline 3\nline 4"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  []string{"owner"},
+				"repo":   []string{"repo"},
+				"path":   []string{"README.md"},
+				"branch": []string{"main"},
+				"lines":  []string{"4"},
+			},
+			expectedResult: []mcp.TextResourceContents{
+				{
+					Text:     "[lines 4-4 of 4]\nline 4",
+					MIMEType: "text/markdown",
+				},
+			},
+		},
+		{
+			name: "lines beyond the end of the file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockTextContent,
+				),
+				mock.WithRequestMatch(
+					GetRawReposContentsByOwnerByRepoByPath,
+					[]byte("line 1\nline 2\nline 3\nline 4\n"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  []string{"owner"},
+				"repo":   []string{"repo"},
+				"path":   []string{"README.md"},
+				"branch": []string{"main"},
+				"lines":  []string{"3-10"},
+			},
+			expectError:    "invalid lines",
+			expectedErrMsg: "invalid lines 3-10, the file has 4 lines",
+		},
+		{
+			name:         "inverted lines",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+				"path":  []string{"README.md"},
+				"lines": []string{"200-100"},
+			},
+			expectError:    "invalid lines",
+			expectedErrMsg: `invalid lines "200-100", the range ends before it starts`,
+		},
+		{
+			name:         "malformed lines",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+				"path":  []string{"README.md"},
+				"lines": []string{"0-10"},
+			},
+			expectError:    "invalid lines",
+			expectedErrMsg: `invalid lines "0-10", must be a line number or a range of line numbers like 100-200`,
+		},
+		{
+			name: "lines of a directory",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockDirContent,
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+				"path":  []string{"src"},
+				"lines": []string{"1-2"},
+			},
+			expectError:    "lines of a directory",
+			expectedErrMsg: "lines can only be requested for files, src is a directory",
+		},
 		{
 			name: "successful directory content fetch",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -180,6 +307,26 @@ func Test_repositoryResourceContentsHandler(t *testing.T) {
 			},
 			expectedResult: expectedDirContent,
 		},
+		{
+			name: "successful directory content fetch at ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{
+						"ref": "v1.0.0",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDirContent),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+				"path":  []string{"src"},
+				"ref":   []string{"v1.0.0"},
+			},
+			expectedResult: expectedDirContent,
+		},
 		{
 			name: "no data",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -210,6 +357,180 @@ func Test_repositoryResourceContentsHandler(t *testing.T) {
 			},
 			expectedResult: nil,
 		},
+		{
+			name: "pull request diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "application/vnd.github.v3.diff", r.Header.Get("Accept"))
+						_, _ = w.Write([]byte(mockPullRequestDiff))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    []string{"owner"},
+				"repo":     []string{"repo"},
+				"prNumber": []string{"42"},
+				"view":     []string{"diff"},
+			},
+			expectedResult: []mcp.TextResourceContents{{
+				MIMEType: "text/x-diff",
+				Text:     mockPullRequestDiff,
+			}},
+		},
+		{
+			name: "pull request diff with lines",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(mockPullRequestDiff))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    []string{"owner"},
+				"repo":     []string{"repo"},
+				"prNumber": []string{"42"},
+				"lines":    []string{"1-2"},
+				"view":     []string{"diff"},
+			},
+			expectedResult: []mcp.TextResourceContents{{
+				MIMEType: "text/x-diff",
+				Text:     "[lines 1-2 of 7]\ndiff --git a/README.md b/README.md\nindex 3b18e51..a9c2f4d 100644\n",
+			}},
+		},
+		{
+			name: "pull request diff too large to render",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotAcceptable, map[string]string{"message": "Sorry, the diff exceeded the maximum number of lines (20000)"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    []string{"owner"},
+				"repo":     []string{"repo"},
+				"prNumber": []string{"42"},
+				"view":     []string{"diff"},
+			},
+			expectError:    "diff too large",
+			expectedErrMsg: "the diff of pull request #42 is too large for GitHub to render, list its changed files instead",
+		},
+		{
+			name: "pull request diff over the size limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(strings.Repeat("+", maxPullRequestDiffSize+1)))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":    []string{"owner"},
+				"repo":     []string{"repo"},
+				"prNumber": []string{"42"},
+				"view":     []string{"diff"},
+			},
+			expectError:    "diff over limit",
+			expectedErrMsg: "the diff of pull request #42 is 1048577 bytes, more than the limit of 1048576, list its changed files instead",
+		},
+		{
+			name: "commit diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/commits/abc123", r.URL.Path)
+						assert.Equal(t, "application/vnd.github.v3.diff", r.Header.Get("Accept"))
+						_, _ = w.Write([]byte(mockPullRequestDiff))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+				"sha":   []string{"abc123"},
+				"view":  []string{"diff"},
+			},
+			expectedResult: []mcp.TextResourceContents{{
+				MIMEType: "text/x-diff",
+				Text:     mockPullRequestDiff,
+			}},
+		},
+		{
+			name: "commit diff over the size limit is truncated",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(mockPullRequestDiff + "diff --git a/big.txt b/big.txt\n" + strings.Repeat("+\n", maxCommitDiffSize)))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+				"sha":   []string{"abc123"},
+				"view":  []string{"diff"},
+			},
+			expectedResult: []mcp.TextResourceContents{{
+				MIMEType: "text/x-diff",
+				Text:     mockPullRequestDiff + "[diff truncated to 148 of 2097331 bytes: 1 of 2 files shown in full]\n",
+			}},
+		},
+		{
+			name:         "commit diff of a path",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner": []string{"owner"},
+				"repo":  []string{"repo"},
+				"sha":   []string{"abc123"},
+				"path":  []string{"README.md"},
+				"view":  []string{"diff"},
+			},
+			expectError:    "diff of a path",
+			expectedErrMsg: "view=diff returns the diff of the whole commit, it cannot be combined with the path README.md",
+		},
+		{
+			name:         "diff of a branch",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":  []string{"owner"},
+				"repo":   []string{"repo"},
+				"branch": []string{"main"},
+				"view":   []string{"diff"},
+			},
+			expectError:    "diff of a branch",
+			expectedErrMsg: "view can only be requested for pull requests and commits",
+		},
+		{
+			name:         "pull request diff of a path",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":    []string{"owner"},
+				"repo":     []string{"repo"},
+				"prNumber": []string{"42"},
+				"path":     []string{"src", "main.go"},
+				"view":     []string{"diff"},
+			},
+			expectError:    "diff of a path",
+			expectedErrMsg: "view=diff returns the diff of the whole pull request, it cannot be combined with the path src/main.go",
+		},
+		{
+			name:         "invalid view",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":    []string{"owner"},
+				"repo":     []string{"repo"},
+				"prNumber": []string{"42"},
+				"view":     []string{"patch"},
+			},
+			expectError:    "invalid view",
+			expectedErrMsg: `invalid view "patch", must be diff`,
+		},
 		{
 			name: "content fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -234,7 +555,7 @@ func Test_repositoryResourceContentsHandler(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			client := github.NewClient(tc.mockedClient)
-			handler := RepositoryResourceContentsHandler((stubGetClientFn(client)))
+			handler := RepositoryResourceContentsHandler(stubGetClientFn(client), newResourceCache(maxCachedResources))
 
 			request := mcp.ReadResourceRequest{
 				Params: struct {
@@ -258,26 +579,244 @@ func Test_repositoryResourceContentsHandler(t *testing.T) {
 	}
 }
 
+func Test_repositoryResourceContentsHandler_cachesByETag(t *testing.T) {
+	mockTextContent := &github.RepositoryContent{
+		Type:        github.Ptr("file"),
+		Name:        github.Ptr("README.md"),
+		Path:        github.Ptr("README.md"),
+		Content:     github.Ptr("# Test Repository"),
+		DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/README.md"),
+	}
+
+	// The content is unchanged for ETag "v1", and replaced by a new version once etag is "v2"
+	etag := `"v1"`
+	var contentRequests, downloads int
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				contentRequests++
+				if r.Header.Get("If-None-Match") == etag {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set("ETag", etag)
+				mockResponse(t, http.StatusOK, mockTextContent)(w, r)
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			GetRawReposContentsByOwnerByRepoByPath,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				downloads++
+				w.Header().Set("Content-Type", "text/markdown")
+				_, _ = w.Write([]byte(fmt.Sprintf("# Test Repository %d", downloads)))
+			}),
+		),
+	)
+	handler := RepositoryResourceContentsHandler(stubGetClientFn(github.NewClient(mockedClient)), newResourceCache(maxCachedResources))
+
+	uri := "repo://owner/repo/refs/heads/main/contents/README.md"
+	read := func() []mcp.ResourceContents {
+		t.Helper()
+		contents, err := handler(context.Background(), mcp.ReadResourceRequest{
+			Params: struct {
+				URI       string         `json:"uri"`
+				Arguments map[string]any `json:"arguments,omitempty"`
+			}{
+				URI: uri,
+				Arguments: map[string]any{
+					"owner":  []string{"owner"},
+					"repo":   []string{"repo"},
+					"path":   []string{"README.md"},
+					"branch": []string{"main"},
+				},
+			},
+		})
+		require.NoError(t, err)
+		return contents
+	}
+	expectedContents := func(text string) []mcp.ResourceContents {
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{URI: uri, MIMEType: "text/markdown", Text: text},
+		}
+	}
+
+	// The first read fetches the content
+	assert.Equal(t, expectedContents("# Test Repository 1"), read())
+	assert.Equal(t, 1, contentRequests)
+	assert.Equal(t, 1, downloads)
+
+	// A 304 Not Modified serves the cached content without downloading it again
+	assert.Equal(t, expectedContents("# Test Repository 1"), read())
+	assert.Equal(t, 2, contentRequests)
+	assert.Equal(t, 1, downloads)
+
+	// Changed content is fetched again and replaces the cached content
+	etag = `"v2"`
+	assert.Equal(t, expectedContents("# Test Repository 2"), read())
+	assert.Equal(t, 3, contentRequests)
+	assert.Equal(t, 2, downloads)
+}
+
+func Test_resourceCache(t *testing.T) {
+	cache := newResourceCache(2)
+	contents := []mcp.ResourceContents{mcp.TextResourceContents{URI: "repo://owner/repo/contents/a", Text: "a"}}
+
+	// Resources without a URI or an ETag are not cached
+	cache.store("", `"a"`, contents)
+	cache.store("repo://owner/repo/contents/a", "", contents)
+	_, ok := cache.lookup("repo://owner/repo/contents/a")
+	assert.False(t, ok)
+
+	cache.store("repo://owner/repo/contents/a", `"a"`, contents)
+	cached, ok := cache.lookup("repo://owner/repo/contents/a")
+	require.True(t, ok)
+	assert.Equal(t, `"a"`, cached.etag)
+	assert.Equal(t, contents, cached.contents)
+
+	// A full cache forgets a resource to make room for another one
+	cache.store("repo://owner/repo/contents/b", `"b"`, contents)
+	cache.store("repo://owner/repo/contents/c", `"c"`, contents)
+	assert.Len(t, cache.resources, 2)
+	_, ok = cache.lookup("repo://owner/repo/contents/c")
+	assert.True(t, ok)
+}
+
+func Test_truncateDiff(t *testing.T) {
+	first := "diff --git a/a.txt b/a.txt\n+a\n"
+	second := "diff --git a/b.txt b/b.txt\n+b\n"
+
+	// Diffs within the limit are unchanged
+	assert.Equal(t, first+second, truncateDiff(first+second, len(first+second)))
+
+	// Files that don't fit are left out whole
+	assert.Equal(t, first+"[diff truncated to 30 of 60 bytes: 1 of 2 files shown in full]\n", truncateDiff(first+second, len(first)+5))
+
+	// A first file larger than the limit is cut at a line
+	assert.Equal(t, "diff --git a/a.txt b/a.txt\n[diff truncated to 27 of 60 bytes: 0 of 2 files shown in full]\n", truncateDiff(first+second, len(first)-1))
+}
+
 func Test_GetRepositoryResourceContent(t *testing.T) {
-	tmpl, _ := GetRepositoryResourceContent(nil, translations.NullTranslationHelper)
-	require.Equal(t, "repo://{owner}/{repo}/contents{/path*}", tmpl.URITemplate.Raw())
+	tmpl, _ := GetRepositoryResourceContent(nil, nil, translations.NullTranslationHelper)
+	require.Equal(t, "repo://{owner}/{repo}/contents{/path*}{?lines}", tmpl.URITemplate.Raw())
 }
 
 func Test_GetRepositoryResourceBranchContent(t *testing.T) {
-	tmpl, _ := GetRepositoryResourceBranchContent(nil, translations.NullTranslationHelper)
-	require.Equal(t, "repo://{owner}/{repo}/refs/heads/{branch}/contents{/path*}", tmpl.URITemplate.Raw())
+	tmpl, _ := GetRepositoryResourceBranchContent(nil, nil, translations.NullTranslationHelper)
+	require.Equal(t, "repo://{owner}/{repo}/refs/heads/{branch}/contents{/path*}{?lines}", tmpl.URITemplate.Raw())
 }
 func Test_GetRepositoryResourceCommitContent(t *testing.T) {
-	tmpl, _ := GetRepositoryResourceCommitContent(nil, translations.NullTranslationHelper)
-	require.Equal(t, "repo://{owner}/{repo}/sha/{sha}/contents{/path*}", tmpl.URITemplate.Raw())
+	tmpl, _ := GetRepositoryResourceCommitContent(nil, nil, translations.NullTranslationHelper)
+	require.Equal(t, "repo://{owner}/{repo}/sha/{sha}/contents{/path*}{?lines,view}", tmpl.URITemplate.Raw())
 }
 
 func Test_GetRepositoryResourceTagContent(t *testing.T) {
-	tmpl, _ := GetRepositoryResourceTagContent(nil, translations.NullTranslationHelper)
-	require.Equal(t, "repo://{owner}/{repo}/refs/tags/{tag}/contents{/path*}", tmpl.URITemplate.Raw())
+	tmpl, _ := GetRepositoryResourceTagContent(nil, nil, translations.NullTranslationHelper)
+	require.Equal(t, "repo://{owner}/{repo}/refs/tags/{tag}/contents{/path*}{?lines}", tmpl.URITemplate.Raw())
 }
 
 func Test_GetRepositoryResourcePrContent(t *testing.T) {
-	tmpl, _ := GetRepositoryResourcePrContent(nil, translations.NullTranslationHelper)
-	require.Equal(t, "repo://{owner}/{repo}/refs/pull/{prNumber}/head/contents{/path*}", tmpl.URITemplate.Raw())
+	tmpl, _ := GetRepositoryResourcePrContent(nil, nil, translations.NullTranslationHelper)
+	require.Equal(t, "repo://{owner}/{repo}/refs/pull/{prNumber}/head/contents{/path*}{?lines,view}", tmpl.URITemplate.Raw())
+}
+
+func Test_GetRepositoryResourceRefContent(t *testing.T) {
+	tmpl, _ := GetRepositoryResourceRefContent(nil, nil, translations.NullTranslationHelper)
+	require.Equal(t, "repo://{owner}/{repo}/ref/{+ref}/contents{/path*}{?lines}", tmpl.URITemplate.Raw())
+
+	tests := []struct {
+		name         string
+		uri          string
+		expectedRef  string
+		expectedPath string
+	}{
+		{
+			name:         "branch",
+			uri:          "repo://owner/repo/ref/main/contents/docs/README.md",
+			expectedRef:  "main",
+			expectedPath: "/repos/owner/repo/contents/docs/README.md",
+		},
+		{
+			name:         "branch with a slash",
+			uri:          "repo://owner/repo/ref/feature/x/contents/docs/README.md",
+			expectedRef:  "feature/x",
+			expectedPath: "/repos/owner/repo/contents/docs/README.md",
+		},
+		{
+			name:         "branch with an encoded slash",
+			uri:          "repo://owner/repo/ref/feature%2Fx/contents/docs/README.md",
+			expectedRef:  "feature/x",
+			expectedPath: "/repos/owner/repo/contents/docs/README.md",
+		},
+		{
+			name:         "branch with several slashes",
+			uri:          "repo://owner/repo/ref/users/octocat/release/1.0/contents/go.mod",
+			expectedRef:  "users/octocat/release/1.0",
+			expectedPath: "/repos/owner/repo/contents/go.mod",
+		},
+		{
+			name:         "path with a contents directory",
+			uri:          "repo://owner/repo/ref/feature/x/contents/site/contents/index.md",
+			expectedRef:  "feature/x",
+			expectedPath: "/repos/owner/repo/contents/site/contents/index.md",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockedClient := mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, tc.expectedPath, r.URL.Path)
+						assert.Equal(t, tc.expectedRef, r.URL.Query().Get("ref"))
+						mockResponse(t, http.StatusOK, []*github.RepositoryContent{})(w, r)
+					}),
+				),
+			)
+			_, handler := GetRepositoryResourceRefContent(stubGetClientFn(github.NewClient(mockedClient)), newResourceCache(maxCachedResources), translations.NullTranslationHelper)
+
+			// Match the URI the way the server does before calling the handler
+			require.True(t, tmpl.URITemplate.Regexp().MatchString(tc.uri))
+			arguments := map[string]any{}
+			for name, value := range tmpl.URITemplate.Match(tc.uri) {
+				arguments[name] = value.V
+			}
+			request := mcp.ReadResourceRequest{
+				Params: struct {
+					URI       string         `json:"uri"`
+					Arguments map[string]any `json:"arguments,omitempty"`
+				}{
+					URI:       tc.uri,
+					Arguments: arguments,
+				},
+			}
+
+			_, err := handler(context.Background(), request)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_splitRefPath(t *testing.T) {
+	tests := []struct {
+		ref          string
+		path         string
+		expectedRef  string
+		expectedPath string
+	}{
+		{ref: "main", path: "docs/README.md", expectedRef: "main", expectedPath: "docs/README.md"},
+		{ref: "feature/x", path: "README.md", expectedRef: "feature/x", expectedPath: "README.md"},
+		{ref: "feature/x/contents/site", path: "index.md", expectedRef: "feature/x", expectedPath: "site/contents/index.md"},
+		{ref: "main/contents", path: "", expectedRef: "main", expectedPath: "contents"},
+		{ref: "contents", path: "a.md", expectedRef: "contents", expectedPath: "a.md"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.ref, func(t *testing.T) {
+			ref, path := splitRefPath(tc.ref, tc.path)
+			assert.Equal(t, tc.expectedRef, ref)
+			assert.Equal(t, tc.expectedPath, path)
+		})
+	}
 }
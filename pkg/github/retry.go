@@ -0,0 +1,114 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries of a transient failure when the server
+	// configuration does not set one.
+	DefaultMaxRetries = 2
+
+	// retryBaseDelay is the delay before the first retry, doubled for each subsequent one.
+	retryBaseDelay = 500 * time.Millisecond
+
+	// maxRetryDelay is the longest the server waits before retrying. Failures asking to wait
+	// longer, through a Retry-After header, are returned as they are.
+	maxRetryDelay = 30 * time.Second
+)
+
+// retryWithBackoff wraps a transport to retry GET and HEAD requests failing with a transient
+// server error (500, 502, 503, 504) or a secondary rate limit, with exponential backoff.
+// Requests that modify state are never retried, as they may have taken effect.
+func retryWithBackoff(transport http.RoundTripper, maxRetries int, baseDelay time.Duration) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			return transport.RoundTrip(req)
+		}
+
+		delay := baseDelay
+		for attempt := 0; ; attempt++ {
+			resp, err := transport.RoundTrip(req)
+			if err != nil || attempt == maxRetries {
+				return resp, err
+			}
+
+			wait, retry := retryDelay(resp, delay)
+			if !retry {
+				return resp, nil
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+
+			if err := sleep(req.Context(), wait); err != nil {
+				return nil, err
+			}
+			delay *= 2
+		}
+	})
+}
+
+// retryDelay reports whether the response is a transient failure worth retrying, and how long
+// to wait before doing so: the Retry-After delay if GitHub sent one, the backoff otherwise.
+func retryDelay(resp *http.Response, backoff time.Duration) (time.Duration, bool) {
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		if !isSecondaryRateLimit(resp) {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		wait := time.Duration(seconds) * time.Second
+		return wait, wait <= maxRetryDelay
+	}
+	return backoff, true
+}
+
+// isSecondaryRateLimit reports whether a 403 or 429 response is a secondary rate limit, which
+// GitHub lifts after a short wait, rather than a permission error or an exhausted primary rate
+// limit. The body is restored so that the caller can still read it.
+func isSecondaryRateLimit(resp *http.Response) bool {
+	if resp.Header.Get("Retry-After") != "" {
+		return true
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return err == nil && strings.Contains(strings.ToLower(string(body)), "secondary rate limit")
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryTransientFailures wraps getClient so that the clients it returns retry transient failures.
+func retryTransientFailures(getClient GetClientFn, maxRetries int) GetClientFn {
+	return func(ctx context.Context) (*github.Client, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return withTransport(client, func(transport http.RoundTripper) http.RoundTripper {
+			return retryWithBackoff(transport, maxRetries, retryBaseDelay)
+		}), nil
+	}
+}
@@ -0,0 +1,195 @@
+package github
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RetryWithBackoff(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		failures         int
+		failureStatus    int
+		failureHeader    http.Header
+		failureBody      string
+		maxRetries       int
+		expectedStatus   int
+		expectedAttempts int32
+		expectedBody     string
+	}{
+		{
+			name:             "transient server errors then success",
+			method:           http.MethodGet,
+			failures:         2,
+			failureStatus:    http.StatusServiceUnavailable,
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+			expectedBody:     "ok",
+		},
+		{
+			name:             "retries exhausted",
+			method:           http.MethodGet,
+			failures:         5,
+			failureStatus:    http.StatusBadGateway,
+			failureBody:      "bad gateway",
+			maxRetries:       2,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 3,
+			expectedBody:     "bad gateway",
+		},
+		{
+			name:             "writes are never retried",
+			method:           http.MethodPost,
+			failures:         1,
+			failureStatus:    http.StatusServiceUnavailable,
+			maxRetries:       3,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "secondary rate limit with Retry-After",
+			method:           http.MethodGet,
+			failures:         1,
+			failureStatus:    http.StatusForbidden,
+			failureHeader:    http.Header{"Retry-After": []string{"0"}},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+			expectedBody:     "ok",
+		},
+		{
+			name:             "secondary rate limit without Retry-After",
+			method:           http.MethodGet,
+			failures:         1,
+			failureStatus:    http.StatusForbidden,
+			failureBody:      `{"message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`,
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+			expectedBody:     "ok",
+		},
+		{
+			name:             "Retry-After longer than the maximum delay",
+			method:           http.MethodGet,
+			failures:         1,
+			failureStatus:    http.StatusTooManyRequests,
+			failureHeader:    http.Header{"Retry-After": []string{"3600"}},
+			maxRetries:       3,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "permission errors are not retried and keep their body",
+			method:           http.MethodGet,
+			failures:         1,
+			failureStatus:    http.StatusForbidden,
+			failureBody:      `{"message": "Resource not accessible by integration"}`,
+			maxRetries:       3,
+			expectedStatus:   http.StatusForbidden,
+			expectedAttempts: 1,
+			expectedBody:     `{"message": "Resource not accessible by integration"}`,
+		},
+		{
+			name:             "client errors are not retried",
+			method:           http.MethodGet,
+			failures:         1,
+			failureStatus:    http.StatusNotFound,
+			maxRetries:       3,
+			expectedStatus:   http.StatusNotFound,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if int(attempts.Add(1)) <= tc.failures {
+					for k, v := range tc.failureHeader {
+						w.Header()[k] = v
+					}
+					w.WriteHeader(tc.failureStatus)
+					_, _ = w.Write([]byte(tc.failureBody))
+					return
+				}
+				_, _ = w.Write([]byte("ok"))
+			}))
+			defer ts.Close()
+
+			client := &http.Client{Transport: retryWithBackoff(http.DefaultTransport, tc.maxRetries, time.Millisecond)}
+			req, err := http.NewRequest(tc.method, ts.URL, nil)
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tc.expectedAttempts, attempts.Load())
+			if tc.expectedBody != "" {
+				assert.Equal(t, tc.expectedBody, string(body))
+			}
+		})
+	}
+}
+
+func Test_RetryWithBackoff_ContextCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	client := &http.Client{Transport: retryWithBackoff(http.DefaultTransport, 3, time.Hour)}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_RetryTransientFailures(t *testing.T) {
+	var attempts atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if attempts.Add(1) <= 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"login": "octocat"}`))
+	}))
+	defer ts.Close()
+
+	client, err := github.NewClient(nil).WithEnterpriseURLs(ts.URL, ts.URL)
+	require.NoError(t, err)
+	getClient := retryTransientFailures(stubGetClientFn(client), 2)
+
+	retrying, err := getClient(context.Background())
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(retrying.BaseURL.String(), ts.URL))
+
+	user, resp, err := retrying.Users.Get(context.Background(), "octocat")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, "octocat", user.GetLogin())
+	assert.Equal(t, int32(2), attempts.Load())
+}
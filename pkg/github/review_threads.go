@@ -0,0 +1,327 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxReviewThreadComments is how many comments of each review thread are returned. Threads
+// rarely grow longer, and comment_count tells when one did.
+const maxReviewThreadComments = 50
+
+// listReviewThreadsQuery fetches a page of the review threads of a pull request. The REST API
+// has the comments of the threads, but not whether a thread was resolved.
+var listReviewThreadsQuery = fmt.Sprintf(`query($owner: String!, $repo: String!, $number: Int!, $first: Int!, $after: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewThreads(first: $first, after: $after) {
+        totalCount
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          id
+          isResolved
+          isOutdated
+          path
+          line
+          startLine
+          resolvedBy { login }
+          comments(first: %d) {
+            totalCount
+            nodes { id author { login } body createdAt url }
+          }
+        }
+      }
+    }
+  }
+}`, maxReviewThreadComments)
+
+// reviewThread is a thread of review comments on a pull request. Line and StartLine are null
+// when the thread is outdated and no longer maps to a line of the current diff.
+type reviewThread struct {
+	ID           string                `json:"id"`
+	IsResolved   bool                  `json:"is_resolved"`
+	IsOutdated   bool                  `json:"is_outdated"`
+	ResolvedBy   string                `json:"resolved_by,omitempty"`
+	Path         string                `json:"path"`
+	Line         *int                  `json:"line"`
+	StartLine    *int                  `json:"start_line,omitempty"`
+	CommentCount int                   `json:"comment_count"`
+	Comments     []reviewThreadComment `json:"comments"`
+}
+
+type reviewThreadComment struct {
+	ID        string `json:"id"`
+	Author    string `json:"author"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+	URL       string `json:"url"`
+}
+
+// reviewThreadsPage is a page of review threads with the cursor to fetch the next one.
+type reviewThreadsPage struct {
+	TotalCount  int            `json:"total_count"`
+	HasNextPage bool           `json:"has_next_page"`
+	EndCursor   string         `json:"end_cursor,omitempty"`
+	Threads     []reviewThread `json:"threads"`
+}
+
+// ListReviewThreads creates a tool to list the review threads of a pull request with their resolution state.
+func ListReviewThreads(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_review_threads",
+			mcp.WithDescription(t("TOOL_LIST_REVIEW_THREADS_DESCRIPTION", "List the review threads of a pull request with whether each is resolved, the file and line it is on, and its comments. Use this to tell which review feedback has been addressed")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			WithCursorPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalCursorPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			variables := map[string]interface{}{
+				"owner":  owner,
+				"repo":   repo,
+				"number": pullNumber,
+				"first":  pagination.perPage,
+				"after":  nil,
+			}
+			if pagination.after != "" {
+				variables["after"] = pagination.after
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := queryGraphQL(ctx, client, listReviewThreadsQuery, variables)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list review threads: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if err := result.err(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list review threads: %s", err.Error())), nil
+			}
+
+			var data struct {
+				Repository *struct {
+					PullRequest *struct {
+						ReviewThreads struct {
+							TotalCount int `json:"totalCount"`
+							PageInfo   struct {
+								HasNextPage bool   `json:"hasNextPage"`
+								EndCursor   string `json:"endCursor"`
+							} `json:"pageInfo"`
+							Nodes []struct {
+								ID         string `json:"id"`
+								IsResolved bool   `json:"isResolved"`
+								IsOutdated bool   `json:"isOutdated"`
+								Path       string `json:"path"`
+								Line       *int   `json:"line"`
+								StartLine  *int   `json:"startLine"`
+								ResolvedBy *struct {
+									Login string `json:"login"`
+								} `json:"resolvedBy"`
+								Comments struct {
+									TotalCount int `json:"totalCount"`
+									Nodes      []struct {
+										ID     string `json:"id"`
+										Author *struct {
+											Login string `json:"login"`
+										} `json:"author"`
+										Body      string `json:"body"`
+										CreatedAt string `json:"createdAt"`
+										URL       string `json:"url"`
+									} `json:"nodes"`
+								} `json:"comments"`
+							} `json:"nodes"`
+						} `json:"reviewThreads"`
+					} `json:"pullRequest"`
+				} `json:"repository"`
+			}
+			if err := json.Unmarshal(result.Data, &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal review threads: %w", err)
+			}
+			if data.Repository == nil || data.Repository.PullRequest == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("pull request not found: %s/%s#%d", owner, repo, pullNumber)), nil
+			}
+
+			threads := data.Repository.PullRequest.ReviewThreads
+			page := reviewThreadsPage{
+				TotalCount:  threads.TotalCount,
+				HasNextPage: threads.PageInfo.HasNextPage,
+				EndCursor:   threads.PageInfo.EndCursor,
+				Threads:     make([]reviewThread, 0, len(threads.Nodes)),
+			}
+			for _, node := range threads.Nodes {
+				thread := reviewThread{
+					ID:           node.ID,
+					IsResolved:   node.IsResolved,
+					IsOutdated:   node.IsOutdated,
+					Path:         node.Path,
+					Line:         node.Line,
+					StartLine:    node.StartLine,
+					CommentCount: node.Comments.TotalCount,
+					Comments:     make([]reviewThreadComment, 0, len(node.Comments.Nodes)),
+				}
+				if node.ResolvedBy != nil {
+					thread.ResolvedBy = node.ResolvedBy.Login
+				}
+				for _, c := range node.Comments.Nodes {
+					comment := reviewThreadComment{
+						ID:        c.ID,
+						Body:      c.Body,
+						CreatedAt: c.CreatedAt,
+						URL:       c.URL,
+					}
+					// Author is null for comments of deleted accounts
+					if c.Author != nil {
+						comment.Author = c.Author.Login
+					}
+					thread.Comments = append(thread.Comments, comment)
+				}
+				page.Threads = append(page.Threads, thread)
+			}
+
+			r, err := json.Marshal(page)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// resolveReviewThreadMutation and unresolveReviewThreadMutation change the resolution state of a
+// review thread and return the new one.
+const (
+	resolveReviewThreadMutation = `mutation($threadId: ID!) {
+  resolveReviewThread(input: {threadId: $threadId}) {
+    thread { id isResolved resolvedBy { login } }
+  }
+}`
+	unresolveReviewThreadMutation = `mutation($threadId: ID!) {
+  unresolveReviewThread(input: {threadId: $threadId}) {
+    thread { id isResolved resolvedBy { login } }
+  }
+}`
+)
+
+// reviewThreadResolution is the resolution state of a review thread after resolving or unresolving it.
+type reviewThreadResolution struct {
+	ID         string `json:"id"`
+	IsResolved bool   `json:"is_resolved"`
+	ResolvedBy string `json:"resolved_by,omitempty"`
+}
+
+// ResolveReviewThread creates a tool to mark a review thread of a pull request as resolved.
+func ResolveReviewThread(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("resolve_review_thread",
+			mcp.WithDescription(t("TOOL_RESOLVE_REVIEW_THREAD_DESCRIPTION", "Mark a review thread of a pull request as resolved, e.g. once the feedback in it has been addressed")),
+			mcp.WithString("thread_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the review thread, as returned by list_review_threads"),
+			),
+		),
+		reviewThreadResolutionHandler(getClient, resolveReviewThreadMutation, "resolveReviewThread")
+}
+
+// UnresolveReviewThread creates a tool to reopen a resolved review thread of a pull request.
+func UnresolveReviewThread(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unresolve_review_thread",
+			mcp.WithDescription(t("TOOL_UNRESOLVE_REVIEW_THREAD_DESCRIPTION", "Reopen a resolved review thread of a pull request")),
+			mcp.WithString("thread_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the review thread, as returned by list_review_threads"),
+			),
+		),
+		reviewThreadResolutionHandler(getClient, unresolveReviewThreadMutation, "unresolveReviewThread")
+}
+
+// reviewThreadResolutionHandler returns the handler shared by the tools resolving and unresolving
+// review threads. It runs mutation on the requested thread and reads the new state from the
+// mutation's payload, which is named field in the response.
+func reviewThreadResolutionHandler(getClient GetClientFn, mutation, field string) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		threadID, err := requiredParam[string](request, "thread_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+		result, resp, err := queryGraphQL(ctx, client, mutation, map[string]interface{}{
+			"threadId": threadID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update review thread: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if err := result.err(); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to update review thread: %s", err.Error())), nil
+		}
+
+		var data map[string]*struct {
+			Thread struct {
+				ID         string `json:"id"`
+				IsResolved bool   `json:"isResolved"`
+				ResolvedBy *struct {
+					Login string `json:"login"`
+				} `json:"resolvedBy"`
+			} `json:"thread"`
+		}
+		if err := json.Unmarshal(result.Data, &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal review thread: %w", err)
+		}
+		payload := data[field]
+		if payload == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("review thread not found: %s", threadID)), nil
+		}
+
+		resolution := reviewThreadResolution{
+			ID:         payload.Thread.ID,
+			IsResolved: payload.Thread.IsResolved,
+		}
+		if payload.Thread.ResolvedBy != nil {
+			resolution.ResolvedBy = payload.Thread.ResolvedBy.Login
+		}
+
+		r, err := json.Marshal(resolution)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal response: %w", err)
+		}
+
+		return mcp.NewToolResultText(string(r)), nil
+	}
+}
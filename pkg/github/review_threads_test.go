@@ -0,0 +1,394 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListReviewThreads(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReviewThreads(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_review_threads", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockThreads := map[string]interface{}{
+		"data": map[string]interface{}{
+			"repository": map[string]interface{}{
+				"pullRequest": map[string]interface{}{
+					"reviewThreads": map[string]interface{}{
+						"totalCount": 2,
+						"pageInfo":   map[string]interface{}{"hasNextPage": false, "endCursor": "Y3Vyc29yOjI="},
+						"nodes": []map[string]interface{}{
+							{
+								"id":         "PRRT_1",
+								"isResolved": true,
+								"isOutdated": false,
+								"path":       "main.go",
+								"line":       42,
+								"startLine":  40,
+								"resolvedBy": map[string]interface{}{"login": "octocat"},
+								"comments": map[string]interface{}{
+									"totalCount": 2,
+									"nodes": []map[string]interface{}{
+										{"id": "PRRC_1", "author": map[string]interface{}{"login": "hubot"}, "body": "Handle the error here", "createdAt": "2025-04-01T10:00:00Z", "url": "https://github.com/owner/repo/pull/7#discussion_r1"},
+										{"id": "PRRC_2", "author": map[string]interface{}{"login": "octocat"}, "body": "Done", "createdAt": "2025-04-01T11:00:00Z", "url": "https://github.com/owner/repo/pull/7#discussion_r2"},
+									},
+								},
+							},
+							{
+								"id":         "PRRT_2",
+								"isResolved": false,
+								"isOutdated": true,
+								"path":       "README.md",
+								"line":       nil,
+								"startLine":  nil,
+								"resolvedBy": nil,
+								"comments": map[string]interface{}{
+									"totalCount": 1,
+									"nodes": []map[string]interface{}{
+										{"id": "PRRC_3", "author": nil, "body": "Typo", "createdAt": "2025-04-02T09:00:00Z", "url": "https://github.com/owner/repo/pull/7#discussion_r3"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedPage   reviewThreadsPage
+		expectedErrMsg string
+	}{
+		{
+			name: "successful threads listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					expectRequestBody(t, map[string]interface{}{
+						"query": listReviewThreadsQuery,
+						"variables": map[string]interface{}{
+							"owner":  "owner",
+							"repo":   "repo",
+							"number": float64(7),
+							"first":  float64(30),
+							"after":  nil,
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockThreads),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+			},
+			expectError: false,
+			expectedPage: reviewThreadsPage{
+				TotalCount: 2,
+				EndCursor:  "Y3Vyc29yOjI=",
+				Threads: []reviewThread{
+					{
+						ID:           "PRRT_1",
+						IsResolved:   true,
+						ResolvedBy:   "octocat",
+						Path:         "main.go",
+						Line:         github.Ptr(42),
+						StartLine:    github.Ptr(40),
+						CommentCount: 2,
+						Comments: []reviewThreadComment{
+							{ID: "PRRC_1", Author: "hubot", Body: "Handle the error here", CreatedAt: "2025-04-01T10:00:00Z", URL: "https://github.com/owner/repo/pull/7#discussion_r1"},
+							{ID: "PRRC_2", Author: "octocat", Body: "Done", CreatedAt: "2025-04-01T11:00:00Z", URL: "https://github.com/owner/repo/pull/7#discussion_r2"},
+						},
+					},
+					{
+						ID:           "PRRT_2",
+						IsOutdated:   true,
+						Path:         "README.md",
+						CommentCount: 1,
+						Comments: []reviewThreadComment{
+							{ID: "PRRC_3", Body: "Typo", CreatedAt: "2025-04-02T09:00:00Z", URL: "https://github.com/owner/repo/pull/7#discussion_r3"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data": map[string]interface{}{
+							"repository": map[string]interface{}{"pullRequest": nil},
+						},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    false,
+			expectedErrMsg: "pull request not found: owner/repo#999",
+		},
+		{
+			name: "graphql errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data":   map[string]interface{}{"repository": nil},
+						"errors": []map[string]interface{}{{"type": "NOT_FOUND", "message": "Could not resolve to a Repository with the name 'owner/missing'."}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "missing",
+				"pullNumber": float64(7),
+			},
+			expectError:    false,
+			expectedErrMsg: "Could not resolve to a Repository with the name 'owner/missing'.",
+		},
+		{
+			name: "list review threads fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockResponse(t, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list review threads",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListReviewThreads(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedPage reviewThreadsPage
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPage)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPage, returnedPage)
+		})
+	}
+}
+
+func Test_ResolveReviewThread(t *testing.T) {
+	// Verify tool definitions once
+	mockClient := github.NewClient(nil)
+	tool, _ := ResolveReviewThread(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "resolve_review_thread", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "thread_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"thread_id"})
+
+	tool, _ = UnresolveReviewThread(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "unresolve_review_thread", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "thread_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"thread_id"})
+
+	tests := []struct {
+		name               string
+		newTool            func(GetClientFn, translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc)
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedResolution reviewThreadResolution
+		expectedErrMsg     string
+	}{
+		{
+			name:    "resolve thread",
+			newTool: ResolveReviewThread,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					expectRequestBody(t, map[string]interface{}{
+						"query": resolveReviewThreadMutation,
+						"variables": map[string]interface{}{
+							"threadId": "PRRT_1",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]interface{}{
+							"data": map[string]interface{}{
+								"resolveReviewThread": map[string]interface{}{
+									"thread": map[string]interface{}{"id": "PRRT_1", "isResolved": true, "resolvedBy": map[string]interface{}{"login": "octocat"}},
+								},
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"thread_id": "PRRT_1",
+			},
+			expectError:        false,
+			expectedResolution: reviewThreadResolution{ID: "PRRT_1", IsResolved: true, ResolvedBy: "octocat"},
+		},
+		{
+			name:    "unresolve thread",
+			newTool: UnresolveReviewThread,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					expectRequestBody(t, map[string]interface{}{
+						"query": unresolveReviewThreadMutation,
+						"variables": map[string]interface{}{
+							"threadId": "PRRT_1",
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]interface{}{
+							"data": map[string]interface{}{
+								"unresolveReviewThread": map[string]interface{}{
+									"thread": map[string]interface{}{"id": "PRRT_1", "isResolved": false, "resolvedBy": nil},
+								},
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"thread_id": "PRRT_1",
+			},
+			expectError:        false,
+			expectedResolution: reviewThreadResolution{ID: "PRRT_1", IsResolved: false},
+		},
+		{
+			name:    "thread not found",
+			newTool: ResolveReviewThread,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					postGraphQL,
+					map[string]interface{}{
+						"data":   map[string]interface{}{"resolveReviewThread": nil},
+						"errors": []map[string]interface{}{{"type": "NOT_FOUND", "message": "Could not resolve to a node with the global id of 'PRRT_missing'"}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"thread_id": "PRRT_missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "Could not resolve to a node with the global id of 'PRRT_missing'",
+		},
+		{
+			name:           "missing thread_id",
+			newTool:        UnresolveReviewThread,
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]interface{}{},
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: thread_id",
+		},
+		{
+			name:    "resolve thread fails",
+			newTool: ResolveReviewThread,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockResponse(t, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"thread_id": "PRRT_1",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update review thread",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := tc.newTool(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResolution reviewThreadResolution
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResolution)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResolution, returnedResolution)
+		})
+	}
+}
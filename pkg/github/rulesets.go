@@ -0,0 +1,215 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// rulesetSummary is a ruleset as returned by list_rulesets. Rulesets are defined either on
+// the repository itself or on its organization or enterprise, in which case Inherited is set
+// and Source names the owner the ruleset is defined on.
+type rulesetSummary struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Target      string            `json:"target,omitempty"`
+	Enforcement string            `json:"enforcement"`
+	SourceType  string            `json:"source_type,omitempty"`
+	Source      string            `json:"source"`
+	Inherited   bool              `json:"inherited"`
+	UpdatedAt   *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+// rulesetDetail is a ruleset as returned by get_ruleset, with the branches or tags it applies
+// to, its rules and who may bypass them.
+type rulesetDetail struct {
+	rulesetSummary
+	Conditions           *github.RepositoryRulesetConditions `json:"conditions,omitempty"`
+	Rules                *github.RepositoryRulesetRules      `json:"rules,omitempty"`
+	BypassActors         []*github.BypassActor               `json:"bypass_actors,omitempty"`
+	CurrentUserCanBypass string                              `json:"current_user_can_bypass,omitempty"`
+}
+
+func newRulesetSummary(r *github.RepositoryRuleset) rulesetSummary {
+	summary := rulesetSummary{
+		ID:          r.GetID(),
+		Name:        r.Name,
+		Enforcement: string(r.Enforcement),
+		Source:      r.Source,
+		UpdatedAt:   r.UpdatedAt,
+	}
+	if r.Target != nil {
+		summary.Target = string(*r.Target)
+	}
+	if r.SourceType != nil {
+		summary.SourceType = string(*r.SourceType)
+		summary.Inherited = *r.SourceType != github.RulesetSourceTypeRepository
+	}
+	return summary
+}
+
+// withIncludeParents returns a ToolOption that adds the "include_parents" parameter to a ruleset tool.
+func withIncludeParents() mcp.ToolOption {
+	return mcp.WithBoolean("include_parents",
+		mcp.Description("Include the rulesets of the organization or enterprise that apply to the repository (default true)"),
+	)
+}
+
+// optionalIncludeParents returns the "include_parents" parameter from the request, or true if it is not present.
+func optionalIncludeParents(request mcp.CallToolRequest) (bool, error) {
+	includeParents, ok, err := OptionalParamOK[bool](request, "include_parents")
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return true, nil
+	}
+	return includeParents, nil
+}
+
+// ListRulesets creates a tool to list the rulesets that apply to a repository.
+func ListRulesets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_rulesets",
+			mcp.WithDescription(t("TOOL_LIST_RULESETS_DESCRIPTION", "List the rulesets that apply to a GitHub repository, with their target and enforcement. Rulesets are the successor of branch protection; use get_ruleset for the rules of one of them")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			withIncludeParents(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeParents, err := optionalIncludeParents(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, includeParents)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list rulesets: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list rulesets: %s", string(body))), nil
+			}
+
+			summaries := make([]rulesetSummary, 0, len(rulesets))
+			for _, r := range rulesets {
+				summaries = append(summaries, newRulesetSummary(r))
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRuleset creates a tool to get a ruleset that applies to a repository, with its rules.
+func GetRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_ruleset",
+			mcp.WithDescription(t("TOOL_GET_RULESET_DESCRIPTION", "Get a ruleset that applies to a GitHub repository, with the refs it targets, its rules and who may bypass them")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Required(),
+				mcp.Description("Ruleset ID, as returned by list_rulesets"),
+			),
+			withIncludeParents(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeParents, err := optionalIncludeParents(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), includeParents)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					msg := fmt.Sprintf("ruleset %d not found in %s/%s", rulesetID, owner, repo)
+					if !includeParents {
+						msg += ", it may be an organization ruleset, which needs include_parents"
+					}
+					return mcp.NewToolResultError(msg), nil
+				}
+				return nil, fmt.Errorf("failed to get ruleset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get ruleset: %s", string(body))), nil
+			}
+
+			detail := rulesetDetail{
+				rulesetSummary: newRulesetSummary(ruleset),
+				Conditions:     ruleset.Conditions,
+				Rules:          ruleset.Rules,
+				BypassActors:   ruleset.BypassActors,
+			}
+			if ruleset.CurrentUserCanBypass != nil {
+				detail.CurrentUserCanBypass = string(*ruleset.CurrentUserCanBypass)
+			}
+
+			r, err := json.Marshal(detail)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
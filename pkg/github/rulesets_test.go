@@ -0,0 +1,275 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRulesets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRulesets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_rulesets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "include_parents")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRulesets := []map[string]interface{}{
+		{
+			"id":          1,
+			"name":        "main protection",
+			"target":      "branch",
+			"source_type": "Repository",
+			"source":      "owner/repo",
+			"enforcement": "active",
+			"updated_at":  "2025-03-01T10:00:00Z",
+		},
+		{
+			"id":          2,
+			"name":        "org release tags",
+			"target":      "tag",
+			"source_type": "Organization",
+			"source":      "owner",
+			"enforcement": "evaluate",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "repository and organization rulesets",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"includes_parents": "true"}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: `[
+				{"id": 1, "name": "main protection", "target": "branch", "enforcement": "active", "source_type": "Repository", "source": "owner/repo", "inherited": false, "updated_at": "2025-03-01T10:00:00Z"},
+				{"id": 2, "name": "org release tags", "target": "tag", "enforcement": "evaluate", "source_type": "Organization", "source": "owner", "inherited": true}
+			]`,
+		},
+		{
+			name: "repository rulesets only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"includes_parents": "false"}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets[:1]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"include_parents": false,
+			},
+			expectedResult: `[
+				{"id": 1, "name": "main protection", "target": "branch", "enforcement": "active", "source_type": "Repository", "source": "owner/repo", "inherited": false, "updated_at": "2025-03-01T10:00:00Z"}
+			]`,
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list rulesets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRulesets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
+
+func Test_GetRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ruleset_id")
+	assert.Contains(t, tool.InputSchema.Properties, "include_parents")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ruleset_id"})
+
+	mockRuleset := map[string]interface{}{
+		"id":          42,
+		"name":        "main protection",
+		"target":      "branch",
+		"source_type": "Organization",
+		"source":      "owner",
+		"enforcement": "active",
+		"conditions": map[string]interface{}{
+			"ref_name": map[string]interface{}{
+				"include": []string{"~DEFAULT_BRANCH"},
+				"exclude": []string{},
+			},
+		},
+		"rules": []map[string]interface{}{
+			{"type": "deletion"},
+			{"type": "pull_request", "parameters": map[string]interface{}{
+				"allowed_merge_methods":             []string{"squash"},
+				"dismiss_stale_reviews_on_push":     true,
+				"require_code_owner_review":         true,
+				"require_last_push_approval":        false,
+				"required_approving_review_count":   2,
+				"required_review_thread_resolution": true,
+			}},
+		},
+		"bypass_actors": []map[string]interface{}{
+			{"actor_id": 5, "actor_type": "RepositoryRole", "bypass_mode": "pull_request"},
+		},
+		"current_user_can_bypass": "never",
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult string
+		expectedErrMsg string
+	}{
+		{
+			name: "organization ruleset with rules",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					expectQueryParams(t, map[string]string{"includes_parents": "true"}).andThen(
+						mockResponse(t, http.StatusOK, mockRuleset),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"ruleset_id": float64(42),
+			},
+			expectedResult: `{
+				"id": 42, "name": "main protection", "target": "branch", "enforcement": "active",
+				"source_type": "Organization", "source": "owner", "inherited": true,
+				"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+				"rules": [
+					{"type": "deletion"},
+					{"type": "pull_request", "parameters": {
+						"allowed_merge_methods": ["squash"],
+						"dismiss_stale_reviews_on_push": true,
+						"require_code_owner_review": true,
+						"require_last_push_approval": false,
+						"required_approving_review_count": 2,
+						"required_review_thread_resolution": true
+					}}
+				],
+				"bypass_actors": [{"actor_id": 5, "actor_type": "RepositoryRole", "bypass_mode": "pull_request"}],
+				"current_user_can_bypass": "never"
+			}`,
+		},
+		{
+			name: "ruleset not found without parents",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"ruleset_id":      float64(42),
+				"include_parents": false,
+			},
+			expectedErrMsg: "ruleset 42 not found in owner/repo, it may be an organization ruleset, which needs include_parents",
+		},
+		{
+			name:         "invalid include_parents",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"ruleset_id":      float64(42),
+				"include_parents": "yes",
+			},
+			expectedErrMsg: "parameter include_parents is not of type bool",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.JSONEq(t, tc.expectedResult, textContent.Text)
+		})
+	}
+}
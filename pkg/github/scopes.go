@@ -0,0 +1,169 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// toolScopes maps tools to the least OAuth scope a classic token needs to run them. Scopes
+// granted by a broader one are accepted too (see impliedScopes), so a tool needing
+// public_repo also runs with repo. Tools that read public data need no scope and are not
+// listed.
+var toolScopes = map[string]string{
+	// Issues
+	"create_issue":           "public_repo",
+	"add_issue_comment":      "public_repo",
+	"update_issue":           "public_repo",
+	"lock_issue":             "public_repo",
+	"unlock_issue":           "public_repo",
+	"transfer_issue":         "public_repo",
+	"add_reaction":           "public_repo",
+	"add_sub_issue":          "public_repo",
+	"remove_sub_issue":       "public_repo",
+	"reprioritize_sub_issue": "public_repo",
+
+	// Pull requests
+	"merge_pull_request":                 "public_repo",
+	"disable_pull_request_auto_merge":    "public_repo",
+	"mark_pull_request_ready_for_review": "public_repo",
+	"convert_pull_request_to_draft":      "public_repo",
+	"update_pull_request_branch":         "public_repo",
+	"create_pull_request_review":         "public_repo",
+	"add_pull_request_review_comment":    "public_repo",
+	"submit_pull_request_review":         "public_repo",
+	"resolve_review_thread":              "public_repo",
+	"unresolve_review_thread":            "public_repo",
+	"create_pull_request":                "public_repo",
+	"update_pull_request":                "public_repo",
+
+	// Repositories
+	"get_repository_traffic_views":  "public_repo",
+	"get_repository_traffic_clones": "public_repo",
+	"list_webhooks":                 "read:repo_hook",
+	"list_repository_invitations":   "repo:invite",
+	"create_or_update_file":         "public_repo",
+	"create_commit_comment":         "public_repo",
+	"create_repository":             "public_repo",
+	"fork_repository":               "public_repo",
+	"sync_fork":                     "public_repo",
+	"create_branch":                 "public_repo",
+	"delete_branch":                 "public_repo",
+	"create_tag":                    "public_repo",
+	"upload_release_asset":          "public_repo",
+	"push_files":                    "public_repo",
+	"create_webhook":                "write:repo_hook",
+	"add_collaborator":              "repo",
+	"accept_repository_invitation":  "repo:invite",
+	"update_branch_protection":      "repo",
+
+	// Users, organizations, deployments and projects
+	"follow_user":                        "user:follow",
+	"list_org_installed_apps":            "read:org",
+	"create_deployment":                  "repo_deployment",
+	"create_deployment_status":           "repo_deployment",
+	"list_projects_v2":                   "read:project",
+	"list_project_v2_items":              "read:project",
+	"add_project_v2_item":                "project",
+	"update_project_v2_item_field_value": "project",
+
+	// Code scanning and Actions
+	"get_code_scanning_alert":   "security_events",
+	"list_code_scanning_alerts": "security_events",
+	"list_actions_secrets":      "repo",
+	"list_actions_variables":    "repo",
+	"cancel_workflow_run":       "public_repo",
+	"dispatch_workflow":         "public_repo",
+	"create_actions_secret":     "repo",
+}
+
+// impliedScopes lists the scopes granted by a broader scope, as documented for OAuth apps.
+// hasScope only looks one level down, so each list holds every scope granted, not just the
+// next narrower ones.
+var impliedScopes = map[string][]string{
+	"repo":            {"repo:status", "repo_deployment", "public_repo", "repo:invite", "security_events", "admin:repo_hook", "write:repo_hook", "read:repo_hook"},
+	"admin:repo_hook": {"write:repo_hook", "read:repo_hook"},
+	"write:repo_hook": {"read:repo_hook"},
+	"admin:org":       {"write:org", "read:org"},
+	"write:org":       {"read:org"},
+	"user":            {"read:user", "user:email", "user:follow"},
+	"project":         {"read:project"},
+}
+
+// hasScope reports whether the granted scopes include scope, directly or through a broader scope.
+func hasScope(granted []string, scope string) bool {
+	for _, g := range granted {
+		if g == scope || slices.Contains(impliedScopes[g], scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenScopes reads the OAuth scopes of the token of the clients returned by getClient, once.
+// The server's token is assumed not to change, so the scopes are cached after the first
+// successful read.
+type tokenScopes struct {
+	getClient GetClientFn
+
+	mu       sync.Mutex
+	loaded   bool
+	scopes   []string
+	reported bool
+}
+
+func newTokenScopes(getClient GetClientFn) *tokenScopes {
+	return &tokenScopes{getClient: getClient}
+}
+
+// get returns the scopes of the token. reported is false for tokens that do not report
+// scopes, i.e. fine-grained personal access tokens and GitHub App tokens.
+func (s *tokenScopes) get(ctx context.Context) (scopes []string, reported bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.loaded {
+		return s.scopes, s.reported, nil
+	}
+
+	client, err := s.getClient(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	// Reading the rate limit does not count against it
+	_, resp, err := client.RateLimit.Get(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read token scopes: %w", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("failed to read token scopes: unexpected status %d", resp.StatusCode)
+	}
+
+	s.scopes, s.reported = parseOAuthScopes(resp.Header)
+	s.loaded = true
+	return s.scopes, s.reported, nil
+}
+
+// withScopeCheck wraps the handler of a tool needing scope so that, when the token reports
+// its scopes and lacks it, calls fail up front with the missing scope rather than with a 403
+// or 404 from GitHub. Tokens that do not report scopes, or whose scopes cannot be read, are
+// left for GitHub to judge.
+func withScopeCheck(scopes *tokenScopes, toolName, scope string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		granted, reported, err := scopes.get(ctx)
+		if err == nil && reported && !hasScope(granted, scope) {
+			have := "none"
+			if len(granted) > 0 {
+				have = strings.Join(granted, ", ")
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("%s needs the %s scope, which the token does not have (it has: %s)", toolName, scope, have)), nil
+		}
+		return handler(ctx, request)
+	}
+}
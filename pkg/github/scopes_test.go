@@ -0,0 +1,171 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToolScopesNameRegisteredTools(t *testing.T) {
+	s := NewServer(stubGetClientFn(github.NewClient(nil)), "test", ServerConfig{}, translations.NullTranslationHelper)
+
+	registered := map[string]bool{}
+	for _, tool := range listServerTools(t, s) {
+		registered[tool.Name] = true
+	}
+	for name := range toolScopes {
+		assert.True(t, registered[name], "toolScopes names unknown tool %s", name)
+	}
+}
+
+func Test_HasScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		scope    string
+		expected bool
+	}{
+		{name: "granted directly", granted: []string{"gist", "public_repo"}, scope: "public_repo", expected: true},
+		{name: "granted by broader scope", granted: []string{"repo"}, scope: "public_repo", expected: true},
+		{name: "granted two levels down", granted: []string{"admin:org"}, scope: "read:org", expected: true},
+		{name: "repo grants webhook admin", granted: []string{"repo"}, scope: "admin:repo_hook", expected: true},
+		{name: "repo grants webhook write", granted: []string{"repo"}, scope: "write:repo_hook", expected: true},
+		{name: "repo grants webhook read", granted: []string{"repo"}, scope: "read:repo_hook", expected: true},
+		{name: "webhook admin grants webhook read", granted: []string{"admin:repo_hook"}, scope: "read:repo_hook", expected: true},
+		{name: "webhook scopes do not grant repo", granted: []string{"admin:repo_hook"}, scope: "repo", expected: false},
+		{name: "narrower scope does not grant broader", granted: []string{"public_repo"}, scope: "repo", expected: false},
+		{name: "no scopes", granted: []string{}, scope: "read:org", expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, hasScope(tc.granted, tc.scope))
+		})
+	}
+}
+
+func Test_ImpliedScopes(t *testing.T) {
+	tests := []struct {
+		scope    string
+		expected []string
+	}{
+		{scope: "repo", expected: []string{"repo:status", "repo_deployment", "public_repo", "repo:invite", "security_events", "admin:repo_hook", "write:repo_hook", "read:repo_hook"}},
+		{scope: "admin:repo_hook", expected: []string{"write:repo_hook", "read:repo_hook"}},
+		{scope: "write:repo_hook", expected: []string{"read:repo_hook"}},
+		{scope: "admin:org", expected: []string{"write:org", "read:org"}},
+		{scope: "write:org", expected: []string{"read:org"}},
+		{scope: "user", expected: []string{"read:user", "user:email", "user:follow"}},
+		{scope: "project", expected: []string{"read:project"}},
+		{scope: "public_repo", expected: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scope, func(t *testing.T) {
+			assert.ElementsMatch(t, tc.expected, impliedScopes[tc.scope])
+			// hasScope doesn't follow implied scopes transitively, so the lists must be closed
+			for _, implied := range impliedScopes[tc.scope] {
+				for _, transitive := range impliedScopes[implied] {
+					assert.Contains(t, impliedScopes[tc.scope], transitive, "%s implies %s, which implies %s", tc.scope, implied, transitive)
+				}
+			}
+		})
+	}
+}
+
+func Test_NewServer_CheckTokenScopes(t *testing.T) {
+	callTool := func(t *testing.T, s *server.MCPServer, name string, arguments map[string]interface{}) (string, bool) {
+		t.Helper()
+		message, err := json.Marshal(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"method":  "tools/call",
+			"params":  map[string]interface{}{"name": name, "arguments": arguments},
+		})
+		require.NoError(t, err)
+
+		b, err := json.Marshal(s.HandleMessage(context.Background(), message))
+		require.NoError(t, err)
+		var callResponse struct {
+			Result struct {
+				Content []struct {
+					Text string `json:"text"`
+				} `json:"content"`
+				IsError bool `json:"isError"`
+			} `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(b, &callResponse))
+		require.Len(t, callResponse.Result.Content, 1)
+		return callResponse.Result.Content[0].Text, callResponse.Result.IsError
+	}
+
+	followArguments := map[string]interface{}{"username": "octocat"}
+
+	t.Run("missing scope", func(t *testing.T) {
+		var rateLimitCalls atomic.Int32
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetRateLimit,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					rateLimitCalls.Add(1)
+					w.Header().Set("X-OAuth-Scopes", "repo, gist")
+					mockResponse(t, http.StatusOK, map[string]interface{}{})(w, r)
+				}),
+			),
+		)
+		s := NewServer(stubGetClientFn(github.NewClient(mockedClient)), "test", ServerConfig{CheckTokenScopes: true}, translations.NullTranslationHelper)
+
+		for range 2 {
+			text, isError := callTool(t, s, "follow_user", followArguments)
+			assert.True(t, isError)
+			assert.Equal(t, "follow_user needs the user:follow scope, which the token does not have (it has: repo, gist)", text)
+		}
+		// The scopes are read once
+		assert.Equal(t, int32(1), rateLimitCalls.Load())
+	})
+
+	t.Run("scope granted by broader scope", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetRateLimit,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("X-OAuth-Scopes", "user")
+					mockResponse(t, http.StatusOK, map[string]interface{}{})(w, r)
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutUserFollowingByUsername,
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		)
+		s := NewServer(stubGetClientFn(github.NewClient(mockedClient)), "test", ServerConfig{CheckTokenScopes: true}, translations.NullTranslationHelper)
+
+		_, isError := callTool(t, s, "follow_user", followArguments)
+		assert.False(t, isError)
+	})
+
+	t.Run("token without reported scopes", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetRateLimit,
+				map[string]interface{}{},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutUserFollowingByUsername,
+				mockResponse(t, http.StatusNoContent, nil),
+			),
+		)
+		s := NewServer(stubGetClientFn(github.NewClient(mockedClient)), "test", ServerConfig{CheckTokenScopes: true}, translations.NullTranslationHelper)
+
+		_, isError := callTool(t, s, "follow_user", followArguments)
+		assert.False(t, isError)
+	})
+}
@@ -5,6 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -12,21 +16,129 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// repositorySearchQualifiers are the structured filters of search_repositories, composed into the search query.
+type repositorySearchQualifiers struct {
+	language string
+	stars    string
+	forks    string
+	topics   []string
+	org      string
+	user     string
+	archived *bool
+}
+
+// buildRepositorySearchQuery appends the qualifiers to the free-text query.
+func buildRepositorySearchQuery(query string, q repositorySearchQualifiers) string {
+	var terms []string
+	if query = strings.TrimSpace(query); query != "" {
+		terms = append(terms, query)
+	}
+	if q.language != "" {
+		terms = append(terms, searchQualifier("language", q.language))
+	}
+	if q.stars != "" {
+		terms = append(terms, searchQualifier("stars", q.stars))
+	}
+	if q.forks != "" {
+		terms = append(terms, searchQualifier("forks", q.forks))
+	}
+	for _, topic := range q.topics {
+		terms = append(terms, searchQualifier("topic", topic))
+	}
+	if q.org != "" {
+		terms = append(terms, searchQualifier("org", q.org))
+	}
+	if q.user != "" {
+		terms = append(terms, searchQualifier("user", q.user))
+	}
+	if q.archived != nil {
+		terms = append(terms, "archived:"+strconv.FormatBool(*q.archived))
+	}
+	return strings.Join(terms, " ")
+}
+
+// repositorySearchResult is the result of search_repositories, with the query that was run.
+type repositorySearchResult struct {
+	Query string `json:"query"`
+	*github.RepositoriesSearchResult
+}
+
 // SearchRepositories creates a tool to search for GitHub repositories.
 func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_repositories",
-			mcp.WithDescription(t("TOOL_SEARCH_REPOSITORIES_DESCRIPTION", "Search for GitHub repositories")),
+			mcp.WithDescription(t("TOOL_SEARCH_REPOSITORIES_DESCRIPTION", "Search for GitHub repositories. Prefer the qualifier parameters over writing qualifiers into the query; the query that was run is returned with the results")),
 			mcp.WithString("query",
-				mcp.Required(),
-				mcp.Description("Search query"),
+				mcp.Description("Search query using GitHub repository search syntax. Combined with the qualifier parameters, at least one of which is required without it"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only match repositories written mainly in this language"),
+			),
+			mcp.WithString("stars",
+				mcp.Description("Only match repositories with this number of stars, e.g. '>100', '<=50' or '10..50'"),
+			),
+			mcp.WithString("forks",
+				mcp.Description("Only match repositories with this number of forks, in the same syntax as stars"),
+			),
+			mcp.WithArray("topic",
+				mcp.Description("Only match repositories with all of these topics"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("org",
+				mcp.Description("Only match repositories owned by this organization"),
+			),
+			mcp.WithString("user",
+				mcp.Description("Only match repositories owned by this user"),
+			),
+			mcp.WithBoolean("archived",
+				mcp.Description("Only match archived (true) or unarchived (false) repositories"),
 			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			query, err := requiredParam[string](request, "query")
+			query, err := OptionalParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var qualifiers repositorySearchQualifiers
+			if qualifiers.language, err = OptionalParam[string](request, "language"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.stars, err = OptionalParam[string](request, "stars"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.forks, err = OptionalParam[string](request, "forks"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.topics, err = OptionalStringArrayParam(request, "topic"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.org, err = OptionalParam[string](request, "org"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.user, err = OptionalParam[string](request, "user"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			archived, ok, err := OptionalParamOK[bool](request, "archived")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if ok {
+				qualifiers.archived = &archived
+			}
+			if qualifiers.stars != "" && !searchRangePattern.MatchString(qualifiers.stars) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid stars %q, must be a number, a comparison like '>100' or a range like '10..50'", qualifiers.stars)), nil
+			}
+			if qualifiers.forks != "" && !searchRangePattern.MatchString(qualifiers.forks) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid forks %q, must be a number, a comparison like '>100' or a range like '10..50'", qualifiers.forks)), nil
+			}
+			query = buildRepositorySearchQuery(query, qualifiers)
+			if query == "" {
+				return mcp.NewToolResultError("missing search query: provide query or at least one qualifier"), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -57,7 +169,7 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search repositories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			r, err := json.Marshal(repositorySearchResult{Query: query, RepositoriesSearchResult: result})
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -138,13 +250,81 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 		}
 }
 
+// userSearchTypes are the account types users can be searched by.
+var userSearchTypes = []string{"user", "org"}
+
+// searchRangePattern matches the numeric values of search qualifiers like followers and
+// repos: a number, a comparison such as ">100" or "<=5", or a range such as "10..50".
+var searchRangePattern = regexp.MustCompile(`^(?:(?:[<>]=?)?\d+|(?:\d+|\*)\.\.(?:\d+|\*))$`)
+
+// userSearchQualifiers are the qualifiers search_users adds to its free-text query.
+type userSearchQualifiers struct {
+	userType  string
+	location  string
+	language  string
+	followers string
+	repos     string
+}
+
+// buildUserSearchQuery appends the qualifiers to the free-text query.
+func buildUserSearchQuery(query string, q userSearchQualifiers) string {
+	var terms []string
+	if query = strings.TrimSpace(query); query != "" {
+		terms = append(terms, query)
+	}
+	if q.userType != "" {
+		terms = append(terms, searchQualifier("type", q.userType))
+	}
+	if q.location != "" {
+		terms = append(terms, searchQualifier("location", q.location))
+	}
+	if q.language != "" {
+		terms = append(terms, searchQualifier("language", q.language))
+	}
+	if q.followers != "" {
+		terms = append(terms, searchQualifier("followers", q.followers))
+	}
+	if q.repos != "" {
+		terms = append(terms, searchQualifier("repos", q.repos))
+	}
+	return strings.Join(terms, " ")
+}
+
+// userSearchResult is the subset of a user search result returned by search_users.
+type userSearchResult struct {
+	TotalCount        int               `json:"total_count"`
+	IncompleteResults bool              `json:"incomplete_results"`
+	Items             []userSearchMatch `json:"items"`
+}
+
+type userSearchMatch struct {
+	Login      string `json:"login"`
+	Type       string `json:"type"`
+	ProfileURL string `json:"profile_url"`
+}
+
 // SearchUsers creates a tool to search for GitHub users.
 func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_users",
-			mcp.WithDescription(t("TOOL_SEARCH_USERS_DESCRIPTION", "Search for GitHub users")),
+			mcp.WithDescription(t("TOOL_SEARCH_USERS_DESCRIPTION", "Search for GitHub users and organizations")),
 			mcp.WithString("q",
-				mcp.Required(),
-				mcp.Description("Search query using GitHub users search syntax"),
+				mcp.Description("Search query using GitHub users search syntax. Combined with the qualifier parameters, at least one of which is required without it"),
+			),
+			mcp.WithString("type",
+				mcp.Description("Only match users or only match organizations"),
+				mcp.Enum(userSearchTypes...),
+			),
+			mcp.WithString("location",
+				mcp.Description("Only match accounts with this location in their profile"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only match accounts with repositories mostly in this language"),
+			),
+			mcp.WithString("followers",
+				mcp.Description("Only match accounts with this many followers, e.g. '>100', '<=10' or '10..50'"),
+			),
+			mcp.WithString("repos",
+				mcp.Description("Only match accounts with this many public repositories, e.g. '>100', '<=10' or '10..50'"),
 			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field (followers, repositories, joined)"),
@@ -157,10 +337,39 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			query, err := requiredParam[string](request, "q")
+			query, err := OptionalParam[string](request, "q")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			var qualifiers userSearchQualifiers
+			if qualifiers.userType, err = OptionalParam[string](request, "type"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.location, err = OptionalParam[string](request, "location"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.language, err = OptionalParam[string](request, "language"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.followers, err = OptionalParam[string](request, "followers"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.repos, err = OptionalParam[string](request, "repos"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if qualifiers.userType != "" && !slices.Contains(userSearchTypes, qualifiers.userType) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid type %q, must be one of: %s", qualifiers.userType, strings.Join(userSearchTypes, ", "))), nil
+			}
+			if qualifiers.followers != "" && !searchRangePattern.MatchString(qualifiers.followers) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid followers %q, must be a number, a comparison like '>100' or a range like '10..50'", qualifiers.followers)), nil
+			}
+			if qualifiers.repos != "" && !searchRangePattern.MatchString(qualifiers.repos) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid repos %q, must be a number, a comparison like '>100' or a range like '10..50'", qualifiers.repos)), nil
+			}
+			query = buildUserSearchQuery(query, qualifiers)
+			if query == "" {
+				return mcp.NewToolResultError("missing search query: provide q or at least one qualifier"), nil
+			}
 			sort, err := OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -202,7 +411,133 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search users: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			users := userSearchResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             make([]userSearchMatch, 0, len(result.Users)),
+			}
+			for _, u := range result.Users {
+				users.Items = append(users.Items, userSearchMatch{
+					Login:      u.GetLogin(),
+					Type:       u.GetType(),
+					ProfileURL: u.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(users)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// commitSearchSorts are the sort fields the commit search API accepts.
+var commitSearchSorts = []string{"author-date", "committer-date"}
+
+type commitSearchResult struct {
+	TotalCount        int                 `json:"total_count"`
+	IncompleteResults bool                `json:"incomplete_results"`
+	Items             []commitSearchMatch `json:"items"`
+}
+
+type commitSearchMatch struct {
+	SHA        string `json:"sha"`
+	Message    string `json:"message"`
+	Author     string `json:"author"`
+	Repository string `json:"repository"`
+	HTMLURL    string `json:"html_url"`
+}
+
+// SearchCommits creates a tool to search for commits across GitHub repositories.
+func SearchCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("search_commits",
+			mcp.WithDescription(t("TOOL_SEARCH_COMMITS_DESCRIPTION", "Search for commits across GitHub repositories")),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description("Search query using GitHub commits search syntax, e.g. 'fix repo:owner/name author:octocat'"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field (author-date, committer-date)"),
+				mcp.Enum(commitSearchSorts...),
+			),
+			mcp.WithString("order",
+				mcp.Description("Sort order ('asc' or 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := requiredParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			order, err := OptionalParam[string](request, "order")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sort != "" && !slices.Contains(commitSearchSorts, sort) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid sort %q, must be one of: %s", sort, strings.Join(commitSearchSorts, ", "))), nil
+			}
+
+			// go-github sends the commit search preview media type itself.
+			opts := &github.SearchOptions{
+				Sort:  sort,
+				Order: order,
+				ListOptions: github.ListOptions{
+					PerPage: pagination.perPage,
+					Page:    pagination.page,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result, resp, err := client.Search.Commits(ctx, query, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to search commits: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to search commits: %s", string(body))), nil
+			}
+
+			commits := commitSearchResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             make([]commitSearchMatch, 0, len(result.Commits)),
+			}
+			for _, c := range result.Commits {
+				author := c.GetAuthor().GetLogin()
+				if author == "" {
+					author = c.GetCommit().GetAuthor().GetName()
+				}
+				commits.Items = append(commits.Items, commitSearchMatch{
+					SHA:        c.GetSHA(),
+					Message:    c.GetCommit().GetMessage(),
+					Author:     author,
+					Repository: c.GetRepository().GetFullName(),
+					HTMLURL:    c.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(commits)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -23,7 +23,14 @@ func Test_SearchRepositories(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "query")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
+	assert.Contains(t, tool.InputSchema.Properties, "language")
+	assert.Contains(t, tool.InputSchema.Properties, "stars")
+	assert.Contains(t, tool.InputSchema.Properties, "forks")
+	assert.Contains(t, tool.InputSchema.Properties, "topic")
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "user")
+	assert.Contains(t, tool.InputSchema.Properties, "archived")
+	assert.Empty(t, tool.InputSchema.Required)
 
 	// Setup mock search results
 	mockSearchResult := &github.RepositoriesSearchResult{
@@ -55,6 +62,7 @@ func Test_SearchRepositories(t *testing.T) {
 		requestArgs    map[string]interface{}
 		expectError    bool
 		expectedResult *github.RepositoriesSearchResult
+		expectedQuery  string
 		expectedErrMsg string
 	}{
 		{
@@ -78,6 +86,7 @@ func Test_SearchRepositories(t *testing.T) {
 			},
 			expectError:    false,
 			expectedResult: mockSearchResult,
+			expectedQuery:  "golang test",
 		},
 		{
 			name: "repository search with default pagination",
@@ -98,6 +107,59 @@ func Test_SearchRepositories(t *testing.T) {
 			},
 			expectError:    false,
 			expectedResult: mockSearchResult,
+			expectedQuery:  "golang test",
+		},
+		{
+			name: "repository search composed from qualifiers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchRepositories,
+					expectQueryParams(t, map[string]string{
+						"q":        "mcp language:go stars:>100 topic:ai topic:llm org:github archived:false",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":    "mcp",
+				"language": "go",
+				"stars":    ">100",
+				"topic":    []interface{}{"ai", "llm"},
+				"org":      "github",
+				"archived": false,
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+			expectedQuery:  "mcp language:go stars:>100 topic:ai topic:llm org:github archived:false",
+		},
+		{
+			name:         "invalid stars range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"language": "go",
+				"stars":    "lots",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid stars "lots", must be a number, a comparison like '>100' or a range like '10..50'`,
+		},
+		{
+			name:         "invalid forks range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"forks": ">=10..20",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid forks ">=10..20", must be a number, a comparison like '>100' or a range like '10..50'`,
+		},
+		{
+			name:           "no query or qualifiers",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]interface{}{},
+			expectError:    false,
+			expectedErrMsg: "missing search query: provide query or at least one qualifier",
 		},
 		{
 			name: "search fails",
@@ -142,10 +204,17 @@ func Test_SearchRepositories(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedResult github.RepositoriesSearchResult
+			var returnedResult repositorySearchResult
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
+			assert.Equal(t, tc.expectedQuery, returnedResult.Query)
 			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
 			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
 			assert.Len(t, returnedResult.Repositories, len(tc.expectedResult.Repositories))
@@ -155,7 +224,43 @@ func Test_SearchRepositories(t *testing.T) {
 				assert.Equal(t, *tc.expectedResult.Repositories[i].FullName, *repo.FullName)
 				assert.Equal(t, *tc.expectedResult.Repositories[i].HTMLURL, *repo.HTMLURL)
 			}
+		})
+	}
+}
+
+func Test_BuildRepositorySearchQuery(t *testing.T) {
+	archived := true
+	tests := []struct {
+		name       string
+		query      string
+		qualifiers repositorySearchQualifiers
+		expected   string
+	}{
+		{
+			name:     "free text only",
+			query:    " mcp server ",
+			expected: "mcp server",
+		},
+		{
+			name:       "qualifiers only",
+			qualifiers: repositorySearchQualifiers{user: "octocat", forks: "10..50", archived: &archived},
+			expected:   "forks:10..50 user:octocat archived:true",
+		},
+		{
+			name:       "values with spaces are quoted",
+			query:      "editor",
+			qualifiers: repositorySearchQualifiers{language: "Vim Script", topics: []string{"text editor"}},
+			expected:   `editor language:"Vim Script" topic:"text editor"`,
+		},
+		{
+			name:     "nothing",
+			expected: "",
+		},
+	}
 
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, buildRepositorySearchQuery(tc.query, tc.qualifiers))
 		})
 	}
 }
@@ -319,11 +424,16 @@ func Test_SearchUsers(t *testing.T) {
 	assert.Equal(t, "search_users", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "q")
+	assert.Contains(t, tool.InputSchema.Properties, "type")
+	assert.Contains(t, tool.InputSchema.Properties, "location")
+	assert.Contains(t, tool.InputSchema.Properties, "language")
+	assert.Contains(t, tool.InputSchema.Properties, "followers")
+	assert.Contains(t, tool.InputSchema.Properties, "repos")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "order")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
+	assert.Empty(t, tool.InputSchema.Required)
 
 	// Setup mock search results
 	mockSearchResult := &github.UsersSearchResult{
@@ -350,13 +460,21 @@ func Test_SearchUsers(t *testing.T) {
 			},
 		},
 	}
+	expectedResult := userSearchResult{
+		TotalCount:        2,
+		IncompleteResults: false,
+		Items: []userSearchMatch{
+			{Login: "user1", Type: "User", ProfileURL: "https://github.com/user1"},
+			{Login: "user2", Type: "User", ProfileURL: "https://github.com/user2"},
+		},
+	}
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedResult *github.UsersSearchResult
+		expectedResult userSearchResult
 		expectedErrMsg string
 	}{
 		{
@@ -383,7 +501,7 @@ func Test_SearchUsers(t *testing.T) {
 				"perPage": float64(30),
 			},
 			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectedResult: expectedResult,
 		},
 		{
 			name: "users search with minimal parameters",
@@ -403,7 +521,57 @@ func Test_SearchUsers(t *testing.T) {
 				"q": "location:finland language:go",
 			},
 			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectedResult: expectedResult,
+		},
+		{
+			name: "users search with qualifiers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchUsers,
+					expectQueryParams(t, map[string]string{
+						"q":        `rust type:org location:"San Francisco" followers:>=100 repos:10..50`,
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q":         "rust",
+				"type":      "org",
+				"location":  "San Francisco",
+				"followers": ">=100",
+				"repos":     "10..50",
+			},
+			expectError:    false,
+			expectedResult: expectedResult,
+		},
+		{
+			name:         "invalid followers range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"followers": "lots",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid followers "lots", must be a number, a comparison like '>100' or a range like '10..50'`,
+		},
+		{
+			name:         "invalid type",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"q":    "octo",
+				"type": "bot",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid type "bot", must be one of: user, org`,
+		},
+		{
+			name:           "missing query and qualifiers",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]interface{}{},
+			expectError:    false,
+			expectedErrMsg: "missing search query: provide q or at least one qualifier",
 		},
 		{
 			name: "search users fails",
@@ -450,21 +618,228 @@ func Test_SearchUsers(t *testing.T) {
 
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedResult github.UsersSearchResult
+			var returnedResult userSearchResult
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
-			assert.Len(t, returnedResult.Users, len(tc.expectedResult.Users))
-			for i, user := range returnedResult.Users {
-				assert.Equal(t, *tc.expectedResult.Users[i].Login, *user.Login)
-				assert.Equal(t, *tc.expectedResult.Users[i].ID, *user.ID)
-				assert.Equal(t, *tc.expectedResult.Users[i].HTMLURL, *user.HTMLURL)
-				assert.Equal(t, *tc.expectedResult.Users[i].AvatarURL, *user.AvatarURL)
-				assert.Equal(t, *tc.expectedResult.Users[i].Type, *user.Type)
-				assert.Equal(t, *tc.expectedResult.Users[i].Followers, *user.Followers)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_BuildUserSearchQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		qualifiers userSearchQualifiers
+		expected   string
+	}{
+		{
+			name:     "free text only",
+			query:    " mona ",
+			expected: "mona",
+		},
+		{
+			name:       "qualifiers only",
+			qualifiers: userSearchQualifiers{userType: "user", language: "go", repos: ">5"},
+			expected:   "type:user language:go repos:>5",
+		},
+		{
+			name:       "locations with spaces are quoted",
+			query:      "mona",
+			qualifiers: userSearchQualifiers{location: "New York", followers: "*..10"},
+			expected:   `mona location:"New York" followers:*..10`,
+		},
+		{
+			name:     "nothing",
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, buildUserSearchQuery(tc.query, tc.qualifiers))
+		})
+	}
+}
+
+func Test_SearchCommits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SearchCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "search_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "order")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
+
+	// Setup mock search results
+	mockSearchResult := &github.CommitsSearchResult{
+		Total:             github.Ptr(2),
+		IncompleteResults: github.Ptr(false),
+		Commits: []*github.CommitResult{
+			{
+				SHA:        github.Ptr("abc123"),
+				Commit:     &github.Commit{Message: github.Ptr("Fix the flaky test")},
+				Author:     &github.User{Login: github.Ptr("octocat")},
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/commit/abc123"),
+			},
+			{
+				SHA: github.Ptr("def456"),
+				Commit: &github.Commit{
+					Message: github.Ptr("Fix the build"),
+					Author:  &github.CommitAuthor{Name: github.Ptr("Mona Lisa")},
+				},
+				Repository: &github.Repository{FullName: github.Ptr("owner/other")},
+				HTMLURL:    github.Ptr("https://github.com/owner/other/commit/def456"),
+			},
+		},
+	}
+	expectedResult := commitSearchResult{
+		TotalCount:        2,
+		IncompleteResults: false,
+		Items: []commitSearchMatch{
+			{SHA: "abc123", Message: "Fix the flaky test", Author: "octocat", Repository: "owner/repo", HTMLURL: "https://github.com/owner/repo/commit/abc123"},
+			{SHA: "def456", Message: "Fix the build", Author: "Mona Lisa", Repository: "owner/other", HTMLURL: "https://github.com/owner/other/commit/def456"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult commitSearchResult
+		expectedErrMsg string
+	}{
+		{
+			name: "successful commits search with all parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCommits,
+					expectQueryParams(t, map[string]string{
+						"q":        "fix org:owner",
+						"sort":     "committer-date",
+						"order":    "asc",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":   "fix org:owner",
+				"sort":    "committer-date",
+				"order":   "asc",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError:    false,
+			expectedResult: expectedResult,
+		},
+		{
+			name: "commits search with minimal parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCommits,
+					expectQueryParams(t, map[string]string{
+						"q":        "fix",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "fix",
+			},
+			expectError:    false,
+			expectedResult: expectedResult,
+		},
+		{
+			name:         "invalid sort",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query": "fix",
+				"sort":  "stars",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid sort "stars", must be one of: author-date, committer-date`,
+		},
+		{
+			name:           "missing query",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]interface{}{},
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: query",
+		},
+		{
+			name: "search commits fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCommits,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "invalid:query",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to search commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SearchCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
 			}
+
+			// Unmarshal and verify the result
+			var returnedResult commitSearchResult
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
 		})
 	}
 }
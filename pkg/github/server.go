@@ -7,17 +7,67 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
 )
 
 type GetClientFn func(context.Context) (*github.Client, error)
 
+// ServerConfig holds the deployment-specific settings of the GitHub MCP server.
+type ServerConfig struct {
+	// ReadOnly restricts the server to read-only operations.
+	ReadOnly bool
+
+	// ReadOnlyExceptions names write tools that stay enabled on a read-only server, e.g. to
+	// allow commenting on issues while everything else is read-only. They run without a
+	// capability token.
+	ReadOnlyExceptions []string
+
+	// CapabilitySecret is the HMAC secret used to verify capability tokens. When set on a
+	// read-only server, write tools stay registered but only run for callers presenting a
+	// valid token (see NewCapabilityToken).
+	CapabilitySecret []byte
+
+	// EnableGraphQL registers the graphql tool, which runs arbitrary GraphQL queries and
+	// mutations. It is off by default since it bypasses the scope of the other tools.
+	EnableGraphQL bool
+
+	// DescriptionOverrides replaces the description of a tool, keyed by tool name.
+	// Tools without an override keep their (translated) default description.
+	DescriptionOverrides map[string]string
+
+	// Logger receives a structured entry for every tool call, with the tool name, sanitized
+	// arguments, duration and outcome. Tool calls are not logged when it is nil.
+	Logger log.FieldLogger
+
+	// Observer receives the duration and outcome of every tool call, and the GitHub rate limit
+	// status of every API response. Nothing is observed when it is nil.
+	Observer ToolObserver
+
+	// MaxRetries is the number of times a read from the GitHub API failing with a transient
+	// server error or a secondary rate limit is retried. Zero means DefaultMaxRetries, and a
+	// negative value disables retries. Writes are never retried.
+	MaxRetries int
+
+	// StrictArguments rejects tool calls passing arguments that the tool does not declare,
+	// listing them in the error. By default unknown arguments are ignored.
+	StrictArguments bool
+
+	// CheckTokenScopes makes tools needing an OAuth scope the token lacks fail with an error
+	// naming the scope, instead of with whatever GitHub responds. The scopes are read from
+	// GitHub on the first such call. Tokens that do not report scopes, like fine-grained
+	// personal access tokens, are not checked.
+	CheckTokenScopes bool
+}
+
 // NewServer creates a new GitHub MCP server with the specified GH client and logger.
-func NewServer(getClient GetClientFn, version string, readOnly bool, t translations.TranslationHelperFunc, opts ...server.ServerOption) *server.MCPServer {
+func NewServer(getClient GetClientFn, version string, cfg ServerConfig, t translations.TranslationHelperFunc, opts ...server.ServerOption) *server.MCPServer {
 	// Add default options
 	defaultOpts := []server.ServerOption{
 		server.WithResourceCapabilities(true, true),
@@ -31,67 +81,261 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 		version,
 		opts...,
 	)
+	tools := &toolRegistrar{server: s, cfg: cfg}
+	if cfg.Observer != nil {
+		getClient = observeRateLimits(getClient, cfg.Observer)
+	}
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	if maxRetries > 0 {
+		getClient = retryTransientFailures(getClient, maxRetries)
+	}
+	if cfg.CheckTokenScopes {
+		tools.scopes = newTokenScopes(getClient)
+	}
+	// Remembers the results of the create tools by idempotency key
+	created := newIdempotencyCache(idempotencyTTL)
 
 	// Add GitHub Resources
-	s.AddResourceTemplate(GetRepositoryResourceContent(getClient, t))
-	s.AddResourceTemplate(GetRepositoryResourceBranchContent(getClient, t))
-	s.AddResourceTemplate(GetRepositoryResourceCommitContent(getClient, t))
-	s.AddResourceTemplate(GetRepositoryResourceTagContent(getClient, t))
-	s.AddResourceTemplate(GetRepositoryResourcePrContent(getClient, t))
+	resources := newResourceCache(maxCachedResources)
+	s.AddResourceTemplate(GetRepositoryResourceContent(getClient, resources, t))
+	s.AddResourceTemplate(GetRepositoryResourceBranchContent(getClient, resources, t))
+	s.AddResourceTemplate(GetRepositoryResourceCommitContent(getClient, resources, t))
+	s.AddResourceTemplate(GetRepositoryResourceTagContent(getClient, resources, t))
+	s.AddResourceTemplate(GetRepositoryResourcePrContent(getClient, resources, t))
+	s.AddResourceTemplate(GetRepositoryResourceRefContent(getClient, resources, t))
 
 	// Add GitHub tools - Issues
-	s.AddTool(GetIssue(getClient, t))
-	s.AddTool(SearchIssues(getClient, t))
-	s.AddTool(ListIssues(getClient, t))
-	s.AddTool(GetIssueComments(getClient, t))
-	if !readOnly {
-		s.AddTool(CreateIssue(getClient, t))
-		s.AddTool(AddIssueComment(getClient, t))
-		s.AddTool(UpdateIssue(getClient, t))
-	}
+	tools.addTool(GetIssue(getClient, t))
+	tools.addTool(BatchGetIssues(getClient, t))
+	tools.addTool(SearchIssues(getClient, t))
+	tools.addTool(ListIssues(getClient, t))
+	tools.addTool(GetIssueComments(getClient, t))
+	tools.addTool(ListReactions(getClient, t))
+	tools.addTool(ListSubIssues(getClient, t))
+	tools.addTool(GetIssueDependencies(getClient, t))
+	tools.addWriteTool(CreateIssue(getClient, created, t))
+	tools.addWriteTool(AddIssueComment(getClient, t))
+	tools.addWriteTool(UpdateIssue(getClient, t))
+	tools.addWriteTool(LockIssue(getClient, t))
+	tools.addWriteTool(UnlockIssue(getClient, t))
+	tools.addWriteTool(TransferIssue(getClient, t))
+	tools.addWriteTool(AddReaction(getClient, t))
+	tools.addWriteTool(AddSubIssue(getClient, t))
+	tools.addWriteTool(RemoveSubIssue(getClient, t))
+	tools.addWriteTool(ReprioritizeSubIssue(getClient, t))
 
 	// Add GitHub tools - Pull Requests
-	s.AddTool(GetPullRequest(getClient, t))
-	s.AddTool(ListPullRequests(getClient, t))
-	s.AddTool(GetPullRequestFiles(getClient, t))
-	s.AddTool(GetPullRequestStatus(getClient, t))
-	s.AddTool(GetPullRequestComments(getClient, t))
-	s.AddTool(GetPullRequestReviews(getClient, t))
-	if !readOnly {
-		s.AddTool(MergePullRequest(getClient, t))
-		s.AddTool(UpdatePullRequestBranch(getClient, t))
-		s.AddTool(CreatePullRequestReview(getClient, t))
-		s.AddTool(CreatePullRequest(getClient, t))
-		s.AddTool(UpdatePullRequest(getClient, t))
-	}
+	tools.addTool(GetPullRequest(getClient, t))
+	tools.addTool(ListPullRequests(getClient, t))
+	tools.addTool(GetPullRequestFiles(getClient, t))
+	tools.addTool(ListPullRequestCommits(getClient, t))
+	tools.addTool(GetReferencedPullRequestsForCommit(getClient, t))
+	tools.addTool(GetPullRequestStatus(getClient, t))
+	tools.addTool(CheckPullRequestMergeable(getClient, t))
+	tools.addTool(GetPullRequestComments(getClient, t))
+	tools.addTool(GetPullRequestReviews(getClient, t))
+	tools.addTool(ListRequestedReviewers(getClient, t))
+	tools.addTool(ListReferencedIssues(getClient, t))
+	tools.addTool(ListReviewThreads(getClient, t))
+	tools.addWriteTool(MergePullRequest(getClient, t))
+	tools.addWriteTool(DisablePullRequestAutoMerge(getClient, t))
+	tools.addWriteTool(MarkPullRequestReadyForReview(getClient, t))
+	tools.addWriteTool(ConvertPullRequestToDraft(getClient, t))
+	tools.addWriteTool(UpdatePullRequestBranch(getClient, t))
+	tools.addWriteTool(CreatePullRequestReview(getClient, t))
+	tools.addWriteTool(AddPullRequestReviewComment(getClient, t))
+	tools.addWriteTool(SubmitPullRequestReview(getClient, t))
+	tools.addWriteTool(ResolveReviewThread(getClient, t))
+	tools.addWriteTool(UnresolveReviewThread(getClient, t))
+	tools.addWriteTool(CreatePullRequest(getClient, created, t))
+	tools.addWriteTool(UpdatePullRequest(getClient, t))
 
 	// Add GitHub tools - Repositories
-	s.AddTool(SearchRepositories(getClient, t))
-	s.AddTool(GetFileContents(getClient, t))
-	s.AddTool(GetCommit(getClient, t))
-	s.AddTool(ListCommits(getClient, t))
-	s.AddTool(ListBranches(getClient, t))
-	if !readOnly {
-		s.AddTool(CreateOrUpdateFile(getClient, t))
-		s.AddTool(CreateRepository(getClient, t))
-		s.AddTool(ForkRepository(getClient, t))
-		s.AddTool(CreateBranch(getClient, t))
-		s.AddTool(PushFiles(getClient, t))
-	}
+	tools.addTool(SearchRepositories(getClient, t))
+	tools.addTool(GetRepository(getClient, t))
+	tools.addTool(ListLanguages(getClient, t))
+	tools.addTool(GetContributorActivity(getClient, t))
+	tools.addTool(ListRepositoryEvents(getClient, t))
+	tools.addTool(ListRepositoryActivity(getClient, t))
+	tools.addTool(GetFileContents(getClient, t))
+	tools.addTool(GetMultipleFileContents(getClient, t))
+	tools.addTool(GrepFile(getClient, t))
+	tools.addTool(GetCommit(getClient, t))
+	tools.addTool(GetCommitSignatureVerification(getClient, t))
+	tools.addTool(ListUnverifiedCommits(getClient, t))
+	tools.addTool(GetCombinedStatus(getClient, t))
+	tools.addTool(ListCommitComments(getClient, t))
+	tools.addTool(ListCommits(getClient, t))
+	tools.addTool(ListBranches(getClient, t))
+	tools.addTool(GetBranchStaleness(getClient, t))
+	tools.addTool(ListRepositoryTagsWithReleases(getClient, t))
+	tools.addTool(ListReleaseAssets(getClient, t))
+	tools.addTool(GetCodeowners(getClient, t))
+	tools.addTool(GetBranchProtection(getClient, t))
+	tools.addTool(ListRulesets(getClient, t))
+	tools.addTool(GetRuleset(getClient, t))
+	tools.addTool(GetRepositoryTrafficViews(getClient, t))
+	tools.addTool(GetRepositoryTrafficClones(getClient, t))
+	tools.addTool(ListWebhooks(getClient, t))
+	tools.addTool(ListCollaborators(getClient, t))
+	tools.addTool(GetCollaboratorPermission(getClient, t))
+	tools.addTool(ListRepositoryInvitations(getClient, t))
+	tools.addWriteTool(CreateOrUpdateFile(getClient, t))
+	tools.addWriteTool(CreateCommitComment(getClient, t))
+	tools.addWriteTool(CreateRepository(getClient, t))
+	tools.addWriteTool(ForkRepository(getClient, t))
+	tools.addWriteTool(SyncFork(getClient, t))
+	tools.addWriteTool(CreateBranch(getClient, t))
+	tools.addWriteTool(DeleteBranch(getClient, t))
+	tools.addWriteTool(CreateTag(getClient, t))
+	tools.addWriteTool(UploadReleaseAsset(getClient, t))
+	tools.addWriteTool(PushFiles(getClient, t))
+	tools.addWriteTool(CreateWebhook(getClient, t))
+	tools.addWriteTool(AddCollaborator(getClient, t))
+	tools.addWriteTool(AcceptRepositoryInvitation(getClient, t))
+	tools.addWriteTool(UpdateBranchProtection(getClient, t))
 
 	// Add GitHub tools - Search
-	s.AddTool(SearchCode(getClient, t))
-	s.AddTool(SearchUsers(getClient, t))
+	tools.addTool(SearchCode(getClient, t))
+	tools.addTool(SearchUsers(getClient, t))
+	tools.addTool(SearchCommits(getClient, t))
 
 	// Add GitHub tools - Users
-	s.AddTool(GetMe(getClient, t))
+	tools.addTool(GetMe(getClient, t))
+	tools.addTool(HealthCheck(getClient, t))
+	tools.addTool(GetUser(getClient, t))
+	tools.addTool(ListFollowers(getClient, t))
+	tools.addTool(ListFollowing(getClient, t))
+	tools.addTool(ListUserEvents(getClient, t))
+	tools.addWriteTool(FollowUser(getClient, t))
+
+	// Add GitHub tools - Organizations
+	tools.addTool(ListOrgInstalledApps(getClient, t))
+
+	// Add GitHub tools - Deployments
+	tools.addTool(ListDeployments(getClient, t))
+	tools.addTool(ListDeploymentStatuses(getClient, t))
+	tools.addTool(ListEnvironments(getClient, t))
+	tools.addTool(GetEnvironment(getClient, t))
+	tools.addWriteTool(CreateDeployment(getClient, t))
+	tools.addWriteTool(CreateDeploymentStatus(getClient, t))
+
+	// Add GitHub tools - Projects
+	tools.addTool(ListProjectsV2(getClient, t))
+	tools.addTool(ListProjectV2Items(getClient, t))
+	tools.addWriteTool(AddProjectV2Item(getClient, t))
+	tools.addWriteTool(UpdateProjectV2ItemFieldValue(getClient, t))
 
 	// Add GitHub tools - Code Scanning
-	s.AddTool(GetCodeScanningAlert(getClient, t))
-	s.AddTool(ListCodeScanningAlerts(getClient, t))
+	tools.addTool(GetCodeScanningAlert(getClient, t))
+	tools.addTool(ListCodeScanningAlerts(getClient, t))
+
+	// Add GitHub tools - Dependency Graph
+	tools.addTool(GetDependencyGraphSBOM(getClient, t))
+	tools.addTool(GetDependencyReview(getClient, t))
+
+	// Add GitHub tools - Actions
+	tools.addTool(ListActionsSecrets(getClient, t))
+	tools.addTool(ListActionsVariables(getClient, t))
+	tools.addTool(GetWorkflowRun(getClient, t))
+	tools.addTool(ListWorkflowJobs(getClient, t))
+	tools.addTool(GetWorkflowRunUsage(getClient, t))
+	tools.addTool(ListArtifacts(getClient, t))
+	tools.addTool(DownloadArtifact(getClient, t))
+	tools.addWriteTool(CancelWorkflowRun(getClient, t))
+	tools.addWriteTool(DispatchWorkflow(getClient, t))
+	tools.addWriteTool(CreateActionsSecret(getClient, t))
+
+	// Add GitHub tools - GraphQL
+	if cfg.EnableGraphQL {
+		// The tool can run mutations, so it is treated as a write tool
+		tools.addWriteTool(GraphQLQuery(getClient, t))
+	}
+
+	// Add introspection tools
+	tools.addTool(ExportToolSchemas(tools.registeredTools, t))
+	tools.addTool(ListAvailableTools(tools.availableTools, t))
 	return s
 }
 
+// toolRegistrar registers tools with an MCP server, applying the deployment-specific
+// settings of the ServerConfig to each of them.
+type toolRegistrar struct {
+	server     *server.MCPServer
+	cfg        ServerConfig
+	tools      []mcp.Tool
+	writeTools map[string]bool
+	scopes     *tokenScopes
+}
+
+// addTool registers the tool, replacing its description if the deployment overrides it.
+// Secrets are redacted from the output of every tool, and calls are logged and observed
+// if the configuration sets a logger and an observer. In strict mode, calls with undeclared
+// arguments are rejected before they reach the tool, and when token scopes are checked, so
+// are calls to tools needing a scope the token lacks.
+func (r *toolRegistrar) addTool(tool mcp.Tool, handler server.ToolHandlerFunc) {
+	if description, ok := r.cfg.DescriptionOverrides[tool.Name]; ok {
+		tool.Description = description
+	}
+	if scope, ok := toolScopes[tool.Name]; ok && r.scopes != nil {
+		handler = withScopeCheck(r.scopes, tool.Name, scope, handler)
+	}
+	if r.cfg.StrictArguments {
+		handler = withStrictArguments(tool, handler)
+	}
+	if r.cfg.Logger != nil {
+		handler = withLogging(r.cfg.Logger, tool.Name, handler)
+	}
+	if r.cfg.Observer != nil {
+		handler = withObserver(r.cfg.Observer, tool.Name, handler)
+	}
+	r.tools = append(r.tools, tool)
+	r.server.AddTool(tool, withRedaction(handler))
+}
+
+// addWriteTool registers a tool that modifies GitHub state. A read-only server skips it,
+// unless it is one of the read-only exceptions, or a capability secret is configured, in
+// which case the tool requires a capability token.
+func (r *toolRegistrar) addWriteTool(tool mcp.Tool, handler server.ToolHandlerFunc) {
+	if r.cfg.ReadOnly && !slices.Contains(r.cfg.ReadOnlyExceptions, tool.Name) {
+		if len(r.cfg.CapabilitySecret) == 0 {
+			return
+		}
+		tool, handler = requireCapability(r.cfg.CapabilitySecret, tool, handler)
+	}
+	r.addTool(tool, handler)
+	if r.writeTools == nil {
+		r.writeTools = make(map[string]bool)
+	}
+	r.writeTools[tool.Name] = true
+}
+
+// registeredTools returns the definitions of the tools registered so far, in registration order.
+func (r *toolRegistrar) registeredTools() []mcp.Tool {
+	return append([]mcp.Tool(nil), r.tools...)
+}
+
+// availableTools returns the tools registered so far, in registration order, telling read
+// tools apart from write tools and the write tools gated behind a capability token, with the
+// OAuth scope each tool needs.
+func (r *toolRegistrar) availableTools() []availableTool {
+	tools := make([]availableTool, 0, len(r.tools))
+	for _, tool := range r.tools {
+		write := r.writeTools[tool.Name]
+		tools = append(tools, availableTool{
+			Name:                    tool.Name,
+			Description:             tool.Description,
+			ReadOnly:                !write,
+			RequiresCapabilityToken: write && r.cfg.ReadOnly && !slices.Contains(r.cfg.ReadOnlyExceptions, tool.Name),
+			RequiredScope:           toolScopes[tool.Name],
+		})
+	}
+	return tools
+}
+
 // GetMe creates a tool to get details of the authenticated user.
 func GetMe(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_me",
@@ -242,6 +486,25 @@ func OptionalIntParamWithDefault(r mcp.CallToolRequest, p string, d int) (int, e
 	return v, nil
 }
 
+// OptionalTimeParam is a helper function that can be used to fetch a requested timestamp parameter from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request, if not, it returns nil
+// 2. If it is present, checks it is a string in one of the formats parseISOTimestamp accepts
+func OptionalTimeParam(r mcp.CallToolRequest, p string) (*time.Time, error) {
+	v, err := OptionalParam[string](r, p)
+	if err != nil {
+		return nil, err
+	}
+	if v == "" {
+		return nil, nil
+	}
+	t, err := parseISOTimestamp(v)
+	if err != nil {
+		return nil, fmt.Errorf("parameter %s: %w", p, err)
+	}
+	return &t, nil
+}
+
 // OptionalStringArrayParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request, if not, it returns its zero-value
@@ -272,6 +535,36 @@ func OptionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error)
 	}
 }
 
+// OptionalIntArrayParam is a helper function that can be used to fetch a requested parameter from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request, if not, it returns its zero-value
+// 2. If it is present, iterates the elements and checks each is a whole number
+func OptionalIntArrayParam(r mcp.CallToolRequest, p string) ([]int, error) {
+	// Check if the parameter is present in the request
+	if _, ok := r.Params.Arguments[p]; !ok {
+		return []int{}, nil
+	}
+
+	switch v := r.Params.Arguments[p].(type) {
+	case nil:
+		return []int{}, nil
+	case []int:
+		return v, nil
+	case []any:
+		intSlice := make([]int, len(v))
+		for i, v := range v {
+			f, ok := v.(float64)
+			if !ok || f != float64(int(f)) {
+				return []int{}, fmt.Errorf("parameter %s is not of type integer, is %T", p, v)
+			}
+			intSlice[i] = int(f)
+		}
+		return intSlice, nil
+	default:
+		return []int{}, fmt.Errorf("parameter %s could not be coerced to []int, is %T", p, r.Params.Arguments[p])
+	}
+}
+
 // WithPagination returns a ToolOption that adds "page" and "perPage" parameters to the tool.
 // The "page" parameter is optional, min 1. The "perPage" parameter is optional, min 1, max 100.
 func WithPagination() mcp.ToolOption {
@@ -313,3 +606,45 @@ func OptionalPaginationParams(r mcp.CallToolRequest) (PaginationParams, error) {
 		perPage: perPage,
 	}, nil
 }
+
+// WithCursorPagination returns a ToolOption that adds "perPage" and "after" parameters to a tool
+// backed by a GraphQL connection. The "perPage" parameter is optional, min 1, max 100. The "after"
+// parameter is the end cursor of the previous page.
+func WithCursorPagination() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithNumber("perPage",
+			mcp.Description("Results per page for pagination (min 1, max 100)"),
+			mcp.Min(1),
+			mcp.Max(100),
+		)(tool)
+
+		mcp.WithString("after",
+			mcp.Description("Cursor to continue from, as returned in end_cursor by the previous page"),
+		)(tool)
+	}
+}
+
+type CursorPaginationParams struct {
+	perPage int
+	after   string
+}
+
+// OptionalCursorPaginationParams returns the "perPage" and "after" parameters from the request,
+// or their default values if not present, "perPage" default is 30 and "after" default is empty.
+func OptionalCursorPaginationParams(r mcp.CallToolRequest) (CursorPaginationParams, error) {
+	perPage, err := OptionalIntParamWithDefault(r, "perPage", 30)
+	if err != nil {
+		return CursorPaginationParams{}, err
+	}
+	if perPage < 1 || perPage > 100 {
+		return CursorPaginationParams{}, fmt.Errorf("perPage must be between 1 and 100, got %d", perPage)
+	}
+	after, err := OptionalParam[string](r, "after")
+	if err != nil {
+		return CursorPaginationParams{}, err
+	}
+	return CursorPaginationParams{
+		perPage: perPage,
+		after:   after,
+	}, nil
+}
@@ -138,6 +138,64 @@ func Test_GetMe(t *testing.T) {
 	}
 }
 
+func Test_NewServer_DescriptionOverrides(t *testing.T) {
+	s := NewServer(stubGetClientFn(github.NewClient(nil)), "test", ServerConfig{
+		DescriptionOverrides: map[string]string{
+			"get_issue": "Fetch a single issue. Never include personal data in summaries.",
+		},
+	}, translations.NullTranslationHelper)
+
+	descriptions := map[string]string{}
+	for _, tool := range listServerTools(t, s) {
+		descriptions[tool.Name] = tool.Description
+	}
+
+	// The overridden tool registers with the custom description
+	assert.Equal(t, "Fetch a single issue. Never include personal data in summaries.", descriptions["get_issue"])
+
+	// Tools without an override keep their default description
+	defaultTool, _ := ListIssues(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	assert.Equal(t, defaultTool.Description, descriptions["list_issues"])
+}
+
+func Test_NewServer_ReadOnlyExceptions(t *testing.T) {
+	toolNames := func(cfg ServerConfig) []string {
+		s := NewServer(stubGetClientFn(github.NewClient(nil)), "test", cfg, translations.NullTranslationHelper)
+		var names []string
+		for _, tool := range listServerTools(t, s) {
+			names = append(names, tool.Name)
+		}
+		return names
+	}
+
+	readOnly := toolNames(ServerConfig{ReadOnly: true})
+	require.NotContains(t, readOnly, "add_issue_comment")
+
+	// Only the excepted write tool is added to the read-only tools
+	withException := toolNames(ServerConfig{ReadOnly: true, ReadOnlyExceptions: []string{"add_issue_comment"}})
+	assert.ElementsMatch(t, append(readOnly, "add_issue_comment"), withException)
+
+	// Exceptions only matter in read-only mode
+	assert.ElementsMatch(t, toolNames(ServerConfig{}), toolNames(ServerConfig{ReadOnlyExceptions: []string{"add_issue_comment"}}))
+}
+
+func Test_NewServer_ReadOnlyExceptionSkipsCapabilityToken(t *testing.T) {
+	s := NewServer(stubGetClientFn(github.NewClient(nil)), "test", ServerConfig{
+		ReadOnly:           true,
+		ReadOnlyExceptions: []string{"add_issue_comment"},
+		CapabilitySecret:   []byte("secret"),
+	}, translations.NullTranslationHelper)
+
+	for _, tool := range listServerTools(t, s) {
+		switch tool.Name {
+		case "add_issue_comment":
+			assert.NotContains(t, tool.InputSchema.Properties, capabilityTokenParam)
+		case "create_issue":
+			assert.Contains(t, tool.InputSchema.Properties, capabilityTokenParam)
+		}
+	}
+}
+
 func Test_IsAcceptedError(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -558,6 +616,159 @@ func TestOptionalStringArrayParam(t *testing.T) {
 	}
 }
 
+func TestOptionalIntArrayParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    []int
+		expectError bool
+	}{
+		{
+			name:        "parameter not in request",
+			params:      map[string]any{},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: false,
+		},
+		{
+			name: "valid any array parameter",
+			params: map[string]any{
+				"numbers": []any{float64(1), float64(42)},
+			},
+			paramName:   "numbers",
+			expected:    []int{1, 42},
+			expectError: false,
+		},
+		{
+			name: "valid int array parameter",
+			params: map[string]any{
+				"numbers": []int{1, 42},
+			},
+			paramName:   "numbers",
+			expected:    []int{1, 42},
+			expectError: false,
+		},
+		{
+			name: "wrong type parameter",
+			params: map[string]any{
+				"numbers": "1",
+			},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: true,
+		},
+		{
+			name: "fractional number",
+			params: map[string]any{
+				"numbers": []any{float64(1), 1.5},
+			},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: true,
+		},
+		{
+			name: "wrong slice type parameter",
+			params: map[string]any{
+				"numbers": []any{float64(1), "2"},
+			},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := OptionalIntArrayParam(request, tc.paramName)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestOptionalTimeParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    *time.Time
+		expectError string
+	}{
+		{
+			name:      "parameter not in request",
+			params:    map[string]any{},
+			paramName: "since",
+			expected:  nil,
+		},
+		{
+			name: "empty string",
+			params: map[string]any{
+				"since": "",
+			},
+			paramName: "since",
+			expected:  nil,
+		},
+		{
+			name: "RFC3339 timestamp",
+			params: map[string]any{
+				"since": "2024-03-01T12:30:00+02:00",
+			},
+			paramName: "since",
+			expected:  github.Ptr(time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)),
+		},
+		{
+			name: "date only",
+			params: map[string]any{
+				"since": "2024-03-01",
+			},
+			paramName: "since",
+			expected:  github.Ptr(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "unparseable timestamp",
+			params: map[string]any{
+				"since": "yesterday",
+			},
+			paramName:   "since",
+			expectError: "parameter since: invalid ISO 8601 timestamp: yesterday (supported formats: YYYY-MM-DDThh:mm:ssZ, YYYY-MM-DDThh:mm:ss or YYYY-MM-DD)",
+		},
+		{
+			name: "wrong type parameter",
+			params: map[string]any{
+				"since": float64(1709251200),
+			},
+			paramName:   "since",
+			expectError: "parameter since is not of type string, is float64",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := OptionalTimeParam(request, tc.paramName)
+
+			if tc.expectError != "" {
+				assert.EqualError(t, err, tc.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expected == nil {
+				assert.Nil(t, result)
+				return
+			}
+			require.NotNil(t, result)
+			assert.True(t, tc.expected.Equal(*result), "expected %s, got %s", tc.expected, result)
+		})
+	}
+}
+
 func TestOptionalPaginationParams(t *testing.T) {
 	tests := []struct {
 		name        string
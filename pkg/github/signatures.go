@@ -0,0 +1,213 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// commitSignature is the signature verification of a commit as GitHub reports it. Reason
+// explains the outcome, e.g. "valid", "unsigned", "unknown_key" or "bad_email". GitHub checks
+// the signature against the keys of the committer, so Signer is the committer's account.
+type commitSignature struct {
+	SHA           string `json:"sha"`
+	Verified      bool   `json:"verified"`
+	Reason        string `json:"reason"`
+	SignatureType string `json:"signature_type,omitempty"`
+	Signer        string `json:"signer,omitempty"`
+	SignerEmail   string `json:"signer_email,omitempty"`
+	Message       string `json:"message,omitempty"`
+	HTMLURL       string `json:"html_url,omitempty"`
+}
+
+// signatureType tells the kind of key a commit was signed with from the armor of its signature.
+func signatureType(signature string) string {
+	switch {
+	case signature == "":
+		return ""
+	case strings.HasPrefix(signature, "-----BEGIN PGP SIGNATURE-----"):
+		return "gpg"
+	case strings.HasPrefix(signature, "-----BEGIN SSH SIGNATURE-----"):
+		return "ssh"
+	case strings.HasPrefix(signature, "-----BEGIN SIGNED MESSAGE-----"):
+		return "x509"
+	}
+	return "unknown"
+}
+
+func newCommitSignature(c *github.RepositoryCommit) commitSignature {
+	verification := c.GetCommit().GetVerification()
+	message, _, _ := strings.Cut(c.GetCommit().GetMessage(), "\n")
+	return commitSignature{
+		SHA:           c.GetSHA(),
+		Verified:      verification.GetVerified(),
+		Reason:        verification.GetReason(),
+		SignatureType: signatureType(verification.GetSignature()),
+		Signer:        c.GetCommitter().GetLogin(),
+		SignerEmail:   c.GetCommit().GetCommitter().GetEmail(),
+		Message:       message,
+		HTMLURL:       c.GetHTMLURL(),
+	}
+}
+
+// unverifiedCommits lists the commits of a page of history whose signature GitHub could not verify.
+type unverifiedCommits struct {
+	Checked    int               `json:"checked"`
+	Unverified []commitSignature `json:"unverified"`
+}
+
+// GetCommitSignatureVerification creates a tool to get whether the signature of a commit is verified.
+func GetCommitSignatureVerification(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_commit_signature_verification",
+			mcp.WithDescription(t("TOOL_GET_COMMIT_SIGNATURE_VERIFICATION_DESCRIPTION", "Get whether GitHub verified the GPG, SSH or S/MIME signature of a commit, why not if it didn't, and the account that signed it")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("Commit SHA, branch name, or tag name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := requiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			commit, resp, err := client.Repositories.GetCommit(ctx, owner, repo, sha, nil)
+			if err != nil {
+				// Unknown refs are reported as 404, malformed ones and unknown SHAs as 422.
+				if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+					return mcp.NewToolResultError(fmt.Sprintf("commit %s not found in %s/%s", sha, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get commit: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newCommitSignature(commit))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListUnverifiedCommits creates a tool to find the recent commits of a ref whose signature is not verified.
+func ListUnverifiedCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_unverified_commits",
+			mcp.WithDescription(t("TOOL_LIST_UNVERIFIED_COMMITS_DESCRIPTION", "Check a page of the recent commits of a branch of a GitHub repository and list those that are unsigned or whose signature GitHub could not verify, with the reason")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("Branch name, tag or commit SHA to start from (default: the default branch)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.CommitsListOptions{
+				SHA: sha,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					if sha != "" {
+						return mcp.NewToolResultError(fmt.Sprintf("ref %s not found in %s/%s", sha, owner, repo)), nil
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list commits: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s", string(body))), nil
+			}
+
+			result := unverifiedCommits{
+				Checked:    len(commits),
+				Unverified: []commitSignature{},
+			}
+			for _, c := range commits {
+				if signature := newCommitSignature(c); !signature.Verified {
+					result.Unverified = append(result.Unverified, signature)
+				}
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
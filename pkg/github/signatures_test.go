@@ -0,0 +1,308 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSignedCommit returns a commit by octocat with the given signature verification.
+func mockSignedCommit(sha string, verified bool, reason, signature string) *github.RepositoryCommit {
+	verification := &github.SignatureVerification{
+		Verified: github.Ptr(verified),
+		Reason:   github.Ptr(reason),
+	}
+	if signature != "" {
+		verification.Signature = github.Ptr(signature)
+	}
+	return &github.RepositoryCommit{
+		SHA:       github.Ptr(sha),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/commit/" + sha),
+		Committer: &github.User{Login: github.Ptr("octocat")},
+		Commit: &github.Commit{
+			Message:      github.Ptr("Commit " + sha + "\n\nWith a body"),
+			Committer:    &github.CommitAuthor{Email: github.Ptr("octocat@github.com")},
+			Verification: verification,
+		},
+	}
+}
+
+func Test_signatureType(t *testing.T) {
+	assert.Equal(t, "", signatureType(""))
+	assert.Equal(t, "gpg", signatureType("-----BEGIN PGP SIGNATURE-----\n\niQIz...\n-----END PGP SIGNATURE-----"))
+	assert.Equal(t, "ssh", signatureType("-----BEGIN SSH SIGNATURE-----\nU1NIU0lH...\n-----END SSH SIGNATURE-----"))
+	assert.Equal(t, "x509", signatureType("-----BEGIN SIGNED MESSAGE-----\nMIAGCSqG...\n-----END SIGNED MESSAGE-----"))
+	assert.Equal(t, "unknown", signatureType("garbage"))
+}
+
+func Test_GetCommitSignatureVerification(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCommitSignatureVerification(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_commit_signature_verification", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult commitSignature
+		expectedErrMsg string
+	}{
+		{
+			name: "verified SSH signature",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockSignedCommit("abc123", true, "valid", "-----BEGIN SSH SIGNATURE-----\nU1NIU0lH\n-----END SSH SIGNATURE-----"),
+				),
+			),
+			expectedResult: commitSignature{
+				SHA:           "abc123",
+				Verified:      true,
+				Reason:        "valid",
+				SignatureType: "ssh",
+				Signer:        "octocat",
+				SignerEmail:   "octocat@github.com",
+				Message:       "Commit abc123",
+				HTMLURL:       "https://github.com/owner/repo/commit/abc123",
+			},
+		},
+		{
+			name: "unsigned commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockSignedCommit("abc123", false, "unsigned", ""),
+				),
+			),
+			expectedResult: commitSignature{
+				SHA:         "abc123",
+				Verified:    false,
+				Reason:      "unsigned",
+				Signer:      "octocat",
+				SignerEmail: "octocat@github.com",
+				Message:     "Commit abc123",
+				HTMLURL:     "https://github.com/owner/repo/commit/abc123",
+			},
+		},
+		{
+			name: "commit not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "No commit found for SHA: abc123"}),
+				),
+			),
+			expectedErrMsg: "commit abc123 not found in owner/repo",
+		},
+		{
+			name: "get commit fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Internal Server Error"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get commit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCommitSignatureVerification(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+			}))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned commitSignature
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListUnverifiedCommits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListUnverifiedCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_unverified_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockCommits := []*github.RepositoryCommit{
+		mockSignedCommit("aaa111", true, "valid", "-----BEGIN PGP SIGNATURE-----\niQIz\n-----END PGP SIGNATURE-----"),
+		mockSignedCommit("bbb222", false, "unsigned", ""),
+		mockSignedCommit("ccc333", false, "unknown_key", "-----BEGIN PGP SIGNATURE-----\niQIz\n-----END PGP SIGNATURE-----"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult unverifiedCommits
+		expectedErrMsg string
+	}{
+		{
+			name: "flags unsigned and unverified commits",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"sha": "main", "page": "1", "per_page": "3"}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"sha":     "main",
+				"perPage": float64(3),
+			},
+			expectedResult: unverifiedCommits{
+				Checked: 3,
+				Unverified: []commitSignature{
+					{
+						SHA:         "bbb222",
+						Reason:      "unsigned",
+						Signer:      "octocat",
+						SignerEmail: "octocat@github.com",
+						Message:     "Commit bbb222",
+						HTMLURL:     "https://github.com/owner/repo/commit/bbb222",
+					},
+					{
+						SHA:           "ccc333",
+						Reason:        "unknown_key",
+						SignatureType: "gpg",
+						Signer:        "octocat",
+						SignerEmail:   "octocat@github.com",
+						Message:       "Commit ccc333",
+						HTMLURL:       "https://github.com/owner/repo/commit/ccc333",
+					},
+				},
+			},
+		},
+		{
+			name: "all commits verified",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockCommits[:1],
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: unverifiedCommits{
+				Checked:    1,
+				Unverified: []commitSignature{},
+			},
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "missing",
+			},
+			expectedErrMsg: "ref missing not found in owner/repo",
+		},
+		{
+			name: "list commits fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Internal Server Error"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListUnverifiedCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned unverifiedCommits
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultActivityWeeks is how many of the most recent weeks of activity are returned per contributor
+// unless the caller asks for more. The statistics cover the whole history of the repository, which
+// is far more than is useful for spotting trends.
+const defaultActivityWeeks = 12
+
+// contributorActivity is a contributor's commit activity in a repository. TotalCommits covers the
+// whole history of the repository, Weeks only the requested number of most recent weeks.
+type contributorActivity struct {
+	Login          string            `json:"login"`
+	TotalCommits   int               `json:"total_commits"`
+	LastActiveWeek *github.Timestamp `json:"last_active_week,omitempty"`
+	Weeks          []weeklyActivity  `json:"weeks"`
+}
+
+type weeklyActivity struct {
+	Week      github.Timestamp `json:"week"`
+	Commits   int              `json:"commits"`
+	Additions int              `json:"additions"`
+	Deletions int              `json:"deletions"`
+}
+
+func newContributorActivity(s *github.ContributorStats, weeks int) contributorActivity {
+	a := contributorActivity{
+		Login:        s.GetAuthor().GetLogin(),
+		TotalCommits: s.GetTotal(),
+		Weeks:        []weeklyActivity{},
+	}
+	for _, w := range s.Weeks {
+		if w.GetCommits() > 0 {
+			a.LastActiveWeek = w.Week
+		}
+	}
+	recent := s.Weeks
+	if len(recent) > weeks {
+		recent = recent[len(recent)-weeks:]
+	}
+	for _, w := range recent {
+		a.Weeks = append(a.Weeks, weeklyActivity{
+			Week:      w.GetWeek(),
+			Commits:   w.GetCommits(),
+			Additions: w.GetAdditions(),
+			Deletions: w.GetDeletions(),
+		})
+	}
+	return a
+}
+
+// GetContributorActivity creates a tool to get the weekly commit activity of a repository's contributors.
+func GetContributorActivity(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_contributor_activity",
+			mcp.WithDescription(t("TOOL_GET_CONTRIBUTOR_ACTIVITY_DESCRIPTION", "Get the weekly commits, additions and deletions of the top contributors to a repository, most active first, to tell active contributors from dormant ones")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("weeks",
+				mcp.Description(fmt.Sprintf("Number of most recent weeks of activity to return per contributor (default: %d)", defaultActivityWeeks)),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			weeks, err := OptionalIntParamWithDefault(request, "weeks", defaultActivityWeeks)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if weeks < 1 {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid weeks %d, must be at least 1", weeks)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			stats, resp, err := client.Repositories.ListContributorsStats(ctx, owner, repo)
+			if err != nil {
+				// GitHub computes the statistics in the background the first time they are requested
+				// and answers 202 until they are ready.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText(fmt.Sprintf("GitHub is still computing the contributor statistics of %s/%s, try again in a few seconds", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get contributor activity: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get contributor activity: %s", string(body))), nil
+			}
+
+			result := make([]contributorActivity, 0, len(stats))
+			for _, s := range stats {
+				result = append(result, newContributorActivity(s, weeks))
+			}
+			// GitHub lists the least active contributors first.
+			sort.SliceStable(result, func(i, j int) bool {
+				return result[i].TotalCommits > result[j].TotalCommits
+			})
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
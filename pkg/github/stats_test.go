@@ -0,0 +1,216 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetContributorActivity(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetContributorActivity(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_contributor_activity", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "weeks")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	week := func(n int) *github.Timestamp {
+		return &github.Timestamp{Time: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 7*n)}
+	}
+	mockStats := []*github.ContributorStats{
+		{
+			Author: &github.Contributor{Login: github.Ptr("dormant")},
+			Total:  github.Ptr(3),
+			Weeks: []*github.WeeklyStats{
+				{Week: week(0), Commits: github.Ptr(3), Additions: github.Ptr(30), Deletions: github.Ptr(3)},
+				{Week: week(1), Commits: github.Ptr(0), Additions: github.Ptr(0), Deletions: github.Ptr(0)},
+				{Week: week(2), Commits: github.Ptr(0), Additions: github.Ptr(0), Deletions: github.Ptr(0)},
+			},
+		},
+		{
+			Author: &github.Contributor{Login: github.Ptr("active")},
+			Total:  github.Ptr(9),
+			Weeks: []*github.WeeklyStats{
+				{Week: week(0), Commits: github.Ptr(1), Additions: github.Ptr(10), Deletions: github.Ptr(1)},
+				{Week: week(1), Commits: github.Ptr(3), Additions: github.Ptr(40), Deletions: github.Ptr(5)},
+				{Week: week(2), Commits: github.Ptr(5), Additions: github.Ptr(120), Deletions: github.Ptr(20)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedActivity []contributorActivity
+		expectedText     string
+		expectedErrMsg   string
+	}{
+		{
+			name: "most active contributors first",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposStatsContributorsByOwnerByRepo,
+					mockStats,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedActivity: []contributorActivity{
+				{
+					Login:          "active",
+					TotalCommits:   9,
+					LastActiveWeek: week(2),
+					Weeks: []weeklyActivity{
+						{Week: *week(0), Commits: 1, Additions: 10, Deletions: 1},
+						{Week: *week(1), Commits: 3, Additions: 40, Deletions: 5},
+						{Week: *week(2), Commits: 5, Additions: 120, Deletions: 20},
+					},
+				},
+				{
+					Login:          "dormant",
+					TotalCommits:   3,
+					LastActiveWeek: week(0),
+					Weeks: []weeklyActivity{
+						{Week: *week(0), Commits: 3, Additions: 30, Deletions: 3},
+						{Week: *week(1)},
+						{Week: *week(2)},
+					},
+				},
+			},
+		},
+		{
+			name: "only the most recent weeks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposStatsContributorsByOwnerByRepo,
+					mockStats[:1],
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"weeks": float64(2),
+			},
+			expectError: false,
+			expectedActivity: []contributorActivity{
+				{
+					Login:          "dormant",
+					TotalCommits:   3,
+					LastActiveWeek: week(0),
+					Weeks: []weeklyActivity{
+						{Week: *week(1)},
+						{Week: *week(2)},
+					},
+				},
+			},
+		},
+		{
+			name: "statistics still being computed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStatsContributorsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusAccepted)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:  false,
+			expectedText: "GitHub is still computing the contributor statistics of owner/repo, try again in a few seconds",
+		},
+		{
+			name:         "invalid weeks",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"weeks": float64(-1),
+			},
+			expectError:  false,
+			expectedText: "invalid weeks -1, must be at least 1",
+		},
+		{
+			name: "get contributor activity fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStatsContributorsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get contributor activity",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetContributorActivity(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedActivity []contributorActivity
+			err = json.Unmarshal([]byte(textContent.Text), &returnedActivity)
+			require.NoError(t, err)
+			require.Len(t, returnedActivity, len(tc.expectedActivity))
+			for i, expected := range tc.expectedActivity {
+				assert.Equal(t, expected.Login, returnedActivity[i].Login)
+				assert.Equal(t, expected.TotalCommits, returnedActivity[i].TotalCommits)
+				require.NotNil(t, returnedActivity[i].LastActiveWeek)
+				assert.True(t, expected.LastActiveWeek.Equal(*returnedActivity[i].LastActiveWeek))
+				require.Len(t, returnedActivity[i].Weeks, len(expected.Weeks))
+				for j, w := range expected.Weeks {
+					assert.True(t, w.Week.Equal(returnedActivity[i].Weeks[j].Week))
+					assert.Equal(t, w.Commits, returnedActivity[i].Weeks[j].Commits)
+					assert.Equal(t, w.Additions, returnedActivity[i].Weeks[j].Additions)
+					assert.Equal(t, w.Deletions, returnedActivity[i].Weeks[j].Deletions)
+				}
+			}
+		})
+	}
+}
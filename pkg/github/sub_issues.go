@@ -0,0 +1,362 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxSubIssues is the most sub-issues GitHub allows an issue to have, so a single page of that
+// size always holds all of them.
+const maxSubIssues = 100
+
+// subIssue is an issue in the sub-issue list of a parent issue. Sub-issues are added, removed
+// and reordered by ID, not by number, and can belong to another repository than their parent.
+type subIssue struct {
+	ID         int64  `json:"id"`
+	Number     int    `json:"number"`
+	Repository string `json:"repository"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	HTMLURL    string `json:"html_url"`
+}
+
+func newSubIssue(i *github.Issue) subIssue {
+	_, repository, _ := strings.Cut(i.GetRepositoryURL(), "/repos/")
+	return subIssue{
+		ID:         i.GetID(),
+		Number:     i.GetNumber(),
+		Repository: repository,
+		Title:      i.GetTitle(),
+		State:      i.GetState(),
+		HTMLURL:    i.GetHTMLURL(),
+	}
+}
+
+// subIssueList is the sub-issue list of a parent issue, in order.
+type subIssueList struct {
+	Parent    int        `json:"parent"`
+	SubIssues []subIssue `json:"sub_issues"`
+}
+
+// subIssuesURL returns the URL of the sub-issue endpoints of an issue, followed by suffix.
+func subIssuesURL(owner, repo string, number int, suffix string) string {
+	return fmt.Sprintf("repos/%s/%s/issues/%d/%s", url.PathEscape(owner), url.PathEscape(repo), number, suffix)
+}
+
+// listSubIssues gets a page of the sub-issues of an issue.
+func listSubIssues(ctx context.Context, client *github.Client, owner, repo string, number, page, perPage int) (*subIssueList, *github.Response, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("per_page", strconv.Itoa(perPage))
+
+	// go-github has no method for this endpoint
+	req, err := client.NewRequest(http.MethodGet, subIssuesURL(owner, repo, number, "sub_issues")+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var issues []*github.Issue
+	resp, err := client.Do(ctx, req, &issues)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result := &subIssueList{Parent: number, SubIssues: make([]subIssue, 0, len(issues))}
+	for _, i := range issues {
+		result.SubIssues = append(result.SubIssues, newSubIssue(i))
+	}
+	return result, resp, nil
+}
+
+// changeSubIssues sends a request changing the sub-issue subIssueID of an issue, then returns
+// its updated sub-issue list. Errors GitHub explains, such as a sub-issue that already has a
+// parent, are returned as tool errors.
+func changeSubIssues(ctx context.Context, client *github.Client, method, owner, repo string, number int, suffix string, subIssueID int, body map[string]any) (*mcp.CallToolResult, error) {
+	body["sub_issue_id"] = subIssueID
+
+	// go-github has no method for this endpoint
+	req, err := client.NewRequest(method, subIssuesURL(owner, repo, number, suffix), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.Do(ctx, req, nil)
+	if err != nil {
+		if resp != nil {
+			switch resp.StatusCode {
+			case http.StatusNotFound:
+				return mcp.NewToolResultError(fmt.Sprintf("issue #%d of %s/%s or sub-issue %d not found", number, owner, repo, subIssueID)), nil
+			case http.StatusUnprocessableEntity:
+				var errResp *github.ErrorResponse
+				if errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to update the sub-issues of #%d: %s", number, errResp.Message)), nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("failed to update sub-issues: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	result, resp, err := listSubIssues(ctx, client, owner, repo, number, 1, maxSubIssues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sub-issues: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// ListSubIssues creates a tool to list the sub-issues of an issue.
+func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_sub_issues",
+			mcp.WithDescription(t("TOOL_LIST_SUB_ISSUES_DESCRIPTION", "List the sub-issues of a GitHub issue in their order, with the ID that remove_sub_issue and reprioritize_sub_issue take")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := listSubIssues(ctx, client, owner, repo, issueNumber, pagination.page, pagination.perPage)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("issue #%d not found in %s/%s", issueNumber, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list sub-issues: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddSubIssue creates a tool to add an issue to the sub-issues of another.
+func AddSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_sub_issue",
+			mcp.WithDescription(t("TOOL_ADD_SUB_ISSUE_DESCRIPTION", "Add an issue to the end of the sub-issues of a GitHub issue, and return the updated sub-issue list. The sub-issue is identified by its ID, not its number, and can belong to another repository of the same owner")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the issue to add as a sub-issue, as returned by get_issue (not its number)"),
+			),
+			mcp.WithBoolean("replace_parent",
+				mcp.Description("Move the issue here if it is already a sub-issue of another issue"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subIssueID, err := RequiredInt(request, "sub_issue_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			replaceParent, err := OptionalParam[bool](request, "replace_parent")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			body := map[string]any{}
+			if replaceParent {
+				body["replace_parent"] = true
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return changeSubIssues(ctx, client, http.MethodPost, owner, repo, issueNumber, "sub_issues", subIssueID, body)
+		}
+}
+
+// RemoveSubIssue creates a tool to remove an issue from the sub-issues of another.
+func RemoveSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_sub_issue",
+			mcp.WithDescription(t("TOOL_REMOVE_SUB_ISSUE_DESCRIPTION", "Remove an issue from the sub-issues of a GitHub issue, and return the updated sub-issue list. The issue itself is not closed or deleted")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the sub-issue to remove, as returned by list_sub_issues (not its number)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subIssueID, err := RequiredInt(request, "sub_issue_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// Removal is the only sub-issue endpoint with a singular path
+			return changeSubIssues(ctx, client, http.MethodDelete, owner, repo, issueNumber, "sub_issue", subIssueID, map[string]any{})
+		}
+}
+
+// ReprioritizeSubIssue creates a tool to move a sub-issue within the sub-issue list of its parent.
+func ReprioritizeSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("reprioritize_sub_issue",
+			mcp.WithDescription(t("TOOL_REPRIORITIZE_SUB_ISSUE_DESCRIPTION", "Move a sub-issue of a GitHub issue right after or right before another of its sub-issues, and return the new order. Sub-issues are identified by their IDs, as returned by list_sub_issues")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the sub-issue to move"),
+			),
+			mcp.WithNumber("after_id",
+				mcp.Description("ID of the sub-issue to move it after. Set exactly one of after_id and before_id"),
+			),
+			mcp.WithNumber("before_id",
+				mcp.Description("ID of the sub-issue to move it before. Set exactly one of after_id and before_id"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subIssueID, err := RequiredInt(request, "sub_issue_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			afterID, err := OptionalIntParam(request, "after_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			beforeID, err := OptionalIntParam(request, "before_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			body := map[string]any{}
+			switch {
+			case afterID != 0 && beforeID != 0:
+				return mcp.NewToolResultError("after_id and before_id cannot both be set"), nil
+			case afterID != 0:
+				body["after_id"] = afterID
+			case beforeID != 0:
+				body["before_id"] = beforeID
+			default:
+				return mcp.NewToolResultError("one of after_id or before_id is required"), nil
+			}
+			if afterID == subIssueID || beforeID == subIssueID {
+				return mcp.NewToolResultError("a sub-issue cannot be moved relative to itself"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return changeSubIssues(ctx, client, http.MethodPatch, owner, repo, issueNumber, "sub_issues/priority", subIssueID, body)
+		}
+}
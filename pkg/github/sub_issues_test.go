@@ -0,0 +1,622 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// go-github-mock has no patterns for the sub-issue endpoints
+var (
+	getSubIssues = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues",
+		Method:  "GET",
+	}
+	postSubIssues = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues",
+		Method:  "POST",
+	}
+	deleteSubIssue = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issue",
+		Method:  "DELETE",
+	}
+	patchSubIssuesPriority = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues/priority",
+		Method:  "PATCH",
+	}
+)
+
+// mockSubIssue returns an open issue of owner/repo to use as a sub-issue.
+func mockSubIssue(id int64, number int, title string) *github.Issue {
+	return &github.Issue{
+		ID:            github.Ptr(id),
+		Number:        github.Ptr(number),
+		Title:         github.Ptr(title),
+		State:         github.Ptr("open"),
+		RepositoryURL: github.Ptr("https://api.github.com/repos/owner/repo"),
+		HTMLURL:       github.Ptr(fmt.Sprintf("https://github.com/owner/repo/issues/%d", number)),
+	}
+}
+
+func Test_ListSubIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListSubIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_sub_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult subIssueList
+		expectedErrMsg string
+	}{
+		{
+			name: "list sub-issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getSubIssues,
+					expectQueryParams(t, map[string]string{"page": "1", "per_page": "30"}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Issue{
+							mockSubIssue(1001, 43, "Design"),
+							{
+								ID:            github.Ptr(int64(2002)),
+								Number:        github.Ptr(7),
+								Title:         github.Ptr("Docs"),
+								State:         github.Ptr("closed"),
+								RepositoryURL: github.Ptr("https://api.github.com/repos/owner/docs"),
+								HTMLURL:       github.Ptr("https://github.com/owner/docs/issues/7"),
+							},
+						}),
+					),
+				),
+			),
+			expectedResult: subIssueList{
+				Parent: 42,
+				SubIssues: []subIssue{
+					{ID: 1001, Number: 43, Repository: "owner/repo", Title: "Design", State: "open", HTMLURL: "https://github.com/owner/repo/issues/43"},
+					{ID: 2002, Number: 7, Repository: "owner/docs", Title: "Docs", State: "closed", HTMLURL: "https://github.com/owner/docs/issues/7"},
+				},
+			},
+		},
+		{
+			name: "no sub-issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					getSubIssues,
+					[]*github.Issue{},
+				),
+			),
+			expectedResult: subIssueList{
+				Parent:    42,
+				SubIssues: []subIssue{},
+			},
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getSubIssues,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectedErrMsg: "issue #42 not found in owner/repo",
+		},
+		{
+			name: "list sub-issues fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getSubIssues,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Internal Server Error"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list sub-issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListSubIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			}))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned subIssueList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_AddSubIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddSubIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_sub_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "sub_issue_id")
+	assert.Contains(t, tool.InputSchema.Properties, "replace_parent")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "sub_issue_id"})
+
+	parent := &github.Issue{Number: github.Ptr(42)}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult subIssueList
+		expectedErrMsg string
+	}{
+		{
+			name: "add sub-issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					expectRequestBody(t, map[string]interface{}{
+						"sub_issue_id": float64(1002),
+					}).andThen(
+						mockResponse(t, http.StatusCreated, parent),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					getSubIssues,
+					expectQueryParams(t, map[string]string{"page": "1", "per_page": "100"}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Issue{
+							mockSubIssue(1001, 43, "Design"),
+							mockSubIssue(1002, 44, "Build"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+			},
+			expectedResult: subIssueList{
+				Parent: 42,
+				SubIssues: []subIssue{
+					{ID: 1001, Number: 43, Repository: "owner/repo", Title: "Design", State: "open", HTMLURL: "https://github.com/owner/repo/issues/43"},
+					{ID: 1002, Number: 44, Repository: "owner/repo", Title: "Build", State: "open", HTMLURL: "https://github.com/owner/repo/issues/44"},
+				},
+			},
+		},
+		{
+			name: "move sub-issue from another parent",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					expectRequestBody(t, map[string]interface{}{
+						"sub_issue_id":   float64(1002),
+						"replace_parent": true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, parent),
+					),
+				),
+				mock.WithRequestMatch(
+					getSubIssues,
+					[]*github.Issue{mockSubIssue(1002, 44, "Build")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"issue_number":   float64(42),
+				"sub_issue_id":   float64(1002),
+				"replace_parent": true,
+			},
+			expectedResult: subIssueList{
+				Parent: 42,
+				SubIssues: []subIssue{
+					{ID: 1002, Number: 44, Repository: "owner/repo", Title: "Build", State: "open", HTMLURL: "https://github.com/owner/repo/issues/44"},
+				},
+			},
+		},
+		{
+			name: "sub-issue already has a parent",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Issue may not contain duplicate sub-issues and Sub issue may only have one parent"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+			},
+			expectedErrMsg: "failed to update the sub-issues of #42: Issue may not contain duplicate sub-issues and Sub issue may only have one parent",
+		},
+		{
+			name: "parent not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+				"sub_issue_id": float64(1002),
+			},
+			expectedErrMsg: "issue #999 of owner/repo or sub-issue 1002 not found",
+		},
+		{
+			name: "add sub-issue fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Internal Server Error"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update sub-issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned subIssueList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_RemoveSubIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveSubIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_sub_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "sub_issue_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "sub_issue_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedResult subIssueList
+		expectedErrMsg string
+	}{
+		{
+			name: "remove sub-issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					deleteSubIssue,
+					expectRequestBody(t, map[string]interface{}{
+						"sub_issue_id": float64(1002),
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{Number: github.Ptr(42)}),
+					),
+				),
+				mock.WithRequestMatch(
+					getSubIssues,
+					[]*github.Issue{mockSubIssue(1001, 43, "Design")},
+				),
+			),
+			expectedResult: subIssueList{
+				Parent: 42,
+				SubIssues: []subIssue{
+					{ID: 1001, Number: 43, Repository: "owner/repo", Title: "Design", State: "open", HTMLURL: "https://github.com/owner/repo/issues/43"},
+				},
+			},
+		},
+		{
+			name: "not a sub-issue of the parent",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					deleteSubIssue,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectedErrMsg: "issue #42 of owner/repo or sub-issue 1002 not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+			}))
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned subIssueList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ReprioritizeSubIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReprioritizeSubIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "reprioritize_sub_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "sub_issue_id")
+	assert.Contains(t, tool.InputSchema.Properties, "after_id")
+	assert.Contains(t, tool.InputSchema.Properties, "before_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "sub_issue_id"})
+
+	reordered := []*github.Issue{
+		mockSubIssue(1002, 44, "Build"),
+		mockSubIssue(1001, 43, "Design"),
+	}
+	expectedOrder := subIssueList{
+		Parent: 42,
+		SubIssues: []subIssue{
+			{ID: 1002, Number: 44, Repository: "owner/repo", Title: "Build", State: "open", HTMLURL: "https://github.com/owner/repo/issues/44"},
+			{ID: 1001, Number: 43, Repository: "owner/repo", Title: "Design", State: "open", HTMLURL: "https://github.com/owner/repo/issues/43"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult subIssueList
+		expectedErrMsg string
+	}{
+		{
+			name: "move before another sub-issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					patchSubIssuesPriority,
+					expectRequestBody(t, map[string]interface{}{
+						"sub_issue_id": float64(1002),
+						"before_id":    float64(1001),
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{Number: github.Ptr(42)}),
+					),
+				),
+				mock.WithRequestMatch(
+					getSubIssues,
+					reordered,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+				"before_id":    float64(1001),
+			},
+			expectedResult: expectedOrder,
+		},
+		{
+			name: "move after another sub-issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					patchSubIssuesPriority,
+					expectRequestBody(t, map[string]interface{}{
+						"sub_issue_id": float64(1001),
+						"after_id":     float64(1002),
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{Number: github.Ptr(42)}),
+					),
+				),
+				mock.WithRequestMatch(
+					getSubIssues,
+					reordered,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1001),
+				"after_id":     float64(1002),
+			},
+			expectedResult: expectedOrder,
+		},
+		{
+			name:         "neither after_id nor before_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+			},
+			expectedErrMsg: "one of after_id or before_id is required",
+		},
+		{
+			name:         "both after_id and before_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+				"after_id":     float64(1001),
+				"before_id":    float64(1003),
+			},
+			expectedErrMsg: "after_id and before_id cannot both be set",
+		},
+		{
+			name:         "relative to itself",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+				"after_id":     float64(1002),
+			},
+			expectedErrMsg: "a sub-issue cannot be moved relative to itself",
+		},
+		{
+			name: "positioning sub-issue is not a sub-issue of the parent",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					patchSubIssuesPriority,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Positional sub-issue not found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+				"after_id":     float64(9999),
+			},
+			expectedErrMsg: "failed to update the sub-issues of #42: Positional sub-issue not found",
+		},
+		{
+			name: "reprioritize fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					patchSubIssuesPriority,
+					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "Internal Server Error"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1002),
+				"after_id":     float64(1001),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update sub-issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReprioritizeSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned subIssueList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
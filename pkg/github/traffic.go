@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// trafficBreakdowns are the periods repository traffic can be broken down by.
+var trafficBreakdowns = []string{"day", "week"}
+
+// withTrafficParams returns a ToolOption that adds the parameters shared by the traffic tools.
+func withTrafficParams() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("Repository owner"),
+		)(tool)
+		mcp.WithString("repo",
+			mcp.Required(),
+			mcp.Description("Repository name"),
+		)(tool)
+		mcp.WithString("per",
+			mcp.Description("Break the counts down per day or per week (default: day)"),
+			mcp.Enum(trafficBreakdowns...),
+		)(tool)
+	}
+}
+
+// trafficParams returns the owner, repo and breakdown options of a traffic tool request.
+func trafficParams(request mcp.CallToolRequest) (string, string, *github.TrafficBreakdownOptions, error) {
+	owner, err := requiredParam[string](request, "owner")
+	if err != nil {
+		return "", "", nil, err
+	}
+	repo, err := requiredParam[string](request, "repo")
+	if err != nil {
+		return "", "", nil, err
+	}
+	per, err := OptionalParam[string](request, "per")
+	if err != nil {
+		return "", "", nil, err
+	}
+	if per != "" && !slices.Contains(trafficBreakdowns, per) {
+		return "", "", nil, fmt.Errorf("invalid per %q, must be one of: %s", per, strings.Join(trafficBreakdowns, ", "))
+	}
+	return owner, repo, &github.TrafficBreakdownOptions{Per: per}, nil
+}
+
+// GetRepositoryTrafficViews creates a tool to get the page views of a repository over the last 14 days.
+func GetRepositoryTrafficViews(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_traffic_views",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TRAFFIC_VIEWS_DESCRIPTION", "Get the total and unique page views of a repository over the last 14 days, with a per-day or per-week breakdown. Requires push access to the repository")),
+			withTrafficParams(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, opts, err := trafficParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			views, resp, err := client.Repositories.ListTrafficViews(ctx, owner, repo, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get traffic views: viewing traffic of %s/%s requires push access to the repository", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get traffic views: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get traffic views: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(views)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRepositoryTrafficClones creates a tool to get the clones of a repository over the last 14 days.
+func GetRepositoryTrafficClones(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_traffic_clones",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TRAFFIC_CLONES_DESCRIPTION", "Get the total and unique clones of a repository over the last 14 days, with a per-day or per-week breakdown. Requires push access to the repository")),
+			withTrafficParams(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, repo, opts, err := trafficParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			clones, resp, err := client.Repositories.ListTrafficClones(ctx, owner, repo, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get traffic clones: viewing traffic of %s/%s requires push access to the repository", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get traffic clones: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get traffic clones: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(clones)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,256 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepositoryTrafficViews(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTrafficViews(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_traffic_views", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "per")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockViews := &github.TrafficViews{
+		Count:   github.Ptr(14),
+		Uniques: github.Ptr(4),
+		Views: []*github.TrafficData{
+			{Timestamp: &github.Timestamp{Time: time.Date(2025, 4, 7, 0, 0, 0, 0, time.UTC)}, Count: github.Ptr(10), Uniques: github.Ptr(3)},
+			{Timestamp: &github.Timestamp{Time: time.Date(2025, 4, 14, 0, 0, 0, 0, time.UTC)}, Count: github.Ptr(4), Uniques: github.Ptr(1)},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedViews  *github.TrafficViews
+		expectedErrMsg string
+	}{
+		{
+			name: "views per week",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"per": "week",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockViews),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"per":   "week",
+			},
+			expectError:   false,
+			expectedViews: mockViews,
+		},
+		{
+			name:         "invalid breakdown",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"per":   "month",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid per "month", must be one of: day, week`,
+		},
+		{
+			name: "insufficient permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "Must have push access to repository"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedErrMsg: "viewing traffic of owner/repo requires push access to the repository",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get traffic views",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTrafficViews(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedViews github.TrafficViews
+			err = json.Unmarshal([]byte(textContent.Text), &returnedViews)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedViews.Count, *returnedViews.Count)
+			assert.Equal(t, *tc.expectedViews.Uniques, *returnedViews.Uniques)
+			require.Len(t, returnedViews.Views, len(tc.expectedViews.Views))
+			for i, view := range returnedViews.Views {
+				assert.True(t, tc.expectedViews.Views[i].Timestamp.Equal(*view.Timestamp))
+				assert.Equal(t, *tc.expectedViews.Views[i].Count, *view.Count)
+				assert.Equal(t, *tc.expectedViews.Views[i].Uniques, *view.Uniques)
+			}
+		})
+	}
+}
+
+func Test_GetRepositoryTrafficClones(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTrafficClones(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_traffic_clones", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "per")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockClones := &github.TrafficClones{
+		Count:   github.Ptr(5),
+		Uniques: github.Ptr(2),
+		Clones: []*github.TrafficData{
+			{Timestamp: &github.Timestamp{Time: time.Date(2025, 4, 7, 0, 0, 0, 0, time.UTC)}, Count: github.Ptr(5), Uniques: github.Ptr(2)},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedClones *github.TrafficClones
+		expectedErrMsg string
+	}{
+		{
+			name: "clones per day",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficClonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"per": "day",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockClones),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"per":   "day",
+			},
+			expectError:    false,
+			expectedClones: mockClones,
+		},
+		{
+			name: "insufficient permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficClonesByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "Must have push access to repository"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedErrMsg: "viewing traffic of owner/repo requires push access to the repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTrafficClones(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedClones github.TrafficClones
+			err = json.Unmarshal([]byte(textContent.Text), &returnedClones)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedClones.Count, *returnedClones.Count)
+			assert.Equal(t, *tc.expectedClones.Uniques, *returnedClones.Uniques)
+			require.Len(t, returnedClones.Clones, len(tc.expectedClones.Clones))
+			assert.Equal(t, *tc.expectedClones.Clones[0].Count, *returnedClones.Clones[0].Count)
+		})
+	}
+}
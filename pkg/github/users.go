@@ -0,0 +1,229 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// userProfile is the subset of a GitHub user's public profile returned by GetUser.
+type userProfile struct {
+	Login       string            `json:"login"`
+	Name        string            `json:"name,omitempty"`
+	Bio         string            `json:"bio,omitempty"`
+	Company     string            `json:"company,omitempty"`
+	PublicRepos int               `json:"public_repos"`
+	Followers   int               `json:"followers"`
+	CreatedAt   *github.Timestamp `json:"created_at,omitempty"`
+	HTMLURL     string            `json:"html_url,omitempty"`
+}
+
+// GetUser creates a tool to get the public profile of an arbitrary GitHub user.
+func GetUser(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_user",
+			mcp.WithDescription(t("TOOL_GET_USER_DESCRIPTION", "Get the public profile of a GitHub user by username")),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			user, resp, err := client.Users.Get(ctx, username)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("user not found: %s", username)), nil
+				}
+				return nil, fmt.Errorf("failed to get user: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get user: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(userProfile{
+				Login:       user.GetLogin(),
+				Name:        user.GetName(),
+				Bio:         user.GetBio(),
+				Company:     user.GetCompany(),
+				PublicRepos: user.GetPublicRepos(),
+				Followers:   user.GetFollowers(),
+				CreatedAt:   user.CreatedAt,
+				HTMLURL:     user.GetHTMLURL(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal user: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListFollowers creates a tool to list the followers of a GitHub user.
+func ListFollowers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_followers",
+			mcp.WithDescription(t("TOOL_LIST_FOLLOWERS_DESCRIPTION", "List the logins of users following a GitHub user")),
+			mcp.WithString("username",
+				mcp.Description("GitHub username (defaults to the authenticated user)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			users, resp, err := client.Users.ListFollowers(ctx, username, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list followers: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list followers: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(userLogins(users))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListFollowing creates a tool to list the users a GitHub user follows.
+func ListFollowing(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_following",
+			mcp.WithDescription(t("TOOL_LIST_FOLLOWING_DESCRIPTION", "List the logins of users a GitHub user is following")),
+			mcp.WithString("username",
+				mcp.Description("GitHub username (defaults to the authenticated user)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			users, resp, err := client.Users.ListFollowing(ctx, username, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list following: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list following: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(userLogins(users))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// FollowUser creates a tool to follow a GitHub user as the authenticated user.
+func FollowUser(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("follow_user",
+			mcp.WithDescription(t("TOOL_FOLLOW_USER_DESCRIPTION", "Follow a GitHub user as the authenticated user")),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username to follow"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Users.Follow(ctx, username)
+			if err != nil {
+				return nil, fmt.Errorf("failed to follow user: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// GitHub responds with 204 whether or not the user was already followed.
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to follow user: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Following %s", username)), nil
+		}
+}
+
+// userLogins returns the logins of the given users.
+func userLogins(users []*github.User) []string {
+	logins := make([]string, 0, len(users))
+	for _, user := range users {
+		logins = append(logins, user.GetLogin())
+	}
+	return logins
+}
@@ -0,0 +1,440 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetUser(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetUser(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"username"})
+
+	// Setup mock user for success case
+	createdAt := time.Date(2011, 1, 25, 18, 44, 36, 0, time.UTC)
+	mockUser := &github.User{
+		Login:       github.Ptr("octocat"),
+		Name:        github.Ptr("The Octocat"),
+		Bio:         github.Ptr("Mascot"),
+		Company:     github.Ptr("@github"),
+		PublicRepos: github.Ptr(8),
+		Followers:   github.Ptr(9999),
+		CreatedAt:   &github.Timestamp{Time: createdAt},
+		HTMLURL:     github.Ptr("https://github.com/octocat"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedUser   userProfile
+		expectedErrMsg string
+	}{
+		{
+			name: "successful user retrieval",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersByUsername,
+					mockUser,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectError: false,
+			expectedUser: userProfile{
+				Login:       "octocat",
+				Name:        "The Octocat",
+				Bio:         "Mascot",
+				Company:     "@github",
+				PublicRepos: 8,
+				Followers:   9999,
+				CreatedAt:   &github.Timestamp{Time: createdAt},
+				HTMLURL:     "https://github.com/octocat",
+			},
+		},
+		{
+			name: "user not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersByUsername,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "nobody",
+			},
+			expectError:    false,
+			expectedErrMsg: "user not found: nobody",
+		},
+		{
+			name: "user retrieval fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersByUsername,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get user",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedUser userProfile
+			err = json.Unmarshal([]byte(textContent.Text), &returnedUser)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedUser.Login, returnedUser.Login)
+			assert.Equal(t, tc.expectedUser.Name, returnedUser.Name)
+			assert.Equal(t, tc.expectedUser.Bio, returnedUser.Bio)
+			assert.Equal(t, tc.expectedUser.Company, returnedUser.Company)
+			assert.Equal(t, tc.expectedUser.PublicRepos, returnedUser.PublicRepos)
+			assert.Equal(t, tc.expectedUser.Followers, returnedUser.Followers)
+			assert.True(t, tc.expectedUser.CreatedAt.Equal(*returnedUser.CreatedAt))
+		})
+	}
+}
+
+func Test_ListFollowers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListFollowers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_followers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	mockUsers := []*github.User{
+		{Login: github.Ptr("alice")},
+		{Login: github.Ptr("bob")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedLogins []string
+		expectedErrMsg string
+	}{
+		{
+			name: "followers of a named user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersFollowersByUsername,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockUsers),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectError:    false,
+			expectedLogins: []string{"alice", "bob"},
+		},
+		{
+			name: "followers of the authenticated user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUserFollowers,
+					mockUsers,
+				),
+			),
+			requestArgs:    map[string]interface{}{},
+			expectError:    false,
+			expectedLogins: []string{"alice", "bob"},
+		},
+		{
+			name: "listing followers fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersFollowersByUsername,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "nobody",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list followers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListFollowers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var returnedLogins []string
+			err = json.Unmarshal([]byte(textContent.Text), &returnedLogins)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLogins, returnedLogins)
+		})
+	}
+}
+
+func Test_ListFollowing(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListFollowing(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_following", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	mockUsers := []*github.User{
+		{Login: github.Ptr("carol")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedLogins []string
+		expectedErrMsg string
+	}{
+		{
+			name: "users followed by a named user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersFollowingByUsername,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "5",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockUsers),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+				"page":     float64(2),
+				"perPage":  float64(5),
+			},
+			expectError:    false,
+			expectedLogins: []string{"carol"},
+		},
+		{
+			name: "users followed by the authenticated user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUserFollowing,
+					mockUsers,
+				),
+			),
+			requestArgs:    map[string]interface{}{},
+			expectError:    false,
+			expectedLogins: []string{"carol"},
+		},
+		{
+			name: "listing following fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersFollowingByUsername,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "nobody",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list following",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListFollowing(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var returnedLogins []string
+			err = json.Unmarshal([]byte(textContent.Text), &returnedLogins)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLogins, returnedLogins)
+		})
+	}
+}
+
+func Test_FollowUser(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := FollowUser(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "follow_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "follow a user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutUserFollowingByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectError:  false,
+			expectedText: "Following octocat",
+		},
+		{
+			// GitHub responds with 204 for users that are already followed
+			name: "follow an already followed user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutUserFollowingByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+			},
+			expectError:  false,
+			expectedText: "Following octocat",
+		},
+		{
+			name: "follow fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutUserFollowingByUsername,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "nobody",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to follow user",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := FollowUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
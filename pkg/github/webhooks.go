@@ -0,0 +1,239 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// webhookContentTypes are the media types a webhook can deliver its payloads as.
+var webhookContentTypes = []string{"json", "form"}
+
+// webhook is the subset of a repository webhook returned by the webhook tools.
+type webhook struct {
+	ID        int64            `json:"id"`
+	Name      string           `json:"name"`
+	Active    bool             `json:"active"`
+	Events    []string         `json:"events"`
+	Config    webhookConfig    `json:"config"`
+	CreatedAt github.Timestamp `json:"created_at"`
+}
+
+type webhookConfig struct {
+	URL         string `json:"url"`
+	ContentType string `json:"content_type,omitempty"`
+	InsecureSSL string `json:"insecure_ssl,omitempty"`
+	Secret      string `json:"secret,omitempty"`
+}
+
+func newWebhook(h *github.Hook) webhook {
+	w := webhook{
+		ID:        h.GetID(),
+		Name:      h.GetName(),
+		Active:    h.GetActive(),
+		Events:    h.Events,
+		CreatedAt: h.GetCreatedAt(),
+	}
+	if config := h.GetConfig(); config != nil {
+		w.Config = webhookConfig{
+			URL:         config.GetURL(),
+			ContentType: config.GetContentType(),
+			InsecureSSL: config.GetInsecureSSL(),
+		}
+		if config.GetSecret() != "" {
+			w.Config.Secret = redactedSecret
+		}
+	}
+	if w.Events == nil {
+		w.Events = []string{}
+	}
+	return w
+}
+
+// ListWebhooks creates a tool to list the webhooks of a repository.
+func ListWebhooks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_webhooks",
+			mcp.WithDescription(t("TOOL_LIST_WEBHOOKS_DESCRIPTION", "List the webhooks of a repository, with their events and delivery configuration. Requires admin access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			hooks, resp, err := client.Repositories.ListHooks(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list webhooks: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list webhooks: %s", string(body))), nil
+			}
+
+			result := make([]webhook, 0, len(hooks))
+			for _, h := range hooks {
+				result = append(result, newWebhook(h))
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateWebhook creates a tool to add a webhook to a repository.
+func CreateWebhook(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_webhook",
+			mcp.WithDescription(t("TOOL_CREATE_WEBHOOK_DESCRIPTION", "Create a webhook delivering repository events to an HTTPS URL. Requires admin access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("url",
+				mcp.Required(),
+				mcp.Description("HTTPS URL the payloads are delivered to"),
+			),
+			mcp.WithString("content_type",
+				mcp.Description("Media type of the payloads (default: json)"),
+				mcp.Enum(webhookContentTypes...),
+			),
+			mcp.WithArray("events",
+				mcp.Description("Events that trigger the webhook, e.g. push, pull_request, or * for all events (default: push)"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("secret",
+				mcp.Description("Secret used to sign the payloads"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookURL, err := requiredParam[string](request, "url")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			contentType, err := OptionalParam[string](request, "content_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			events, err := OptionalStringArrayParam(request, "events")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			secret, err := OptionalParam[string](request, "secret")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if u, err := url.Parse(hookURL); err != nil || u.Scheme != "https" || u.Host == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid url %q, must be an https URL", hookURL)), nil
+			}
+			if contentType == "" {
+				contentType = "json"
+			}
+			if !slices.Contains(webhookContentTypes, contentType) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid content_type %q, must be one of: %s", contentType, strings.Join(webhookContentTypes, ", "))), nil
+			}
+			if len(events) == 0 {
+				events = []string{"push"}
+			}
+
+			hook := &github.Hook{
+				Config: &github.HookConfig{
+					URL:         github.Ptr(hookURL),
+					ContentType: github.Ptr(contentType),
+				},
+				Events: events,
+				Active: github.Ptr(true),
+			}
+			if secret != "" {
+				hook.Config.Secret = github.Ptr(secret)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			created, resp, err := client.Repositories.CreateHook(ctx, owner, repo, hook)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to create webhook: %s", err.Error())), nil
+				}
+				return nil, fmt.Errorf("failed to create webhook: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create webhook: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newWebhook(created))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,346 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListWebhooks(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListWebhooks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_webhooks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockHooks := []*github.Hook{
+		{
+			ID:        github.Ptr(int64(1)),
+			Name:      github.Ptr("web"),
+			Active:    github.Ptr(true),
+			Events:    []string{"push", "pull_request"},
+			CreatedAt: &github.Timestamp{Time: createdAt},
+			Config: &github.HookConfig{
+				URL:         github.Ptr("https://ci.example.com/hook"),
+				ContentType: github.Ptr("json"),
+				InsecureSSL: github.Ptr("0"),
+				Secret:      github.Ptr("********"),
+			},
+		},
+		{
+			ID:        github.Ptr(int64(2)),
+			Name:      github.Ptr("web"),
+			Active:    github.Ptr(false),
+			Events:    []string{"*"},
+			CreatedAt: &github.Timestamp{Time: createdAt},
+			Config: &github.HookConfig{
+				URL:         github.Ptr("https://chat.example.com/hook"),
+				ContentType: github.Ptr("form"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedHooks  []webhook
+		expectedErrMsg string
+	}{
+		{
+			name: "list webhooks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockHooks),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedHooks: []webhook{
+				{
+					ID:        1,
+					Name:      "web",
+					Active:    true,
+					Events:    []string{"push", "pull_request"},
+					CreatedAt: github.Timestamp{Time: createdAt},
+					Config:    webhookConfig{URL: "https://ci.example.com/hook", ContentType: "json", InsecureSSL: "0", Secret: redactedSecret},
+				},
+				{
+					ID:        2,
+					Name:      "web",
+					Active:    false,
+					Events:    []string{"*"},
+					CreatedAt: github.Timestamp{Time: createdAt},
+					Config:    webhookConfig{URL: "https://chat.example.com/hook", ContentType: "form"},
+				},
+			},
+		},
+		{
+			name: "list webhooks fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list webhooks",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListWebhooks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedHooks []webhook
+			err = json.Unmarshal([]byte(textContent.Text), &returnedHooks)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedHooks, returnedHooks)
+		})
+	}
+}
+
+func Test_CreateWebhook(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateWebhook(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_webhook", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "url")
+	assert.Contains(t, tool.InputSchema.Properties, "content_type")
+	assert.Contains(t, tool.InputSchema.Properties, "events")
+	assert.Contains(t, tool.InputSchema.Properties, "secret")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "url"})
+
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockHook := &github.Hook{
+		ID:        github.Ptr(int64(12345)),
+		Name:      github.Ptr("web"),
+		Active:    github.Ptr(true),
+		Events:    []string{"push", "pull_request"},
+		CreatedAt: &github.Timestamp{Time: createdAt},
+		Config: &github.HookConfig{
+			URL:         github.Ptr("https://ci.example.com/hook"),
+			ContentType: github.Ptr("json"),
+			InsecureSSL: github.Ptr("0"),
+			// GitHub echoes back the secret in plain text for the creation response
+			Secret: github.Ptr("s3cret"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedHook   webhook
+		expectedErrMsg string
+	}{
+		{
+			name: "create webhook with secret",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"config": map[string]any{
+							"url":          "https://ci.example.com/hook",
+							"content_type": "json",
+							"secret":       "s3cret",
+						},
+						"events": []any{"push", "pull_request"},
+						"active": true,
+						"name":   "web",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockHook),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"url":    "https://ci.example.com/hook",
+				"events": []interface{}{"push", "pull_request"},
+				"secret": "s3cret",
+			},
+			expectError: false,
+			expectedHook: webhook{
+				ID:        12345,
+				Name:      "web",
+				Active:    true,
+				Events:    []string{"push", "pull_request"},
+				CreatedAt: github.Timestamp{Time: createdAt},
+				Config:    webhookConfig{URL: "https://ci.example.com/hook", ContentType: "json", InsecureSSL: "0", Secret: redactedSecret},
+			},
+		},
+		{
+			name: "create webhook with defaults",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"config": map[string]any{
+							"url":          "https://ci.example.com/hook",
+							"content_type": "json",
+						},
+						"events": []any{"push"},
+						"active": true,
+						"name":   "web",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Hook{
+							ID:     github.Ptr(int64(12346)),
+							Name:   github.Ptr("web"),
+							Active: github.Ptr(true),
+							Events: []string{"push"},
+							Config: &github.HookConfig{URL: github.Ptr("https://ci.example.com/hook"), ContentType: github.Ptr("json")},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"url":   "https://ci.example.com/hook",
+			},
+			expectError: false,
+			expectedHook: webhook{
+				ID:     12346,
+				Name:   "web",
+				Active: true,
+				Events: []string{"push"},
+				Config: webhookConfig{URL: "https://ci.example.com/hook", ContentType: "json"},
+			},
+		},
+		{
+			name:         "reject non-https url",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"url":   "http://ci.example.com/hook",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid url "http://ci.example.com/hook", must be an https URL`,
+		},
+		{
+			name:         "invalid content type",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"url":          "https://ci.example.com/hook",
+				"content_type": "xml",
+			},
+			expectError:    false,
+			expectedErrMsg: `invalid content_type "xml", must be one of: json, form`,
+		},
+		{
+			name: "hook already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]any{
+						"message": "Validation Failed",
+						"errors":  []map[string]any{{"resource": "Hook", "code": "custom", "message": "Hook already exists on this repository"}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"url":   "https://ci.example.com/hook",
+			},
+			expectError:    false,
+			expectedErrMsg: "Hook already exists on this repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateWebhook(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// The secret never appears in the output
+			assert.NotContains(t, textContent.Text, "s3cret")
+
+			// Unmarshal and verify the result
+			var returnedHook webhook
+			err = json.Unmarshal([]byte(textContent.Text), &returnedHook)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedHook, returnedHook)
+		})
+	}
+}